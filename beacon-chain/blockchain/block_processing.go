@@ -1,6 +1,7 @@
 package blockchain
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
@@ -39,10 +40,13 @@ import (
 //		else:
 //			return nil, error  # or throw or whatever
 //
+// The state transition runs on a copy-on-write copy of the head state, so the returned
+// state shares the fields the block did not write to with the head state, and must be
+// treated as read-only.
 func (c *ChainService) ReceiveBlock(ctx context.Context, block *pb.BeaconBlock) (*pb.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.blockchain.ReceiveBlock")
 	defer span.End()
-	beaconState, err := c.beaconDB.State(ctx)
+	beaconState, err := c.beaconDB.SharedState(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("could not retrieve beacon state: %v", err)
 	}
@@ -57,7 +61,7 @@ func (c *ChainService) ReceiveBlock(ctx context.Context, block *pb.BeaconBlock)
 	}
 
 	// Save blocks with higher slot numbers in cache.
-	if err := c.isBlockReadyForProcessing(block, beaconState); err != nil {
+	if err := c.isBlockReadyForProcessing(block, beaconState.InnerStateUnsafe()); err != nil {
 		return nil, fmt.Errorf("block with root %#x is not ready for processing: %v", blockRoot, err)
 	}
 
@@ -72,7 +76,7 @@ func (c *ChainService) ReceiveBlock(ctx context.Context, block *pb.BeaconBlock)
 
 	// Check for skipped slots.
 	numSkippedSlots := 0
-	for beaconState.Slot < block.Slot-1 {
+	for beaconState.Slot() < block.Slot-1 {
 		beaconState, err = c.runStateTransition(headRoot, nil, beaconState)
 		if err != nil {
 			return nil, fmt.Errorf("could not execute state transition without block %v", err)
@@ -88,6 +92,18 @@ func (c *ChainService) ReceiveBlock(ctx context.Context, block *pb.BeaconBlock)
 		return nil, fmt.Errorf("could not execute state transition with block %v", err)
 	}
 
+	// Check the state root of the block against the post-state root.
+	stateRoot, err := state.StateRoot(beaconState.InnerStateUnsafe())
+	if err != nil {
+		return nil, fmt.Errorf("could not compute state root: %v", err)
+	}
+	if !bytes.Equal(block.StateRootHash32, stateRoot[:]) {
+		return nil, fmt.Errorf(
+			"block with root %#x has state root %#x, but the state transition resulted in %#x",
+			blockRoot, block.StateRootHash32, stateRoot,
+		)
+	}
+
 	// if there exists a block for the slot being processed.
 	if err := c.beaconDB.SaveBlock(block); err != nil {
 		return nil, fmt.Errorf("failed to save block: %v", err)
@@ -104,7 +120,7 @@ func (c *ChainService) ReceiveBlock(ctx context.Context, block *pb.BeaconBlock)
 	}
 
 	log.WithField("hash", fmt.Sprintf("%#x", blockRoot)).Debug("Processed beacon block")
-	return beaconState, nil
+	return beaconState.InnerStateUnsafe(), nil
 }
 
 func (c *ChainService) isBlockReadyForProcessing(block *pb.BeaconBlock, beaconState *pb.BeaconState) error {
@@ -120,11 +136,11 @@ func (c *ChainService) isBlockReadyForProcessing(block *pb.BeaconBlock, beaconSt
 }
 
 func (c *ChainService) runStateTransition(
-	headRoot [32]byte, block *pb.BeaconBlock, beaconState *pb.BeaconState,
-) (*pb.BeaconState, error) {
-	beaconState, err := state.ExecuteStateTransition(
+	headRoot [32]byte, block *pb.BeaconBlock, sharedState *state.BeaconState,
+) (*state.BeaconState, error) {
+	sharedState, err := state.ExecuteSharedStateTransition(
 		c.ctx,
-		beaconState,
+		sharedState,
 		block,
 		headRoot,
 		true, /* sig verify */
@@ -132,6 +148,7 @@ func (c *ChainService) runStateTransition(
 	if err != nil {
 		return nil, fmt.Errorf("could not execute state transition %v", err)
 	}
+	beaconState := sharedState.InnerStateUnsafe()
 	log.WithField(
 		"slotsSinceGenesis", beaconState.Slot-params.BeaconConfig().GenesisSlot,
 	).Info("Slot transition successfully processed")
@@ -155,7 +172,7 @@ func (c *ChainService) runStateTransition(
 			"SlotsSinceGenesis", beaconState.Slot-params.BeaconConfig().GenesisSlot,
 		).Info("Epoch transition successfully processed")
 	}
-	return beaconState, nil
+	return sharedState, nil
 }
 
 func (c *ChainService) saveFinalizedState(beaconState *pb.BeaconState) error {
@@ -4,9 +4,11 @@ import (
 	"context"
 	"encoding/binary"
 	"math/big"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	v "github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
@@ -77,10 +79,6 @@ func TestReceiveBlock_ProcessCorrectly(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Can't generate genesis state: %v", err)
 	}
-	stateRoot, err := hashutil.HashProto(beaconState)
-	if err != nil {
-		t.Fatalf("Could not tree hash state: %v", err)
-	}
 	parentHash, genesisBlock := setupGenesisBlock(t, chainService, beaconState)
 	if err := chainService.beaconDB.UpdateChainHead(genesisBlock, beaconState); err != nil {
 		t.Fatal(err)
@@ -92,7 +90,6 @@ func TestReceiveBlock_ProcessCorrectly(t *testing.T) {
 
 	block := &pb.BeaconBlock{
 		Slot:             currentSlot + 1,
-		StateRootHash32:  stateRoot[:],
 		ParentRootHash32: parentHash[:],
 		RandaoReveal:     randaoReveal,
 		Eth1Data: &pb.Eth1Data{
@@ -104,6 +101,8 @@ func TestReceiveBlock_ProcessCorrectly(t *testing.T) {
 		},
 	}
 
+	setPostStateRoot(t, chainService, block)
+	signBlock(t, beaconState, block, privKeys)
 	if err := chainService.beaconDB.SaveBlock(block); err != nil {
 		t.Fatal(err)
 	}
@@ -128,10 +127,6 @@ func TestReceiveBlock_RemovesPendingDeposits(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Can't generate genesis state: %v", err)
 	}
-	stateRoot, err := hashutil.HashProto(beaconState)
-	if err != nil {
-		t.Fatalf("Could not tree hash state: %v", err)
-	}
 	parentHash, genesisBlock := setupGenesisBlock(t, chainService, beaconState)
 	beaconState.Slot++
 	if err := chainService.beaconDB.UpdateChainHead(genesisBlock, beaconState); err != nil {
@@ -165,7 +160,6 @@ func TestReceiveBlock_RemovesPendingDeposits(t *testing.T) {
 
 	block := &pb.BeaconBlock{
 		Slot:             currentSlot + 1,
-		StateRootHash32:  stateRoot[:],
 		ParentRootHash32: parentHash[:],
 		RandaoReveal:     randaoReveal,
 		Eth1Data: &pb.Eth1Data{
@@ -189,10 +183,28 @@ func TestReceiveBlock_RemovesPendingDeposits(t *testing.T) {
 	if err := chainService.beaconDB.SaveState(beaconState); err != nil {
 		t.Fatal(err)
 	}
+	setPostStateRoot(t, chainService, block)
+	signBlock(t, beaconState, block, privKeys)
+	headState, err := db.State(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
 	computedState, err := chainService.ReceiveBlock(context.Background(), block)
 	if err != nil {
 		t.Fatal(err)
 	}
+	// The deposit is only added to the registry of the post-state, not to the one of the
+	// head state it shares the registry with.
+	if len(computedState.ValidatorRegistry) != len(headState.ValidatorRegistry)+1 {
+		t.Errorf("Expected the deposit to add a validator, received %d validators", len(computedState.ValidatorRegistry))
+	}
+	unchangedState, err := db.State(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(headState, unchangedState) {
+		t.Error("Expected the head state not to be changed by processing the block")
+	}
 	if err := chainService.ApplyForkChoiceRule(context.Background(), block, computedState); err != nil {
 		t.Fatal(err)
 	}
@@ -203,6 +215,42 @@ func TestReceiveBlock_RemovesPendingDeposits(t *testing.T) {
 	testutil.AssertLogsContain(t, hook, "Executing state transition")
 }
 
+func TestReceiveBlock_RejectsWrongStateRoot(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	chainService := setupBeaconChain(t, false, db, true, nil)
+	deposits, privKeys := setupInitialDeposits(t, 100)
+	beaconState, err := state.GenesisBeaconState(deposits, 0, &pb.Eth1Data{})
+	if err != nil {
+		t.Fatalf("Can't generate genesis state: %v", err)
+	}
+	parentHash, genesisBlock := setupGenesisBlock(t, chainService, beaconState)
+	if err := chainService.beaconDB.UpdateChainHead(genesisBlock, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	beaconState.Slot++
+	block := &pb.BeaconBlock{
+		Slot:             params.BeaconConfig().GenesisSlot + 1,
+		StateRootHash32:  []byte{'a'},
+		ParentRootHash32: parentHash[:],
+		RandaoReveal:     createRandaoReveal(t, beaconState, privKeys),
+		Eth1Data: &pb.Eth1Data{
+			DepositRootHash32: []byte("a"),
+			BlockHash32:       []byte("b"),
+		},
+		Body: &pb.BeaconBlockBody{},
+	}
+	signBlock(t, beaconState, block, privKeys)
+	if err := chainService.beaconDB.SaveBlock(block); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "but the state transition resulted in"
+	if _, err := chainService.ReceiveBlock(context.Background(), block); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error containing %q, received %v", want, err)
+	}
+}
+
 func TestIsBlockReadyForProcessing_ValidBlock(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
@@ -358,3 +406,32 @@ func TestSaveValidatorIdx_SaveRetrieveWorks(t *testing.T) {
 		t.Errorf("Activated validators mapping for epoch %d still there", epoch)
 	}
 }
+
+// setPostStateRoot sets the state root of the block to the root of the state
+// which results from applying the block on top of the stored head state.
+func setPostStateRoot(t *testing.T, chainService *ChainService, block *pb.BeaconBlock) {
+	ctx := context.Background()
+	beaconState, err := chainService.beaconDB.State(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	headRoot, err := chainService.ChainHeadRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for beaconState.Slot < block.Slot-1 {
+		beaconState, err = state.ExecuteStateTransition(ctx, beaconState, nil, headRoot, false /* no sig verify */)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	beaconState, err = state.ExecuteStateTransition(ctx, beaconState, block, headRoot, false /* no sig verify */)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stateRoot, err := state.StateRoot(beaconState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block.StateRootHash32 = stateRoot[:]
+}
@@ -25,7 +25,9 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/forkutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/interop"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/ssz"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/sirupsen/logrus"
 	logTest "github.com/sirupsen/logrus/hooks/test"
@@ -136,9 +138,11 @@ func setupInitialDeposits(t *testing.T, numDeposits int) ([]*pb.Deposit, []*bls.
 		if err != nil {
 			t.Fatal(err)
 		}
-		depositInput := &pb.DepositInput{
-			Pubkey: priv.PublicKey().Marshal(),
+		depositInputs, err := interop.GenerateDepositInputs([]*bls.SecretKey{priv})
+		if err != nil {
+			t.Fatal(err)
 		}
+		depositInput := depositInputs[0]
 		balance := params.BeaconConfig().MaxDepositAmount
 		depositData, err := helpers.EncodeDepositData(depositInput, balance, time.Now().Unix())
 		if err != nil {
@@ -175,6 +179,21 @@ func createRandaoReveal(t *testing.T, beaconState *pb.BeaconState, privKeys []*b
 	return epochSignature.Marshal()
 }
 
+// signBlock signs the signing root of the block with the key of the proposer
+// at the slot of the block.
+func signBlock(t *testing.T, beaconState *pb.BeaconState, block *pb.BeaconBlock, privKeys []*bls.SecretKey) {
+	proposerIdx, err := helpers.BeaconProposerIndex(beaconState, block.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := ssz.SigningRoot(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := forkutil.DomainVersion(beaconState.Fork, helpers.SlotToEpoch(block.Slot), params.BeaconConfig().DomainProposal)
+	block.Signature = privKeys[proposerIdx].Sign(root[:], domain).Marshal()
+}
+
 func setupGenesisBlock(t *testing.T, cs *ChainService, beaconState *pb.BeaconState) ([32]byte, *pb.BeaconBlock) {
 	genesis := b.NewGenesisBlock([]byte{})
 	if err := cs.beaconDB.SaveBlock(genesis); err != nil {
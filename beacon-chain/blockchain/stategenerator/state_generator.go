@@ -35,12 +35,13 @@ func GenerateStateFromSlot(ctx context.Context, db *db.BeaconDB, slot uint64) (*
 	}
 
 	// run N state transitions to generate state
+	beaconState := state.InitializeFromProto(fState)
 	for i := fState.Slot + 1; i <= slot; i++ {
 		exists, blk, err := db.HasBlockBySlot(i)
 		if !exists {
-			fState, err = state.ExecuteStateTransition(
+			beaconState, err = state.ExecuteSharedStateTransition(
 				ctx,
-				fState,
+				beaconState,
 				nil,
 				root,
 				true, /* sig verify */
@@ -51,9 +52,9 @@ func GenerateStateFromSlot(ctx context.Context, db *db.BeaconDB, slot uint64) (*
 			continue
 		}
 
-		fState, err = state.ExecuteStateTransition(
+		beaconState, err = state.ExecuteSharedStateTransition(
 			ctx,
-			fState,
+			beaconState,
 			blk,
 			root,
 			true, /* sig verify */
@@ -68,5 +69,5 @@ func GenerateStateFromSlot(ctx context.Context, db *db.BeaconDB, slot uint64) (*
 		}
 	}
 
-	return fState, nil
+	return beaconState.InnerStateUnsafe(), nil
 }
@@ -7,11 +7,14 @@ import (
 	"time"
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/forkutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/interop"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/ssz"
 	"github.com/prysmaticlabs/prysm/shared/trieutil"
 )
 
@@ -25,7 +28,7 @@ func generateSimulatedBlock(
 	simObjects *SimulatedObjects,
 	privKeys []*bls.SecretKey,
 ) (*pb.BeaconBlock, [32]byte, error) {
-	stateRoot, err := hashutil.HashProto(beaconState)
+	stateRoot, err := state.StateRoot(beaconState)
 	if err != nil {
 		return nil, [32]byte{}, fmt.Errorf("could not tree hash state: %v", err)
 	}
@@ -57,13 +60,19 @@ func generateSimulatedBlock(
 		},
 	}
 	if simObjects.simDeposit != nil {
-		depositInput := &pb.DepositInput{
-			Pubkey:                      []byte(simObjects.simDeposit.Pubkey),
-			WithdrawalCredentialsHash32: make([]byte, 32),
-			ProofOfPossession:           make([]byte, 96),
+		// Deposits need a proof of possession, so the configured public key
+		// seeds the secret key of the depositor.
+		seed := hashutil.Hash([]byte(simObjects.simDeposit.Pubkey))
+		priv, err := bls.SecretKeyFromBytes(seed[:])
+		if err != nil {
+			return nil, [32]byte{}, fmt.Errorf("could not create deposit key: %v", err)
+		}
+		depositInputs, err := interop.GenerateDepositInputs([]*bls.SecretKey{priv})
+		if err != nil {
+			return nil, [32]byte{}, fmt.Errorf("could not create deposit input: %v", err)
 		}
 
-		data, err := helpers.EncodeDepositData(depositInput, simObjects.simDeposit.Amount, time.Now().Unix())
+		data, err := helpers.EncodeDepositData(depositInputs[0], simObjects.simDeposit.Amount, time.Now().Unix())
 		if err != nil {
 			return nil, [32]byte{}, fmt.Errorf("could not encode deposit data: %v", err)
 		}
@@ -132,6 +141,12 @@ func generateSimulatedBlock(
 			ValidatorIndex: simObjects.simValidatorExit.ValidatorIndex,
 		})
 	}
+	signingRoot, err := ssz.SigningRoot(block)
+	if err != nil {
+		return nil, [32]byte{}, fmt.Errorf("could not get signing root of new block: %v", err)
+	}
+	proposalDomain := forkutil.DomainVersion(beaconState.Fork, epoch, params.BeaconConfig().DomainProposal)
+	block.Signature = privKeys[proposerIdx].Sign(signingRoot[:], proposalDomain).Marshal()
 	blockRoot, err := hashutil.HashBeaconBlock(block)
 	if err != nil {
 		return nil, [32]byte{}, fmt.Errorf("could not tree hash new block: %v", err)
@@ -150,13 +165,12 @@ func generateInitialSimulatedDeposits(numDeposits uint64) ([]*pb.Deposit, []*bls
 		if err != nil {
 			return nil, nil, fmt.Errorf("could not initialize key: %v", err)
 		}
-		depositInput := &pb.DepositInput{
-			Pubkey:                      priv.PublicKey().Marshal(),
-			WithdrawalCredentialsHash32: make([]byte, 32),
-			ProofOfPossession:           make([]byte, 96),
+		depositInputs, err := interop.GenerateDepositInputs([]*bls.SecretKey{priv})
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not create deposit input: %v", err)
 		}
 		depositData, err := helpers.EncodeDepositData(
-			depositInput,
+			depositInputs[0],
 			params.BeaconConfig().MaxDepositAmount,
 			genesisTime,
 		)
@@ -280,7 +280,7 @@ func (sb *SimulatedBackend) setupBeaconStateAndGenesisBlock(initialDeposits []*p
 	// We do not expect hashing initial beacon state and genesis block to
 	// fail, so we can safely ignore the error below.
 	// #nosec G104
-	stateRoot, err := hashutil.HashProto(sb.state)
+	stateRoot, err := state.StateRoot(sb.state)
 	if err != nil {
 		return fmt.Errorf("could not tree hash state: %v", err)
 	}
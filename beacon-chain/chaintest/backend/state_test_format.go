@@ -35,7 +35,9 @@ type StateTestDeposit struct {
 	Slot        uint64 `yaml:"slot"`
 	Amount      uint64 `yaml:"amount"`
 	MerkleIndex uint64 `yaml:"merkle_index"`
-	Pubkey      string `yaml:"pubkey"`
+	// Pubkey seeds the key of the depositor, which signs the deposit's
+	// proof of possession.
+	Pubkey string `yaml:"pubkey"`
 }
 
 // StateTestProposerSlashing --
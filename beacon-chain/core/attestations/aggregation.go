@@ -0,0 +1,130 @@
+package attestations
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bitutil"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/forkutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// IsAggregator returns true if the selection proof, the signature of the slot by a
+// committee member, selects the member to aggregate the attestations of its committee.
+// About TARGET_AGGREGATORS_PER_COMMITTEE members of each committee are selected.
+//
+// Spec pseudocode definition:
+//   def is_aggregator(state: BeaconState, slot: Slot, index: CommitteeIndex, slot_signature: BLSSignature) -> bool:
+//     committee = get_beacon_committee(state, slot, index)
+//     modulo = max(1, len(committee) // TARGET_AGGREGATORS_PER_COMMITTEE)
+//     return bytes_to_int(hash(slot_signature)[0:8]) % modulo == 0
+func IsAggregator(committeeSize uint64, selectionProof []byte) bool {
+	modulo := committeeSize / params.BeaconConfig().TargetAggregatorsPerCommittee
+	if modulo == 0 {
+		modulo = 1
+	}
+	h := hashutil.Hash(selectionProof)
+	return bytesutil.FromBytes8(h[:8])%modulo == 0
+}
+
+// AggregateAttestations combines the attestations which have identical attestation
+// data and no participant in common, combining their bitfields and aggregating
+// their signatures. An attestation which overlaps with every aggregate of its data
+// starts another aggregate. The aggregates are returned in the order in which their
+// first attestation appears.
+func AggregateAttestations(atts []*pb.Attestation) ([]*pb.Attestation, error) {
+	aggregates := make([]*pb.Attestation, 0, len(atts))
+	signatures := make([][]*bls.Signature, 0, len(atts))
+	for _, att := range atts {
+		sig, err := bls.SignatureFromBytes(att.AggregateSignature)
+		if err != nil {
+			return nil, fmt.Errorf("could not deserialize attestation signature: %v", err)
+		}
+		merged := false
+		for i, agg := range aggregates {
+			if !proto.Equal(agg.Data, att.Data) || bitutil.Overlaps(agg.AggregationBitfield, att.AggregationBitfield) {
+				continue
+			}
+			agg.AggregationBitfield = bitutil.Or(agg.AggregationBitfield, att.AggregationBitfield)
+			agg.CustodyBitfield = bitutil.Or(agg.CustodyBitfield, att.CustodyBitfield)
+			signatures[i] = append(signatures[i], sig)
+			merged = true
+			break
+		}
+		if !merged {
+			aggregates = append(aggregates, proto.Clone(att).(*pb.Attestation))
+			signatures = append(signatures, []*bls.Signature{sig})
+		}
+	}
+	for i, agg := range aggregates {
+		if len(signatures[i]) > 1 {
+			sig, err := bls.AggregateSignatures(signatures[i])
+			if err != nil {
+				return nil, fmt.Errorf("could not aggregate attestation signatures: %v", err)
+			}
+			agg.AggregateSignature = sig.Marshal()
+		}
+	}
+	return aggregates, nil
+}
+
+// VerifyAggregateAndProof checks that the aggregator of an aggregate is a member of
+// the committee of the aggregate which was selected to aggregate its attestations,
+// and that the aggregate signature of the participants verifies.
+func VerifyAggregateAndProof(beaconState *pb.BeaconState, aggregateAndProof *pb.AggregateAndProof) error {
+	aggregate := aggregateAndProof.Aggregate
+	if aggregate == nil || aggregate.Data == nil {
+		return errors.New("expected an aggregate attestation, received nil")
+	}
+	committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, aggregate.Data.Slot, false /* registryChange */)
+	if err != nil {
+		return fmt.Errorf("could not get crosslink committees: %v", err)
+	}
+	var committee []uint64
+	for _, c := range committees {
+		if c.Shard == aggregate.Data.Shard {
+			committee = c.Committee
+			break
+		}
+	}
+	inCommittee := false
+	for _, idx := range committee {
+		if idx == aggregateAndProof.AggregatorIndex {
+			inCommittee = true
+			break
+		}
+	}
+	if !inCommittee {
+		return fmt.Errorf("aggregator %d is not in the committee of shard %d", aggregateAndProof.AggregatorIndex, aggregate.Data.Shard)
+	}
+	pub, err := bls.PublicKeyFromBytes(beaconState.ValidatorRegistry[aggregateAndProof.AggregatorIndex].Pubkey)
+	if err != nil {
+		return fmt.Errorf("could not deserialize aggregator public key: %v", err)
+	}
+	proof, err := bls.SignatureFromBytes(aggregateAndProof.SelectionProof)
+	if err != nil {
+		return fmt.Errorf("could not deserialize selection proof: %v", err)
+	}
+	epoch := helpers.SlotToEpoch(aggregate.Data.Slot)
+	domain := forkutil.DomainVersion(beaconState.Fork, epoch, params.BeaconConfig().DomainSelectionProof)
+	buf := make([]byte, 32)
+	binary.LittleEndian.PutUint64(buf, aggregate.Data.Slot)
+	if !proof.Verify(buf, pub, domain) {
+		return errors.New("selection proof did not verify")
+	}
+	if !IsAggregator(uint64(len(committee)), aggregateAndProof.SelectionProof) {
+		return fmt.Errorf("validator %d is not an aggregator of its committee", aggregateAndProof.AggregatorIndex)
+	}
+	if err := blocks.VerifyAttestationSignature(beaconState, aggregate); err != nil {
+		return fmt.Errorf("could not verify aggregate signature: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+package attestations
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bitutil"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/forkutil"
+	"github.com/prysmaticlabs/prysm/shared/interop"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/ssz"
+)
+
+func signAttestation(t *testing.T, priv *bls.SecretKey, data *pb.AttestationData, fork *pb.Fork, bitfield []byte) *pb.Attestation {
+	msg, err := ssz.TreeHash(&pb.AttestationDataAndCustodyBit{Data: data, CustodyBit: false})
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := forkutil.DomainVersion(fork, helpers.SlotToEpoch(data.Slot), params.BeaconConfig().DomainAttestation)
+	return &pb.Attestation{
+		Data:                data,
+		AggregationBitfield: bitfield,
+		CustodyBitfield:     make([]byte, len(bitfield)),
+		AggregateSignature:  priv.Sign(msg[:], domain).Marshal(),
+	}
+}
+
+func TestIsAggregator_SmallCommitteeAlwaysAggregates(t *testing.T) {
+	for i := 0; i < 10; i++ {
+		proof := make([]byte, 96)
+		if _, err := rand.Read(proof); err != nil {
+			t.Fatal(err)
+		}
+		if !IsAggregator(params.BeaconConfig().TargetAggregatorsPerCommittee-1, proof) {
+			t.Errorf("Expected every member of a committee smaller than the target to aggregate, proof %#x", proof)
+		}
+	}
+	// With a modulo of 2^40, a random proof practically never selects its member.
+	proof := make([]byte, 96)
+	if _, err := rand.Read(proof); err != nil {
+		t.Fatal(err)
+	}
+	if IsAggregator(params.BeaconConfig().TargetAggregatorsPerCommittee<<40, proof) {
+		t.Errorf("Expected proof %#x not to select its member", proof)
+	}
+}
+
+func TestAggregateAttestations_CombinesDisjointAttestations(t *testing.T) {
+	fork := &pb.Fork{}
+	data := &pb.AttestationData{Slot: params.BeaconConfig().GenesisSlot, Shard: 1}
+	other := &pb.AttestationData{Slot: params.BeaconConfig().GenesisSlot, Shard: 2}
+	privKeys := make([]*bls.SecretKey, 3)
+	for i := range privKeys {
+		priv, err := bls.RandKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		privKeys[i] = priv
+	}
+	atts := []*pb.Attestation{
+		signAttestation(t, privKeys[0], data, fork, []byte{0x80}),
+		signAttestation(t, privKeys[1], data, fork, []byte{0x40}),
+		signAttestation(t, privKeys[2], other, fork, []byte{0x20}),
+		// A second attestation of the first participant cannot join the first aggregate.
+		signAttestation(t, privKeys[0], data, fork, []byte{0x80}),
+		signAttestation(t, privKeys[2], data, fork, []byte{0x20}),
+	}
+	aggregates, err := AggregateAttestations(atts)
+	if err != nil {
+		t.Fatalf("Could not aggregate attestations: %v", err)
+	}
+	if len(aggregates) != 3 {
+		t.Fatalf("Expected 3 aggregates, received %d", len(aggregates))
+	}
+	if aggregates[0].AggregationBitfield[0] != 0xe0 {
+		t.Errorf("Expected aggregate bitfield %#x, received %#x", 0xe0, aggregates[0].AggregationBitfield)
+	}
+	if aggregates[1].Data.Shard != 2 || aggregates[2].AggregationBitfield[0] != 0x80 {
+		t.Errorf("Unexpected aggregates %v", aggregates[1:])
+	}
+	if atts[0].AggregationBitfield[0] != 0x80 {
+		t.Error("Expected the aggregated attestations to be left unchanged")
+	}
+
+	msg, err := ssz.TreeHash(&pb.AttestationDataAndCustodyBit{Data: data, CustodyBit: false})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := bls.SignatureFromBytes(aggregates[0].AggregateSignature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubKeys := []*bls.PublicKey{privKeys[0].PublicKey(), privKeys[1].PublicKey(), privKeys[2].PublicKey()}
+	domain := forkutil.DomainVersion(fork, helpers.SlotToEpoch(data.Slot), params.BeaconConfig().DomainAttestation)
+	if !sig.VerifyAggregate(pubKeys, msg[:], domain) {
+		t.Error("Aggregate signature does not verify")
+	}
+}
+
+func TestVerifyAggregateAndProof(t *testing.T) {
+	numValidators := params.BeaconConfig().SlotsPerEpoch
+	privKeys, err := interop.DeterministicallyGenerateKeys(0, numValidators)
+	if err != nil {
+		t.Fatal(err)
+	}
+	depositInputs, err := interop.GenerateDepositInputs(privKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	deposits := make([]*pb.Deposit, numValidators)
+	for i := range deposits {
+		depositData, err := helpers.EncodeDepositData(depositInputs[i], params.BeaconConfig().MaxDepositAmount, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		deposits[i] = &pb.Deposit{DepositData: depositData}
+	}
+	beaconState, err := state.GenesisBeaconState(deposits, 0, &pb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	slot := beaconState.Slot
+	committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, slot, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	committee := committees[0]
+	aggregator := committee.Committee[0]
+
+	data := &pb.AttestationData{Slot: slot, Shard: committee.Shard}
+	bitfield := make([]byte, (len(committee.Committee)+7)/8)
+	for i := range committee.Committee {
+		bitfield = bitutil.Or(bitfield, bitutil.SetBitfield(i))
+	}
+	var sigs []*bls.Signature
+	for _, idx := range committee.Committee {
+		att := signAttestation(t, privKeys[idx], data, beaconState.Fork, bitfield)
+		sig, err := bls.SignatureFromBytes(att.AggregateSignature)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sigs = append(sigs, sig)
+	}
+	aggSig, err := bls.AggregateSignatures(sigs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	aggregate := &pb.Attestation{
+		Data:                data,
+		AggregationBitfield: bitfield,
+		CustodyBitfield:     make([]byte, len(bitfield)),
+		AggregateSignature:  aggSig.Marshal(),
+	}
+	buf := make([]byte, 32)
+	binary.LittleEndian.PutUint64(buf, slot)
+	domain := forkutil.DomainVersion(beaconState.Fork, helpers.SlotToEpoch(slot), params.BeaconConfig().DomainSelectionProof)
+	aggregateAndProof := &pb.AggregateAndProof{
+		AggregatorIndex: aggregator,
+		Aggregate:       aggregate,
+		SelectionProof:  privKeys[aggregator].Sign(buf, domain).Marshal(),
+	}
+	if err := VerifyAggregateAndProof(beaconState, aggregateAndProof); err != nil {
+		t.Errorf("Expected aggregate and proof to verify: %v", err)
+	}
+
+	// The selection proof of another slot does not select the aggregator.
+	binary.LittleEndian.PutUint64(buf, slot+1)
+	aggregateAndProof.SelectionProof = privKeys[aggregator].Sign(buf, domain).Marshal()
+	want := "selection proof did not verify"
+	if err := VerifyAggregateAndProof(beaconState, aggregateAndProof); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+
+	var outsider uint64
+	for outsider = 0; outsider < numValidators; outsider++ {
+		member := false
+		for _, idx := range committee.Committee {
+			member = member || idx == outsider
+		}
+		if !member {
+			break
+		}
+	}
+	aggregateAndProof.AggregatorIndex = outsider
+	want = "is not in the committee"
+	if err := VerifyAggregateAndProof(beaconState, aggregateAndProof); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+}
@@ -16,10 +16,12 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/stateutils"
 	v "github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bitutil"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/forkutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/ssz"
 	"github.com/prysmaticlabs/prysm/shared/trieutil"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -29,14 +31,28 @@ import (
 // the correct proposer created an incoming beacon block during state
 // transition processing.
 //
-// WIP - this is stubbed out until BLS is integrated into Prysm.
+// Official spec definition for verifying the proposer signature:
+//   Let proposer = state.validator_registry[get_beacon_proposer_index(state, state.slot)].
+//   Verify that bls_verify(pubkey=proposer.pubkey, message_hash=signed_root(block),
+//     signature=block.signature, domain=get_domain(state.fork, get_current_epoch(state), DOMAIN_PROPOSAL)).
 func VerifyProposerSignature(
 	ctx context.Context,
-	_ *pb.BeaconBlock,
+	beaconState *pb.BeaconState,
+	block *pb.BeaconBlock,
 ) error {
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.ChainService.state.ProcessBlock.VerifyProposerSignature")
 	defer span.End()
 
+	proposerIdx, err := helpers.BeaconProposerIndex(beaconState, beaconState.Slot)
+	if err != nil {
+		return fmt.Errorf("could not get beacon proposer index: %v", err)
+	}
+	proposer := beaconState.ValidatorRegistry[proposerIdx]
+	currentEpoch := helpers.CurrentEpoch(beaconState)
+	domain := forkutil.DomainVersion(beaconState.Fork, currentEpoch, params.BeaconConfig().DomainProposal)
+	if err := helpers.VerifySigningRoot(block, proposer.Pubkey, block.Signature, domain); err != nil {
+		return fmt.Errorf("block signature of proposer %d did not verify: %v", proposerIdx, err)
+	}
 	return nil
 }
 
@@ -267,7 +283,7 @@ func ProcessAttesterSlashings(
 		)
 	}
 	for idx, slashing := range body.AttesterSlashings {
-		if err := verifyAttesterSlashing(slashing, verifySignatures); err != nil {
+		if err := verifyAttesterSlashing(beaconState, slashing, verifySignatures); err != nil {
 			return nil, fmt.Errorf("could not verify attester slashing #%d: %v", idx, err)
 		}
 		slashableIndices, err := attesterSlashableIndices(beaconState, slashing)
@@ -285,7 +301,7 @@ func ProcessAttesterSlashings(
 	return beaconState, nil
 }
 
-func verifyAttesterSlashing(slashing *pb.AttesterSlashing, verifySignatures bool) error {
+func verifyAttesterSlashing(beaconState *pb.BeaconState, slashing *pb.AttesterSlashing, verifySignatures bool) error {
 	slashableAttestation1 := slashing.SlashableAttestation_1
 	slashableAttestation2 := slashing.SlashableAttestation_2
 	data1 := slashableAttestation1.Data
@@ -307,10 +323,10 @@ func verifyAttesterSlashing(slashing *pb.AttesterSlashing, verifySignatures bool
 	if !(isSameTarget || isSurroundVote(data1, data2)) {
 		return errors.New("attester slashing is not a double vote nor surround vote")
 	}
-	if err := verifySlashableAttestation(slashableAttestation1, verifySignatures); err != nil {
+	if err := verifySlashableAttestation(beaconState, slashableAttestation1, verifySignatures); err != nil {
 		return fmt.Errorf("could not verify attester slashable attestation data 1: %v", err)
 	}
-	if err := verifySlashableAttestation(slashableAttestation2, verifySignatures); err != nil {
+	if err := verifySlashableAttestation(beaconState, slashableAttestation2, verifySignatures); err != nil {
 		return fmt.Errorf("could not verify attester slashable attestation data 2: %v", err)
 	}
 	return nil
@@ -339,7 +355,7 @@ func attesterSlashableIndices(beaconState *pb.BeaconState, slashing *pb.Attester
 	return slashableIndices, nil
 }
 
-func verifySlashableAttestation(att *pb.SlashableAttestation, verifySignatures bool) error {
+func verifySlashableAttestation(beaconState *pb.BeaconState, att *pb.SlashableAttestation, verifySignatures bool) error {
 	emptyCustody := make([]byte, len(att.CustodyBitfield))
 	if bytes.Equal(att.CustodyBitfield, emptyCustody) {
 		return errors.New("custody bit field can't all be 0s")
@@ -367,8 +383,67 @@ func verifySlashableAttestation(att *pb.SlashableAttestation, verifySignatures b
 	}
 
 	if verifySignatures {
-		// TODO(#258): Implement BLS verify multiple.
-		return nil
+		if err := verifySlashableAttestationSignature(beaconState, att); err != nil {
+			return fmt.Errorf("could not verify aggregate signature: %v", err)
+		}
+	}
+	return nil
+}
+
+// verifySlashableAttestationSignature checks the aggregate signature of a
+// slashable attestation, where each validator signed the attestation data
+// together with its custody bit.
+//
+// Official spec definition:
+//   return bls_verify_multiple(
+//     pubkeys=[
+//       bls_aggregate_pubkeys([state.validator_registry[i].pubkey for i in custody_bit_0_indices]),
+//       bls_aggregate_pubkeys([state.validator_registry[i].pubkey for i in custody_bit_1_indices]),
+//     ],
+//     message_hashes=[
+//       hash_tree_root(AttestationDataAndCustodyBit(data=slashable_attestation.data, custody_bit=0b0)),
+//       hash_tree_root(AttestationDataAndCustodyBit(data=slashable_attestation.data, custody_bit=0b1)),
+//     ],
+//     signature=slashable_attestation.aggregate_signature,
+//     domain=get_domain(state.fork, slot_to_epoch(vote_data.data.slot), DOMAIN_ATTESTATION),
+//   )
+func verifySlashableAttestationSignature(beaconState *pb.BeaconState, att *pb.SlashableAttestation) error {
+	var msgs [2][32]byte
+	for i, custodyBit := range []bool{false, true} {
+		msg, err := ssz.TreeHash(&pb.AttestationDataAndCustodyBit{Data: att.Data, CustodyBit: custodyBit})
+		if err != nil {
+			return fmt.Errorf("could not hash attestation data: %v", err)
+		}
+		msgs[i] = msg
+	}
+	pubKeys := make([]*bls.PublicKey, len(att.ValidatorIndices))
+	signedMsgs := make([][]byte, len(att.ValidatorIndices))
+	for i, idx := range att.ValidatorIndices {
+		if idx >= uint64(len(beaconState.ValidatorRegistry)) {
+			return fmt.Errorf("validator index %d is out of range", idx)
+		}
+		pub, err := bls.PublicKeyFromBytes(beaconState.ValidatorRegistry[idx].Pubkey)
+		if err != nil {
+			return fmt.Errorf("could not deserialize public key of validator %d: %v", idx, err)
+		}
+		custodyBit, err := bitutil.CheckBit(att.CustodyBitfield, i)
+		if err != nil {
+			return fmt.Errorf("could not get custody bit of validator %d: %v", idx, err)
+		}
+		pubKeys[i] = pub
+		if custodyBit {
+			signedMsgs[i] = msgs[1][:]
+		} else {
+			signedMsgs[i] = msgs[0][:]
+		}
+	}
+	sig, err := bls.SignatureFromBytes(att.AggregateSignature)
+	if err != nil {
+		return fmt.Errorf("could not deserialize aggregate signature: %v", err)
+	}
+	domain := forkutil.DomainVersion(beaconState.Fork, helpers.SlotToEpoch(att.Data.Slot), params.BeaconConfig().DomainAttestation)
+	if !sig.VerifyAggregateMessages(pubKeys, signedMsgs, domain) {
+		return errors.New("aggregate signature did not verify")
 	}
 	return nil
 }
@@ -531,20 +606,61 @@ func verifyAttestation(beaconState *pb.BeaconState, att *pb.Attestation, verifyS
 		)
 	}
 	if verifySignatures {
-		// TODO(#258): Integrate BLS signature verification for attestation.
-		// assert bls_verify_multiple(
-		//   pubkeys=[
-		//	 bls_aggregate_pubkeys([state.validator_registry[i].pubkey for i in custody_bit_0_participants]),
-		//   bls_aggregate_pubkeys([state.validator_registry[i].pubkey for i in custody_bit_1_participants]),
-		//   ],
-		//   message_hash=[
-		//   hash_tree_root(AttestationDataAndCustodyBit(data=attestation.data, custody_bit=0b0)),
-		//   hash_tree_root(AttestationDataAndCustodyBit(data=attestation.data, custody_bit=0b1)),
-		//   ],
-		//   signature=attestation.aggregate_signature,
-		//   domain=get_domain(state.fork, slot_to_epoch(attestation.data.slot), DOMAIN_ATTESTATION),
-		// )
-		return nil
+		if err := VerifyAttestationSignature(beaconState, att); err != nil {
+			return fmt.Errorf("could not verify aggregate signature: %v", err)
+		}
+	}
+	return nil
+}
+
+// VerifyAttestationSignature checks the aggregate signature of the participants
+// of an attestation. Until phase 1 every participant signs with custody bit 0,
+// so they all sign the same message.
+//
+// Official spec definition:
+//   assert bls_verify_multiple(
+//     pubkeys=[
+//       bls_aggregate_pubkeys([state.validator_registry[i].pubkey for i in custody_bit_0_participants]),
+//       bls_aggregate_pubkeys([state.validator_registry[i].pubkey for i in custody_bit_1_participants]),
+//     ],
+//     message_hash=[
+//       hash_tree_root(AttestationDataAndCustodyBit(data=attestation.data, custody_bit=0b0)),
+//       hash_tree_root(AttestationDataAndCustodyBit(data=attestation.data, custody_bit=0b1)),
+//     ],
+//     signature=attestation.aggregate_signature,
+//     domain=get_domain(state.fork, slot_to_epoch(attestation.data.slot), DOMAIN_ATTESTATION),
+//   )
+func VerifyAttestationSignature(beaconState *pb.BeaconState, att *pb.Attestation) error {
+	for _, b := range att.CustodyBitfield {
+		if b != 0 {
+			return errors.New("custody bits must be 0 until phase 1")
+		}
+	}
+	participants, err := helpers.AttestationParticipants(beaconState, att.Data, att.AggregationBitfield)
+	if err != nil {
+		return fmt.Errorf("could not get attestation participants: %v", err)
+	}
+	if len(participants) == 0 {
+		return errors.New("attestation has no participants")
+	}
+	pubKeys := make([]*bls.PublicKey, len(participants))
+	for i, idx := range participants {
+		pubKeys[i], err = bls.PublicKeyFromBytes(beaconState.ValidatorRegistry[idx].Pubkey)
+		if err != nil {
+			return fmt.Errorf("could not deserialize public key of validator %d: %v", idx, err)
+		}
+	}
+	sig, err := bls.SignatureFromBytes(att.AggregateSignature)
+	if err != nil {
+		return fmt.Errorf("could not deserialize aggregate signature: %v", err)
+	}
+	msg, err := ssz.TreeHash(&pb.AttestationDataAndCustodyBit{Data: att.Data, CustodyBit: false})
+	if err != nil {
+		return fmt.Errorf("could not hash attestation data: %v", err)
+	}
+	domain := forkutil.DomainVersion(beaconState.Fork, helpers.SlotToEpoch(att.Data.Slot), params.BeaconConfig().DomainAttestation)
+	if !sig.VerifyAggregate(pubKeys, msg[:], domain) {
+		return errors.New("aggregate signature did not verify")
 	}
 	return nil
 }
@@ -613,6 +729,13 @@ func ProcessValidatorDeposits(
 			depositInput.ProofOfPossession,
 			depositInput.WithdrawalCredentialsHash32,
 		)
+		if invalid, ok := err.(*v.InvalidDepositError); ok {
+			log.WithFields(logrus.Fields{
+				"publicKey":       fmt.Sprintf("%#x", invalid.Pubkey),
+				"merkleTreeIndex": deposit.MerkleTreeIndex,
+			}).Warnf("Skipping deposit: %v", invalid.Reason)
+			continue
+		}
 		if err != nil {
 			return nil, fmt.Errorf("could not process deposit into beacon state: %v", err)
 		}
@@ -650,10 +773,7 @@ func verifyDeposit(beaconState *pb.BeaconState, deposit *pb.Deposit) error {
 //     Let validator = state.validator_registry[exit.validator_index].
 //     Verify that validator.exit_epoch > get_entry_exit_effect_epoch(get_current_epoch(state)).
 //     Verify that get_current_epoch(state) >= exit.epoch.
-//     Let exit_message = hash_tree_root(
-//       Exit(epoch=exit.epoch, validator_index=exit.validator_index, signature=EMPTY_SIGNATURE)
-//     )
-//     Verify that bls_verify(pubkey=validator.pubkey, message_hash=exit_message,
+//     Verify that bls_verify(pubkey=validator.pubkey, message_hash=signed_root(exit),
 //       signature=exit.signature, domain=get_domain(state.fork, exit.epoch, DOMAIN_EXIT)).
 //     Run initiate_validator_exit(state, exit.validator_index).
 func ProcessValidatorExits(
@@ -676,7 +796,7 @@ func ProcessValidatorExits(
 
 	validatorRegistry := beaconState.ValidatorRegistry
 	for idx, exit := range exits {
-		if err := verifyExit(beaconState, exit, verifySignatures); err != nil {
+		if err := VerifyExit(beaconState, exit, verifySignatures); err != nil {
 			return nil, fmt.Errorf("could not verify exit #%d: %v", idx, err)
 		}
 		beaconState = v.InitiateValidatorExit(beaconState, exit.ValidatorIndex)
@@ -685,7 +805,13 @@ func ProcessValidatorExits(
 	return beaconState, nil
 }
 
-func verifyExit(beaconState *pb.BeaconState, exit *pb.VoluntaryExit, verifySignatures bool) error {
+// VerifyExit checks that the voluntary exit can be processed in a block on top of the
+// beacon state, which lets a beacon node check exits before adding them to its
+// operations pool.
+func VerifyExit(beaconState *pb.BeaconState, exit *pb.VoluntaryExit, verifySignatures bool) error {
+	if exit.ValidatorIndex >= uint64(len(beaconState.ValidatorRegistry)) {
+		return fmt.Errorf("validator index %d is not in the validator registry", exit.ValidatorIndex)
+	}
 	validator := beaconState.ValidatorRegistry[exit.ValidatorIndex]
 	currentEpoch := helpers.CurrentEpoch(beaconState)
 	entryExitEffectEpoch := helpers.EntryExitEffectEpoch(currentEpoch)
@@ -704,13 +830,10 @@ func verifyExit(beaconState *pb.BeaconState, exit *pb.VoluntaryExit, verifySigna
 		)
 	}
 	if verifySignatures {
-		// TODO(#258): Verify using BLS signature verification below:
-		// Let exit_message = hash_tree_root(
-		//   Exit(epoch=exit.epoch, validator_index=exit.validator_index, signature=EMPTY_SIGNATURE)
-		// )
-		// Verify that bls_verify(pubkey=validator.pubkey, message_hash=exit_message,
-		//   signature=exit.signature, domain=get_domain(state.fork, exit.epoch, DOMAIN_EXIT)).
-		return nil
+		domain := forkutil.DomainVersion(beaconState.Fork, exit.Epoch, params.BeaconConfig().DomainExit)
+		if err := helpers.VerifySigningRoot(exit, validator.Pubkey, exit.Signature, domain); err != nil {
+			return fmt.Errorf("exit signature of validator %d did not verify: %v", exit.ValidatorIndex, err)
+		}
 	}
 	return nil
 }
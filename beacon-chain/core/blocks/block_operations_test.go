@@ -17,9 +17,12 @@ import (
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/forkutil"
+	"github.com/prysmaticlabs/prysm/shared/interop"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/ssz"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/trieutil"
+	logTest "github.com/sirupsen/logrus/hooks/test"
 )
 
 func setupInitialDeposits(t *testing.T, numDeposits int) ([]*pb.Deposit, []*bls.SecretKey) {
@@ -30,9 +33,11 @@ func setupInitialDeposits(t *testing.T, numDeposits int) ([]*pb.Deposit, []*bls.
 		if err != nil {
 			t.Fatal(err)
 		}
-		depositInput := &pb.DepositInput{
-			Pubkey: priv.PublicKey().Marshal(),
+		depositInputs, err := interop.GenerateDepositInputs([]*bls.SecretKey{priv})
+		if err != nil {
+			t.Fatal(err)
 		}
+		depositInput := depositInputs[0]
 		balance := params.BeaconConfig().MaxDepositAmount
 		depositData, err := helpers.EncodeDepositData(depositInput, balance, time.Now().Unix())
 		if err != nil {
@@ -44,6 +49,18 @@ func setupInitialDeposits(t *testing.T, numDeposits int) ([]*pb.Deposit, []*bls.
 	return deposits, privKeys
 }
 
+func signedDepositInput(t *testing.T) *pb.DepositInput {
+	priv, err := bls.RandKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	depositInputs, err := interop.GenerateDepositInputs([]*bls.SecretKey{priv})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return depositInputs[0]
+}
+
 func TestProcessBlockRandao_IncorrectProposerFailsVerification(t *testing.T) {
 	deposits, privKeys := setupInitialDeposits(t, 100)
 	beaconState, err := state.GenesisBeaconState(deposits, uint64(0), &pb.Eth1Data{})
@@ -680,6 +697,91 @@ func TestProcessAttesterSlashings_AppliesCorrectStatus(t *testing.T) {
 	}
 }
 
+func TestProcessAttesterSlashings_VerifiesCustodyBitSignatures(t *testing.T) {
+	deposits, privKeys := setupInitialDeposits(t, 100)
+	beaconState, err := state.GenesisBeaconState(deposits, uint64(0), &pb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// signSlashableAttestation signs the data of att with the custody bit of
+	// each of its validators, or with flipped custody bits.
+	signSlashableAttestation := func(att *pb.SlashableAttestation, flipCustodyBits bool) {
+		domain := forkutil.DomainVersion(beaconState.Fork, helpers.SlotToEpoch(att.Data.Slot), params.BeaconConfig().DomainAttestation)
+		sigs := make([]*bls.Signature, len(att.ValidatorIndices))
+		for i, idx := range att.ValidatorIndices {
+			custodyBit := att.CustodyBitfield[0]>>(7-uint(i))&1 == 1
+			msg, err := ssz.TreeHash(&pb.AttestationDataAndCustodyBit{
+				Data:       att.Data,
+				CustodyBit: custodyBit != flipCustodyBits,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			sigs[i] = privKeys[idx].Sign(msg[:], domain)
+		}
+		aggSig, err := bls.AggregateSignatures(sigs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		att.AggregateSignature = aggSig.Marshal()
+	}
+	newSlashing := func(flipCustodyBits bool) *pb.AttesterSlashing {
+		slashing := &pb.AttesterSlashing{
+			SlashableAttestation_1: &pb.SlashableAttestation{
+				Data: &pb.AttestationData{
+					Slot:           params.BeaconConfig().GenesisSlot,
+					JustifiedEpoch: 5,
+				},
+				ValidatorIndices: []uint64{1, 2, 3},
+				// Validator 1 signs with custody bit 0, validators 2 and 3 with 1.
+				CustodyBitfield: []byte{0x60},
+			},
+			SlashableAttestation_2: &pb.SlashableAttestation{
+				Data: &pb.AttestationData{
+					Slot:           params.BeaconConfig().GenesisSlot,
+					JustifiedEpoch: 4,
+				},
+				ValidatorIndices: []uint64{2, 3},
+				CustodyBitfield:  []byte{0xC0},
+			},
+		}
+		signSlashableAttestation(slashing.SlashableAttestation_1, flipCustodyBits)
+		signSlashableAttestation(slashing.SlashableAttestation_2, false)
+		return slashing
+	}
+
+	block := &pb.BeaconBlock{
+		Body: &pb.BeaconBlockBody{
+			AttesterSlashings: []*pb.AttesterSlashing{newSlashing(true)},
+		},
+	}
+	want := "could not verify attester slashable attestation data 1: could not verify aggregate signature"
+	if _, err := blocks.ProcessAttesterSlashings(
+		context.Background(),
+		beaconState,
+		block,
+		true,
+	); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+
+	block.Body.AttesterSlashings = []*pb.AttesterSlashing{newSlashing(false)}
+	newState, err := blocks.ProcessAttesterSlashings(
+		context.Background(),
+		beaconState,
+		block,
+		true,
+	)
+	if err != nil {
+		t.Fatalf("Could not process attester slashing: %v", err)
+	}
+	for _, idx := range []uint64{2, 3} {
+		if newState.ValidatorRegistry[idx].SlashedEpoch == params.BeaconConfig().FarFutureEpoch {
+			t.Errorf("Validator %d was not slashed", idx)
+		}
+	}
+}
+
 func TestProcessBlockAttestations_ThresholdReached(t *testing.T) {
 	attestations := make([]*pb.Attestation, params.BeaconConfig().MaxAttestations+1)
 	block := &pb.BeaconBlock{
@@ -1193,11 +1295,7 @@ func TestProcessValidatorDeposits_ProcessDepositHelperFuncFails(t *testing.T) {
 	// Having mismatched withdrawal credentials will cause the process deposit
 	// validator helper function to fail with error when the public key
 	// currently exists in the validator registry.
-	depositInput := &pb.DepositInput{
-		Pubkey:                      []byte{1},
-		WithdrawalCredentialsHash32: []byte{1, 2, 3},
-		ProofOfPossession:           []byte{},
-	}
+	depositInput := signedDepositInput(t)
 	wBuf := new(bytes.Buffer)
 	if err := ssz.Encode(wBuf, depositInput); err != nil {
 		t.Fatalf("failed to encode deposit input: %v", err)
@@ -1250,7 +1348,7 @@ func TestProcessValidatorDeposits_ProcessDepositHelperFuncFails(t *testing.T) {
 	// the one specified in the deposit input, causing a failure.
 	registry := []*pb.Validator{
 		{
-			Pubkey:                      []byte{1},
+			Pubkey:                      depositInput.Pubkey,
 			WithdrawalCredentialsHash32: []byte{4, 5, 6},
 		},
 	}
@@ -1277,11 +1375,7 @@ func TestProcessValidatorDeposits_ProcessDepositHelperFuncFails(t *testing.T) {
 }
 
 func TestProcessValidatorDeposits_ProcessCorrectly(t *testing.T) {
-	depositInput := &pb.DepositInput{
-		Pubkey:                      []byte{1},
-		WithdrawalCredentialsHash32: []byte{1, 2, 3},
-		ProofOfPossession:           []byte{},
-	}
+	depositInput := signedDepositInput(t)
 	wBuf := new(bytes.Buffer)
 	if err := ssz.Encode(wBuf, depositInput); err != nil {
 		t.Fatalf("failed to encode deposit input: %v", err)
@@ -1334,8 +1428,8 @@ func TestProcessValidatorDeposits_ProcessCorrectly(t *testing.T) {
 	}
 	registry := []*pb.Validator{
 		{
-			Pubkey:                      []byte{1},
-			WithdrawalCredentialsHash32: []byte{1, 2, 3},
+			Pubkey:                      depositInput.Pubkey,
+			WithdrawalCredentialsHash32: depositInput.WithdrawalCredentialsHash32,
 		},
 	}
 	balances := []uint64{0}
@@ -1367,6 +1461,51 @@ func TestProcessValidatorDeposits_ProcessCorrectly(t *testing.T) {
 	}
 }
 
+func TestProcessValidatorDeposits_SkipsInvalidProofOfPossession(t *testing.T) {
+	depositInput := signedDepositInput(t)
+	// The proof of possession of another deposit input does not verify.
+	depositInput.ProofOfPossession = signedDepositInput(t).ProofOfPossession
+	data, err := helpers.EncodeDepositData(depositInput, 1000, time.Unix(1000, 0).Unix())
+	if err != nil {
+		t.Fatal(err)
+	}
+	depositTrie, err := trieutil.GenerateTrieFromItems([][]byte{data}, int(params.BeaconConfig().DepositContractTreeDepth))
+	if err != nil {
+		t.Fatalf("Could not generate trie: %v", err)
+	}
+	proof, err := depositTrie.MerkleProof(0)
+	if err != nil {
+		t.Fatalf("Could not generate proof: %v", err)
+	}
+	block := &pb.BeaconBlock{
+		Body: &pb.BeaconBlockBody{
+			Deposits: []*pb.Deposit{{
+				DepositData:         data,
+				MerkleBranchHash32S: proof,
+				MerkleTreeIndex:     0,
+			}},
+		},
+	}
+	root := depositTrie.Root()
+	beaconState := &pb.BeaconState{
+		ValidatorRegistry: []*pb.Validator{},
+		ValidatorBalances: []uint64{},
+		LatestEth1Data: &pb.Eth1Data{
+			DepositRootHash32: root[:],
+			BlockHash32:       root[:],
+		},
+	}
+	hook := logTest.NewGlobal()
+	newState, err := blocks.ProcessValidatorDeposits(context.Background(), beaconState, block)
+	if err != nil {
+		t.Fatalf("Expected the deposit to be skipped, received: %v", err)
+	}
+	if len(newState.ValidatorRegistry) != 0 || len(newState.ValidatorBalances) != 0 {
+		t.Errorf("Expected no validator to be added, received %d", len(newState.ValidatorRegistry))
+	}
+	testutil.AssertLogsContain(t, hook, "Skipping deposit")
+}
+
 func TestProcessValidatorExits_ThresholdReached(t *testing.T) {
 	exits := make([]*pb.VoluntaryExit, params.BeaconConfig().MaxVoluntaryExits+1)
 	registry := []*pb.Validator{}
@@ -1523,3 +1662,16 @@ func TestProcessValidatorExits_AppliesCorrectStatus(t *testing.T) {
 		t.Error("Expected validator status to change, remained INITIAL")
 	}
 }
+
+func TestVerifyExit_UnknownValidator(t *testing.T) {
+	state := &pb.BeaconState{
+		ValidatorRegistry: []*pb.Validator{
+			{ExitEpoch: params.BeaconConfig().FarFutureEpoch},
+		},
+	}
+	exit := &pb.VoluntaryExit{ValidatorIndex: 1}
+	want := "validator index 1 is not in the validator registry"
+	if err := blocks.VerifyExit(state, exit, false); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+}
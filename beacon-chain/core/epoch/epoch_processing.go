@@ -14,6 +14,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/forkutil"
 	"github.com/prysmaticlabs/prysm/shared/mathutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
@@ -414,3 +415,27 @@ func UpdateLatestRandaoMixes(ctx context.Context, state *pb.BeaconState) (*pb.Be
 	state.LatestRandaoMixes[nextEpoch] = randaoMix
 	return state, nil
 }
+
+// ProcessForkSchedule rotates the fork of the state when the fork schedule of the
+// beacon chain config activates a new fork version at the next epoch.
+//
+// The fork is rotated as:
+//	Set state.fork.previous_version = state.fork.current_version
+//	Set state.fork.current_version = FORK_SCHEDULE[next_epoch]
+//	Set state.fork.epoch = next_epoch
+func ProcessForkSchedule(ctx context.Context, state *pb.BeaconState) *pb.BeaconState {
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.ChainService.state.ProcessEpoch.ProcessForkSchedule")
+	defer span.End()
+
+	fork := forkutil.ScheduledFork(state.Fork, helpers.NextEpoch(state))
+	if fork == state.Fork {
+		return state
+	}
+	log.WithFields(logrus.Fields{
+		"previousVersion": fork.PreviousVersion,
+		"currentVersion":  fork.CurrentVersion,
+		"epoch":           fork.Epoch - params.BeaconConfig().GenesisEpoch,
+	}).Info("Scheduled fork activated")
+	state.Fork = fork
+	return state
+}
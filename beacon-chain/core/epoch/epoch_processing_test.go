@@ -569,3 +569,27 @@ func TestUpdateLatestActiveIndexRoots_UpdatesActiveIndexRoots(t *testing.T) {
 		)
 	}
 }
+
+func TestProcessForkSchedule_RotatesFork(t *testing.T) {
+	defer params.OverrideBeaconConfig(params.BeaconConfig())
+	cfg := *params.BeaconConfig()
+	cfg.ForkSchedule = map[uint64]uint64{2: 1}
+	params.OverrideBeaconConfig(&cfg)
+
+	genesisFork := &pb.Fork{Epoch: cfg.GenesisEpoch}
+	state := &pb.BeaconState{
+		Slot: cfg.GenesisSlot + cfg.SlotsPerEpoch - 1,
+		Fork: genesisFork,
+	}
+	state = ProcessForkSchedule(context.Background(), state)
+	if state.Fork != genesisFork {
+		t.Errorf("Expected fork to stay the same before the scheduled epoch, received %v", state.Fork)
+	}
+
+	state.Slot += cfg.SlotsPerEpoch
+	state = ProcessForkSchedule(context.Background(), state)
+	want := &pb.Fork{PreviousVersion: 0, CurrentVersion: 1, Epoch: cfg.GenesisEpoch + 2}
+	if !proto.Equal(state.Fork, want) {
+		t.Errorf("Wanted fork %v, received %v", want, state.Fork)
+	}
+}
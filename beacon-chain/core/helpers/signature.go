@@ -0,0 +1,34 @@
+package helpers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/ssz"
+)
+
+// VerifySigningRoot checks that signature was produced by the given public key
+// over the signing root of a signed object, such as a block, voluntary exit or
+// deposit input.
+//
+// Spec pseudocode definition:
+//   bls_verify(pubkey=pubkey, message_hash=signed_root(obj), signature=signature, domain=domain)
+func VerifySigningRoot(obj interface{}, pubkey []byte, signature []byte, domain uint64) error {
+	pub, err := bls.PublicKeyFromBytes(pubkey)
+	if err != nil {
+		return fmt.Errorf("could not deserialize public key: %v", err)
+	}
+	sig, err := bls.SignatureFromBytes(signature)
+	if err != nil {
+		return fmt.Errorf("could not deserialize signature: %v", err)
+	}
+	root, err := ssz.SigningRoot(obj)
+	if err != nil {
+		return fmt.Errorf("could not get signing root: %v", err)
+	}
+	if !sig.Verify(root[:], pub, domain) {
+		return errors.New("signature did not verify")
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package helpers
+
+import (
+	"crypto/rand"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/ssz"
+)
+
+func TestVerifySigningRoot(t *testing.T) {
+	priv, err := bls.RandKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exit := &pb.VoluntaryExit{Epoch: 5, ValidatorIndex: 7}
+	root, err := ssz.SigningRoot(exit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := params.BeaconConfig().DomainExit
+	exit.Signature = priv.Sign(root[:], domain).Marshal()
+	pubkey := priv.PublicKey().Marshal()
+
+	if err := VerifySigningRoot(exit, pubkey, exit.Signature, domain); err != nil {
+		t.Errorf("Expected signature to verify: %v", err)
+	}
+	if err := VerifySigningRoot(exit, pubkey, exit.Signature, params.BeaconConfig().DomainProposal); err == nil {
+		t.Error("Expected signature with another domain to fail verification")
+	}
+	exit.ValidatorIndex = 8
+	if err := VerifySigningRoot(exit, pubkey, exit.Signature, domain); err == nil {
+		t.Error("Expected signature over a modified exit to fail verification")
+	}
+}
@@ -0,0 +1,167 @@
+package state
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// sharedField identifies one of the large fields of the beacon state which
+// are shared between copies until one of them writes to it.
+type sharedField int
+
+const (
+	validatorRegistry sharedField = iota
+	validatorBalances
+	latestRandaoMixes
+)
+
+var sharedFields = []sharedField{validatorRegistry, validatorBalances, latestRandaoMixes}
+
+// reference counts how many copies of a beacon state point
+// at the same backing data of a shared field.
+type reference struct {
+	lock sync.Mutex
+	refs uint
+}
+
+func (r *reference) addRef() {
+	r.lock.Lock()
+	r.refs++
+	r.lock.Unlock()
+}
+
+func (r *reference) minusRef() {
+	r.lock.Lock()
+	if r.refs > 0 {
+		r.refs--
+	}
+	r.lock.Unlock()
+}
+
+func (r *reference) count() uint {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.refs
+}
+
+// BeaconState wraps the beacon state protobuf and shares the validator registry,
+// the validator balances and the latest RANDAO mixes between copies of the state.
+// A shared field is only copied once a copy of the state is about to write to it,
+// which makes copying a state before running a speculative state transition cheap.
+type BeaconState struct {
+	lock       sync.RWMutex
+	state      *pb.BeaconState
+	references map[sharedField]*reference
+}
+
+// InitializeFromProto wraps the given beacon state protobuf. The wrapper takes
+// ownership of the protobuf, callers must not mutate it afterwards.
+func InitializeFromProto(st *pb.BeaconState) *BeaconState {
+	s := &BeaconState{
+		state:      st,
+		references: make(map[sharedField]*reference, len(sharedFields)),
+	}
+	for _, f := range sharedFields {
+		s.references[f] = &reference{refs: 1}
+	}
+	runtime.SetFinalizer(s, releaseReferences)
+	return s
+}
+
+// releaseReferences drops the references held by a garbage collected state
+// so the remaining holders of a shared field do not copy it needlessly.
+func releaseReferences(s *BeaconState) {
+	for _, ref := range s.references {
+		ref.minusRef()
+	}
+}
+
+// Copy returns a copy of the beacon state which shares the large fields with
+// the original. Every other field is deep copied.
+func (s *BeaconState) Copy() *BeaconState {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	shallow := *s.state
+	shallow.ValidatorRegistry = nil
+	shallow.ValidatorBalances = nil
+	shallow.LatestRandaoMixes = nil
+	inner := proto.Clone(&shallow).(*pb.BeaconState)
+
+	// Limit the capacity of the shared slices so appending to
+	// them in one copy can never write into the other's backing array.
+	registry := s.state.ValidatorRegistry
+	balances := s.state.ValidatorBalances
+	mixes := s.state.LatestRandaoMixes
+	inner.ValidatorRegistry = registry[:len(registry):len(registry)]
+	inner.ValidatorBalances = balances[:len(balances):len(balances)]
+	inner.LatestRandaoMixes = mixes[:len(mixes):len(mixes)]
+
+	dst := &BeaconState{
+		state:      inner,
+		references: make(map[sharedField]*reference, len(sharedFields)),
+	}
+	for f, ref := range s.references {
+		ref.addRef()
+		dst.references[f] = ref
+	}
+	runtime.SetFinalizer(dst, releaseReferences)
+	return dst
+}
+
+// CloneInnerState returns a deep copy of the underlying protobuf which is
+// safe to mutate without affecting this state or any of its copies.
+func (s *BeaconState) CloneInnerState() *pb.BeaconState {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return proto.Clone(s.state).(*pb.BeaconState)
+}
+
+// InnerStateUnsafe returns the underlying protobuf without copying it. The
+// returned value may share data with other copies and must be treated as read-only.
+func (s *BeaconState) InnerStateUnsafe() *pb.BeaconState {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.state
+}
+
+// Slot of the beacon state.
+func (s *BeaconState) Slot() uint64 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.state.Slot
+}
+
+// detach gives this state its own copy of the given fields if they are
+// currently shared with another copy. The caller must hold the write lock.
+func (s *BeaconState) detach(fields ...sharedField) {
+	for _, f := range fields {
+		ref := s.references[f]
+		if ref.count() <= 1 {
+			continue
+		}
+		switch f {
+		case validatorRegistry:
+			registry := make([]*pb.Validator, len(s.state.ValidatorRegistry))
+			for i, v := range s.state.ValidatorRegistry {
+				registry[i] = proto.Clone(v).(*pb.Validator)
+			}
+			s.state.ValidatorRegistry = registry
+		case validatorBalances:
+			balances := make([]uint64, len(s.state.ValidatorBalances))
+			copy(balances, s.state.ValidatorBalances)
+			s.state.ValidatorBalances = balances
+		case latestRandaoMixes:
+			mixes := make([][]byte, len(s.state.LatestRandaoMixes))
+			for i, mix := range s.state.LatestRandaoMixes {
+				mixes[i] = append([]byte{}, mix...)
+			}
+			s.state.LatestRandaoMixes = mixes
+		}
+		ref.minusRef()
+		s.references[f] = &reference{refs: 1}
+	}
+}
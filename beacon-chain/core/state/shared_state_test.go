@@ -0,0 +1,155 @@
+package state_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func sharedStateTestBlock(slot uint64) *pb.BeaconBlock {
+	return &pb.BeaconBlock{
+		Slot:         slot,
+		RandaoReveal: []byte{'A'},
+		Eth1Data: &pb.Eth1Data{
+			DepositRootHash32: []byte{2},
+			BlockHash32:       []byte{3},
+		},
+		Body: &pb.BeaconBlockBody{},
+	}
+}
+
+func TestSharedState_CopySharesUntilWritten(t *testing.T) {
+	deposits, _ := setupInitialDeposits(t, params.BeaconConfig().SlotsPerEpoch)
+	genesis, err := state.GenesisBeaconState(deposits, uint64(0), &pb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	original := state.InitializeFromProto(proto.Clone(genesis).(*pb.BeaconState))
+	copied := original.Copy()
+
+	if &original.InnerStateUnsafe().ValidatorRegistry[0] != &copied.InnerStateUnsafe().ValidatorRegistry[0] {
+		t.Error("Expected copy to share the validator registry with the original")
+	}
+	if &original.InnerStateUnsafe().LatestRandaoMixes[0] != &copied.InnerStateUnsafe().LatestRandaoMixes[0] {
+		t.Error("Expected copy to share the randao mixes with the original")
+	}
+
+	block := sharedStateTestBlock(genesis.Slot + 1)
+	if _, err := state.ExecuteSharedStateTransition(
+		context.Background(), copied, block, [32]byte{}, false, /* no sig verify */
+	); err != nil {
+		t.Fatalf("Could not execute state transition: %v", err)
+	}
+
+	if !proto.Equal(original.InnerStateUnsafe(), genesis) {
+		t.Error("Expected original state to be unaffected by the transition of its copy")
+	}
+	if &original.InnerStateUnsafe().LatestRandaoMixes[0] == &copied.InnerStateUnsafe().LatestRandaoMixes[0] {
+		t.Error("Expected randao mixes to be copied once written")
+	}
+	if &original.InnerStateUnsafe().ValidatorRegistry[0] != &copied.InnerStateUnsafe().ValidatorRegistry[0] {
+		t.Error("Expected validator registry to stay shared when the block does not modify it")
+	}
+}
+
+func TestSharedState_CloneInnerStateIsIndependent(t *testing.T) {
+	deposits, _ := setupInitialDeposits(t, 8)
+	genesis, err := state.GenesisBeaconState(deposits, uint64(0), &pb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	shared := state.InitializeFromProto(proto.Clone(genesis).(*pb.BeaconState))
+	cloned := shared.Copy().CloneInnerState()
+	cloned.ValidatorBalances[0] = 0
+	cloned.ValidatorRegistry[0].ExitEpoch = 0
+
+	if !proto.Equal(shared.InnerStateUnsafe(), genesis) {
+		t.Error("Expected mutating a cloned inner state to leave the shared state untouched")
+	}
+}
+
+func TestExecuteSharedStateTransition_MatchesExecuteStateTransition(t *testing.T) {
+	deposits, _ := setupInitialDeposits(t, params.BeaconConfig().SlotsPerEpoch)
+	genesis, err := state.GenesisBeaconState(deposits, uint64(0), &pb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	want := proto.Clone(genesis).(*pb.BeaconState)
+	shared := state.InitializeFromProto(proto.Clone(genesis).(*pb.BeaconState))
+	// Keep a copy alive so every field of the transitioned state starts out shared.
+	held := shared.Copy()
+
+	for i := uint64(1); i <= params.BeaconConfig().SlotsPerEpoch; i++ {
+		var block *pb.BeaconBlock
+		if i%2 == 0 {
+			block = sharedStateTestBlock(genesis.Slot + i)
+		}
+		want, err = state.ExecuteStateTransition(ctx, want, block, [32]byte{}, false /* no sig verify */)
+		if err != nil {
+			t.Fatalf("Could not execute state transition: %v", err)
+		}
+		shared, err = state.ExecuteSharedStateTransition(ctx, shared, block, [32]byte{}, false /* no sig verify */)
+		if err != nil {
+			t.Fatalf("Could not execute shared state transition: %v", err)
+		}
+	}
+
+	if !proto.Equal(shared.InnerStateUnsafe(), want) {
+		t.Error("Expected shared state transition to produce the same state as the regular transition")
+	}
+	if !proto.Equal(held.InnerStateUnsafe(), genesis) {
+		t.Error("Expected held copy to be unaffected by the transitions")
+	}
+}
+
+func BenchmarkComputeStateRoot_Clone(b *testing.B) {
+	genesis := benchmarkGenesisState(b)
+	block := sharedStateTestBlock(genesis.Slot + 1)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		st := proto.Clone(genesis).(*pb.BeaconState)
+		if _, err := state.ExecuteStateTransition(context.Background(), st, block, [32]byte{}, false); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkComputeStateRoot_SharedCopy(b *testing.B) {
+	genesis := state.InitializeFromProto(benchmarkGenesisState(b))
+	block := sharedStateTestBlock(genesis.Slot() + 1)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := state.ExecuteSharedStateTransition(context.Background(), genesis.Copy(), block, [32]byte{}, false); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func benchmarkGenesisState(b *testing.B) *pb.BeaconState {
+	validators := make([]*pb.Validator, 16384)
+	balances := make([]uint64, len(validators))
+	for i := 0; i < len(validators); i++ {
+		validators[i] = &pb.Validator{
+			Pubkey:          []byte{byte(i), byte(i >> 8)},
+			ActivationEpoch: params.BeaconConfig().GenesisEpoch,
+			ExitEpoch:       params.BeaconConfig().FarFutureEpoch,
+			WithdrawalEpoch: params.BeaconConfig().FarFutureEpoch,
+			SlashedEpoch:    params.BeaconConfig().FarFutureEpoch,
+		}
+		balances[i] = params.BeaconConfig().MaxDepositAmount
+	}
+	genesis, err := state.GenesisBeaconState(nil, uint64(0), &pb.Eth1Data{})
+	if err != nil {
+		b.Fatal(err)
+	}
+	genesis.ValidatorRegistry = validators
+	genesis.ValidatorBalances = balances
+	return genesis
+}
@@ -60,27 +60,6 @@ func GenesisBeaconState(
 		latestBlockRoots[i] = zeroHash
 	}
 
-	validatorRegistry := make([]*pb.Validator, len(genesisValidatorDeposits))
-	latestBalances := make([]uint64, len(genesisValidatorDeposits))
-	for i, d := range genesisValidatorDeposits {
-		depositInput, err := helpers.DecodeDepositInput(d.DepositData)
-		if err != nil {
-			return nil, fmt.Errorf("could decode deposit input %v", err)
-		}
-
-		validator := &pb.Validator{
-			Pubkey:                      depositInput.Pubkey,
-			WithdrawalCredentialsHash32: depositInput.WithdrawalCredentialsHash32,
-			ActivationEpoch:             params.BeaconConfig().FarFutureEpoch,
-			ExitEpoch:                   params.BeaconConfig().FarFutureEpoch,
-			SlashedEpoch:                params.BeaconConfig().FarFutureEpoch,
-			WithdrawalEpoch:             params.BeaconConfig().FarFutureEpoch,
-		}
-
-		validatorRegistry[i] = validator
-
-	}
-
 	latestSlashedExitBalances := make([]uint64, params.BeaconConfig().LatestSlashedExitLength)
 
 	state := &pb.BeaconState{
@@ -94,8 +73,8 @@ func GenesisBeaconState(
 		},
 
 		// Validator registry fields.
-		ValidatorRegistry:            validatorRegistry,
-		ValidatorBalances:            latestBalances,
+		ValidatorRegistry:            []*pb.Validator{},
+		ValidatorBalances:            []uint64{},
 		ValidatorRegistryUpdateEpoch: params.BeaconConfig().GenesisEpoch,
 
 		// Randomness and committees.
@@ -147,6 +126,12 @@ func GenesisBeaconState(
 			depositInput.ProofOfPossession,
 			depositInput.WithdrawalCredentialsHash32,
 		)
+		if invalid, ok := err.(*v.InvalidDepositError); ok {
+			log.WithField(
+				"publicKey", fmt.Sprintf("%#x", invalid.Pubkey),
+			).Warnf("Skipping genesis deposit: %v", invalid.Reason)
+			continue
+		}
 		if err != nil {
 			return nil, fmt.Errorf("could not process validator deposit: %v", err)
 		}
@@ -178,3 +163,39 @@ func GenesisBeaconState(
 	state.CurrentShufflingSeedHash32 = seed[:]
 	return state, nil
 }
+
+// GenerateGenesisState builds the genesis state of a chain started without a deposit
+// contract, in which each of the given deposit inputs deposited the maximum deposit
+// amount at genesis, along with the root of its genesis block. Unlike the deposits of
+// a deposit contract, which GenesisBeaconState skips if they are invalid, an error is
+// returned if the proof of possession of any deposit input does not verify.
+func GenerateGenesisState(depositInputs []*pb.DepositInput, genesisTime uint64) (*pb.GenesisState, error) {
+	deposits := make([]*pb.Deposit, len(depositInputs))
+	for i, depositInput := range depositInputs {
+		if err := v.VerifyProofOfPossession(depositInput); err != nil {
+			return nil, fmt.Errorf("invalid proof of possession of genesis deposit %d with public key %#x: %v",
+				i, depositInput.Pubkey, err)
+		}
+		data, err := helpers.EncodeDepositData(depositInput, params.BeaconConfig().MaxDepositAmount, int64(genesisTime))
+		if err != nil {
+			return nil, fmt.Errorf("could not encode deposit data: %v", err)
+		}
+		deposits[i] = &pb.Deposit{DepositData: data, MerkleTreeIndex: uint64(i)}
+	}
+	eth1Data := &pb.Eth1Data{
+		DepositRootHash32: params.BeaconConfig().ZeroHash[:],
+		BlockHash32:       params.BeaconConfig().ZeroHash[:],
+	}
+	beaconState, err := GenesisBeaconState(deposits, genesisTime, eth1Data)
+	if err != nil {
+		return nil, err
+	}
+	blockRoot, err := GenesisBlockRoot(beaconState)
+	if err != nil {
+		return nil, fmt.Errorf("could not compute genesis block root: %v", err)
+	}
+	return &pb.GenesisState{
+		State:                  beaconState,
+		GenesisBlockRootHash32: blockRoot[:],
+	}, nil
+}
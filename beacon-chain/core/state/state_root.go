@@ -0,0 +1,31 @@
+package state
+
+import (
+	"fmt"
+
+	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/ssz"
+)
+
+// stateRootCache is shared by every state root computation so that computing
+// the root of a state derived from a recently hashed one, such as the head
+// state after a block, only rehashes the validators and lists which changed.
+var stateRootCache = ssz.NewTreeHashCache()
+
+// StateRoot computes the tree hash root of the beacon state. The beacon state
+// is treated as read-only and may be a state shared with other copies.
+func StateRoot(beaconState *pb.BeaconState) ([32]byte, error) {
+	return stateRootCache.TreeHash(beaconState)
+}
+
+// GenesisBlockRoot computes the root of the genesis block which commits
+// to the given genesis state.
+func GenesisBlockRoot(genesisState *pb.BeaconState) ([32]byte, error) {
+	stateRoot, err := StateRoot(genesisState)
+	if err != nil {
+		return [32]byte{}, fmt.Errorf("could not compute state root: %v", err)
+	}
+	return hashutil.HashBeaconBlock(b.NewGenesisBlock(stateRoot[:]))
+}
@@ -0,0 +1,109 @@
+package state_test
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/ssz"
+)
+
+func TestStateRoot_MatchesTreeHashAcrossTransitions(t *testing.T) {
+	deposits, _ := setupInitialDeposits(t, params.BeaconConfig().SlotsPerEpoch)
+	beaconState, err := state.GenesisBeaconState(deposits, uint64(0), &pb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := uint64(1); i <= params.BeaconConfig().SlotsPerEpoch; i++ {
+		beaconState, err = state.ExecuteStateTransition(
+			context.Background(), beaconState, sharedStateTestBlock(beaconState.Slot+1), [32]byte{}, false, /* no sig verify */
+		)
+		if err != nil {
+			t.Fatalf("Could not execute state transition: %v", err)
+		}
+		beaconState.ValidatorBalances[i%uint64(len(beaconState.ValidatorBalances))]++
+
+		want, err := ssz.TreeHash(beaconState)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := state.StateRoot(beaconState)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Fatalf("Slot %d: wanted state root %#x, received %#x", beaconState.Slot, want, got)
+		}
+	}
+}
+
+func TestTreeHashProof_VerifiesAgainstStateRoot(t *testing.T) {
+	deposits, _ := setupInitialDeposits(t, params.BeaconConfig().SlotsPerEpoch)
+	beaconState, err := state.GenesisBeaconState(deposits, uint64(0), &pb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	beaconState, err = state.ExecuteStateTransition(
+		context.Background(), beaconState, sharedStateTestBlock(beaconState.Slot+1), [32]byte{}, false, /* no sig verify */
+	)
+	if err != nil {
+		t.Fatalf("Could not execute state transition: %v", err)
+	}
+	exitEpoch := params.BeaconConfig().GenesisEpoch + 3
+	beaconState.ValidatorRegistry[42].ExitEpoch = exitEpoch
+	root, err := state.StateRoot(beaconState)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	leaf, proof, err := ssz.TreeHashProof(beaconState, "validator_registry[42].exit_epoch")
+	if err != nil {
+		t.Fatalf("Could not prove exit epoch: %v", err)
+	}
+	if !ssz.VerifyTreeHashProof((*pb.BeaconState)(nil), "validator_registry[42].exit_epoch", root, leaf, proof) {
+		t.Error("Expected the proof of the exit epoch to verify against the state root")
+	}
+	if binary.LittleEndian.Uint64(leaf) != exitEpoch {
+		t.Errorf("Expected exit epoch %d, received %d", exitEpoch, binary.LittleEndian.Uint64(leaf))
+	}
+	if ssz.VerifyTreeHashProof((*pb.BeaconState)(nil), "validator_registry[43].exit_epoch", root, leaf, proof) {
+		t.Error("Expected the proof not to verify for the exit epoch of another validator")
+	}
+
+	for _, path := range []string{
+		"slot",
+		"validator_registry.__len__",
+		"validator_registry[0]",
+		"validator_registry[63].status_flags",
+		"validator_balances[17]",
+		"latest_randao_mixes[1]",
+		"latest_crosslinks[5].epoch",
+		"latest_block_root_hash32s[0]",
+	} {
+		leaf, proof, err := ssz.TreeHashProof(beaconState, path)
+		if err != nil {
+			t.Errorf("Could not prove %q: %v", path, err)
+			continue
+		}
+		if !ssz.VerifyTreeHashProof((*pb.BeaconState)(nil), path, root, leaf, proof) {
+			t.Errorf("Expected the proof of %q to verify against the state root", path)
+		}
+	}
+}
+
+func BenchmarkStateRoot(b *testing.B) {
+	beaconState := benchmarkGenesisState(b)
+	if _, err := state.StateRoot(beaconState); err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		beaconState.ValidatorBalances[i%len(beaconState.ValidatorBalances)]++
+		if _, err := state.StateRoot(beaconState); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
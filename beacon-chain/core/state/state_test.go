@@ -3,8 +3,9 @@ package state_test
 import (
 	"bytes"
 	"encoding/binary"
+	"fmt"
 	"reflect"
-	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/interop"
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
@@ -52,7 +54,6 @@ func TestGenesisBeaconState_OK(t *testing.T) {
 	if params.BeaconConfig().DepositsForChainStart != 16384 {
 		t.Error("DepositsForChainStart should be 16384 for these tests to pass")
 	}
-	depositsForChainStart := int(params.BeaconConfig().DepositsForChainStart)
 
 	if params.BeaconConfig().LatestSlashedExitLength != 8192 {
 		t.Error("LatestSlashedExitLength should be 8192 for these tests to pass")
@@ -62,14 +63,25 @@ func TestGenesisBeaconState_OK(t *testing.T) {
 	genesisTime := uint64(99999)
 	processedPowReceiptRoot := []byte{'A', 'B', 'C'}
 	maxDeposit := params.BeaconConfig().MaxDepositAmount
+	// Verifying the proofs of possession of DepositsForChainStart deposits
+	// takes too long, so we start the chain with fewer deposits, one of which
+	// has an invalid proof of possession.
+	numValidators := 64
+	keys, err := interop.DeterministicallyGenerateKeys(0, uint64(numValidators))
+	if err != nil {
+		t.Fatal(err)
+	}
+	depositInputs, err := interop.GenerateDepositInputs(keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	invalidDepositInput := *depositInputs[0]
+	invalidDepositInput.Pubkey = []byte{'A'}
+	depositInputs = append(depositInputs, &invalidDepositInput)
 	var deposits []*pb.Deposit
-	for i := 0; i < depositsForChainStart; i++ {
+	for _, depositInput := range depositInputs {
 		depositData, err := helpers.EncodeDepositData(
-			&pb.DepositInput{
-				Pubkey:                      []byte(strconv.Itoa(i)),
-				ProofOfPossession:           []byte{'B'},
-				WithdrawalCredentialsHash32: []byte{'C'},
-			},
+			depositInput,
 			maxDeposit,
 			time.Now().Unix(),
 		)
@@ -113,10 +125,10 @@ func TestGenesisBeaconState_OK(t *testing.T) {
 	if newState.ValidatorRegistryUpdateEpoch != params.BeaconConfig().GenesisEpoch {
 		t.Error("ValidatorRegistryUpdateSlot was not correctly initialized")
 	}
-	if len(newState.ValidatorRegistry) != depositsForChainStart {
+	if len(newState.ValidatorRegistry) != numValidators {
 		t.Error("ValidatorRegistry was not correctly initialized")
 	}
-	if len(newState.ValidatorBalances) != depositsForChainStart {
+	if len(newState.ValidatorBalances) != numValidators {
 		t.Error("ValidatorBalances was not correctly initialized")
 	}
 
@@ -218,3 +230,49 @@ func TestGenesisState_InitializesLatestBlockHashes(t *testing.T) {
 		}
 	}
 }
+
+func TestGenerateGenesisState_ActivatesDepositors(t *testing.T) {
+	keys, err := interop.DeterministicallyGenerateKeys(0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	depositInputs, err := interop.GenerateDepositInputs(keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesisTime := uint64(time.Now().Unix())
+	genesis, err := state.GenerateGenesisState(depositInputs, genesisTime)
+	if err != nil {
+		t.Fatalf("Could not generate genesis state: %v", err)
+	}
+	if genesis.State.GenesisTime != genesisTime {
+		t.Errorf("Expected genesis time %d, received %d", genesisTime, genesis.State.GenesisTime)
+	}
+	active := helpers.ActiveValidatorIndices(genesis.State.ValidatorRegistry, params.BeaconConfig().GenesisEpoch)
+	if len(active) != len(depositInputs) {
+		t.Errorf("Expected %d active validators, received %d", len(depositInputs), len(active))
+	}
+	blockRoot, err := state.GenesisBlockRoot(genesis.State)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(genesis.GenesisBlockRootHash32, blockRoot[:]) {
+		t.Errorf("Expected genesis block root %#x, received %#x", blockRoot, genesis.GenesisBlockRootHash32)
+	}
+}
+
+func TestGenerateGenesisState_RejectsInvalidProofOfPossession(t *testing.T) {
+	keys, err := interop.DeterministicallyGenerateKeys(0, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	depositInputs, err := interop.GenerateDepositInputs(keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	depositInputs[1].ProofOfPossession = depositInputs[0].ProofOfPossession
+	want := fmt.Sprintf("invalid proof of possession of genesis deposit 1 with public key %#x", depositInputs[1].Pubkey)
+	if _, err := state.GenerateGenesisState(depositInputs, uint64(time.Now().Unix())); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error containing %q, received %v", want, err)
+	}
+}
@@ -64,6 +64,62 @@ func ExecuteStateTransition(
 	return state, nil
 }
 
+// ExecuteSharedStateTransition runs the same state transition as ExecuteStateTransition
+// on a copy-on-write beacon state. Fields shared with other copies of the state are only
+// copied right before the part of the transition which writes to them, so a block
+// without slashings, deposits or exits outside of an epoch boundary only copies the
+// RANDAO mixes.
+func ExecuteSharedStateTransition(
+	ctx context.Context,
+	st *BeaconState,
+	block *pb.BeaconBlock,
+	headRoot [32]byte,
+	verifySignatures bool,
+) (*BeaconState, error) {
+	st.lock.Lock()
+	defer st.lock.Unlock()
+	var err error
+
+	// Execute per slot transition, it only writes to fields
+	// which are never shared.
+	st.state = ProcessSlot(ctx, st.state, headRoot)
+
+	// Execute per block transition.
+	if block != nil {
+		st.detach(latestRandaoMixes)
+		if writesValidatorRegistry(block) {
+			st.detach(validatorRegistry, validatorBalances)
+		}
+		st.state, err = ProcessBlock(ctx, st.state, block, verifySignatures)
+		if err != nil {
+			return nil, fmt.Errorf("could not process block: %v", err)
+		}
+	}
+
+	// Execute per epoch transition.
+	if e.CanProcessEpoch(st.state) {
+		st.detach(sharedFields...)
+		st.state, err = ProcessEpoch(ctx, st.state)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not process epoch: %v", err)
+	}
+
+	return st, nil
+}
+
+// writesValidatorRegistry returns true if processing the block operations
+// modifies the validator registry or the validator balances.
+func writesValidatorRegistry(block *pb.BeaconBlock) bool {
+	if block.Body == nil {
+		return false
+	}
+	return len(block.Body.ProposerSlashings) > 0 ||
+		len(block.Body.AttesterSlashings) > 0 ||
+		len(block.Body.Deposits) > 0 ||
+		len(block.Body.VoluntaryExits) > 0
+}
+
 // ProcessSlot happens every slot and focuses on the slot counter and block roots record updates.
 // It happens regardless if there's an incoming block or not.
 //
@@ -111,8 +167,7 @@ func ProcessBlock(
 
 	// Verify block signature.
 	if verifySignatures {
-		// TODO(#781): Verify Proposer Signature.
-		if err := b.VerifyProposerSignature(ctx, block); err != nil {
+		if err := b.VerifyProposerSignature(ctx, state, block); err != nil {
 			return nil, fmt.Errorf("could not verify proposer signature: %v", err)
 		}
 	}
@@ -420,6 +475,9 @@ func ProcessEpoch(ctx context.Context, state *pb.BeaconState) (*pb.BeaconState,
 		return nil, fmt.Errorf("could not update latest randao mixes: %v", err)
 	}
 
+	// Rotate the fork if a fork is scheduled to activate at the next epoch.
+	state = e.ProcessForkSchedule(ctx, state)
+
 	// Clean up processed attestations.
 	state = e.CleanupAttestations(ctx, state)
 
@@ -9,12 +9,15 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
+	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/shared/forkutil"
 
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/interop"
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
@@ -26,9 +29,11 @@ func setupInitialDeposits(t *testing.T, numDeposits uint64) ([]*pb.Deposit, []*b
 		if err != nil {
 			t.Fatal(err)
 		}
-		depositInput := &pb.DepositInput{
-			Pubkey: priv.PublicKey().Marshal(),
+		depositInputs, err := interop.GenerateDepositInputs([]*bls.SecretKey{priv})
+		if err != nil {
+			t.Fatal(err)
 		}
+		depositInput := depositInputs[0]
 		balance := params.BeaconConfig().MaxDepositAmount
 		depositData, err := helpers.EncodeDepositData(depositInput, balance, time.Now().Unix())
 		if err != nil {
@@ -561,3 +566,55 @@ func TestProcessEpoch_CantGetCurrentValidatorIndices(t *testing.T) {
 		t.Errorf("Expected: %s, received: %v", wanted, err)
 	}
 }
+
+func TestExecuteStateTransition_RotatesScheduledFork(t *testing.T) {
+	defer params.OverrideBeaconConfig(params.BeaconConfig())
+	cfg := *params.BeaconConfig()
+	cfg.ForkSchedule = map[uint64]uint64{1: 1}
+	params.OverrideBeaconConfig(&cfg)
+
+	deposits, privKeys := setupInitialDeposits(t, cfg.SlotsPerEpoch)
+	beaconState, err := state.GenesisBeaconState(deposits, uint64(0), &pb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesisFork := beaconState.Fork
+	for beaconState.Slot < cfg.GenesisSlot+cfg.SlotsPerEpoch-1 {
+		beaconState, err = state.ExecuteStateTransition(context.Background(), beaconState, nil, [32]byte{}, false)
+		if err != nil {
+			t.Fatalf("Could not execute state transition: %v", err)
+		}
+	}
+	want := &pb.Fork{
+		PreviousVersion: cfg.GenesisForkVersion,
+		CurrentVersion:  1,
+		Epoch:           cfg.GenesisEpoch + 1,
+	}
+	if !proto.Equal(beaconState.Fork, want) {
+		t.Fatalf("Wanted fork %v after the epoch transition, received %v", want, beaconState.Fork)
+	}
+
+	// A validator only holding the fork from before the boundary must sign
+	// with the domain of the scheduled fork.
+	beaconState = state.ProcessSlot(context.Background(), beaconState, [32]byte{})
+	epoch := helpers.CurrentEpoch(beaconState)
+	proposerIdx, err := helpers.BeaconProposerIndex(beaconState, beaconState.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 32)
+	binary.LittleEndian.PutUint64(buf, epoch)
+	sign := func(fork *pb.Fork) []byte {
+		domain := forkutil.DomainVersion(fork, epoch, params.BeaconConfig().DomainRandao)
+		return privKeys[proposerIdx].Sign(buf, domain).Marshal()
+	}
+
+	block := &pb.BeaconBlock{Slot: beaconState.Slot, RandaoReveal: sign(genesisFork)}
+	if _, err := b.ProcessBlockRandao(context.Background(), proto.Clone(beaconState).(*pb.BeaconState), block, true); err == nil {
+		t.Error("Expected randao signed with the domain of the previous fork to be rejected")
+	}
+	block.RandaoReveal = sign(forkutil.ScheduledFork(genesisFork, epoch))
+	if _, err := b.ProcessBlockRandao(context.Background(), beaconState, block, true); err != nil {
+		t.Errorf("Could not verify randao signed with the domain of the scheduled fork: %v", err)
+	}
+}
@@ -91,21 +91,64 @@ func AttestingValidatorIndices(
 	return validatorIndicesCommittees, nil
 }
 
+// InvalidDepositError is returned by ProcessDeposit for a deposit which it
+// skipped, leaving the beacon state unchanged, because its proof of possession
+// did not verify. The deposit is still part of the deposit contract's Merkle
+// tree, so a block including it remains valid.
+type InvalidDepositError struct {
+	Pubkey []byte
+	Reason error
+}
+
+func (e *InvalidDepositError) Error() string {
+	return fmt.Sprintf("invalid proof of possession for public key %#x: %v", e.Pubkey, e.Reason)
+}
+
+// VerifyProofOfPossession checks that the proof of possession of a deposit
+// input is a signature of its signing root by the deposited public key. The
+// domain does not depend on the fork, as the depositor signs before knowing
+// in which fork the deposit gets processed.
+//
+// Spec pseudocode definition:
+//   bls_verify(
+//     pubkey=pubkey,
+//     message_hash=signed_root(deposit_input),
+//     signature=proof_of_possession,
+//     domain=get_domain(state.fork, get_current_epoch(state), DOMAIN_DEPOSIT),
+//   )
+func VerifyProofOfPossession(depositInput *pb.DepositInput) error {
+	return helpers.VerifySigningRoot(
+		depositInput,
+		depositInput.Pubkey,
+		depositInput.ProofOfPossession,
+		params.BeaconConfig().DomainDeposit,
+	)
+}
+
 // ProcessDeposit mutates a corresponding index in the beacon state for
 // a validator depositing ETH into the beacon chain. Specifically, this function
 // adds a validator balance or tops up an existing validator's balance
 // by some deposit amount. This function returns a mutated beacon state and
 // the validator index corresponding to the validator in the processed
-// deposit.
+// deposit. A deposit with an invalid proof of possession returns the
+// unchanged state together with an *InvalidDepositError, which callers should
+// record and skip rather than treat as fatal.
 func ProcessDeposit(
 	state *pb.BeaconState,
 	validatorIdxMap map[[32]byte]int,
 	pubkey []byte,
 	amount uint64,
-	_ /*proofOfPossession*/ []byte,
+	proofOfPossession []byte,
 	withdrawalCredentials []byte,
 ) (*pb.BeaconState, error) {
-	// TODO(#258): Validate proof of possession using BLS.
+	if err := VerifyProofOfPossession(&pb.DepositInput{
+		Pubkey:                      pubkey,
+		ProofOfPossession:           proofOfPossession,
+		WithdrawalCredentialsHash32: withdrawalCredentials,
+	}); err != nil {
+		return state, &InvalidDepositError{Pubkey: pubkey, Reason: err}
+	}
+
 	var publicKeyExists bool
 	var existingValidatorIdx int
 
@@ -114,12 +157,13 @@ func ProcessDeposit(
 		// If public key does not exist in the registry, we add a new validator
 		// to the beacon state.
 		newValidator := &pb.Validator{
-			Pubkey:          pubkey,
-			ActivationEpoch: params.BeaconConfig().FarFutureEpoch,
-			ExitEpoch:       params.BeaconConfig().FarFutureEpoch,
-			WithdrawalEpoch: params.BeaconConfig().FarFutureEpoch,
-			SlashedEpoch:    params.BeaconConfig().FarFutureEpoch,
-			StatusFlags:     0,
+			Pubkey:                      pubkey,
+			WithdrawalCredentialsHash32: withdrawalCredentials,
+			ActivationEpoch:             params.BeaconConfig().FarFutureEpoch,
+			ExitEpoch:                   params.BeaconConfig().FarFutureEpoch,
+			WithdrawalEpoch:             params.BeaconConfig().FarFutureEpoch,
+			SlashedEpoch:                params.BeaconConfig().FarFutureEpoch,
+			StatusFlags:                 0,
 		}
 		state.ValidatorRegistry = append(state.ValidatorRegistry, newValidator)
 		state.ValidatorBalances = append(state.ValidatorBalances, amount)
@@ -1,7 +1,9 @@
 package validators
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
 	"fmt"
 	"reflect"
 	"strings"
@@ -11,7 +13,9 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/stateutils"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bitutil"
+	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/ssz"
 )
 
 func TestHasVoted_OK(t *testing.T) {
@@ -179,21 +183,22 @@ func TestAllValidatorIndices_OK(t *testing.T) {
 }
 
 func TestProcessDeposit_BadWithdrawalCredentials(t *testing.T) {
+	depositInput := signedDepositInput(t, []byte{1})
 	registry := []*pb.Validator{
 		{
 			Pubkey: []byte{1, 2, 3},
 		},
 		{
-			Pubkey:                      []byte{4, 5, 6},
+			Pubkey:                      depositInput.Pubkey,
 			WithdrawalCredentialsHash32: []byte{0},
 		},
 	}
 	beaconState := &pb.BeaconState{
 		ValidatorRegistry: registry,
 	}
-	pubkey := []byte{4, 5, 6}
+	pubkey := depositInput.Pubkey
 	deposit := uint64(1000)
-	proofOfPossession := []byte{}
+	proofOfPossession := depositInput.ProofOfPossession
 	withdrawalCredentials := []byte{1}
 
 	want := "expected withdrawal credentials to match"
@@ -210,12 +215,13 @@ func TestProcessDeposit_BadWithdrawalCredentials(t *testing.T) {
 }
 
 func TestProcessDeposit_GoodWithdrawalCredentials(t *testing.T) {
+	depositInput := signedDepositInput(t, []byte{1})
 	registry := []*pb.Validator{
 		{
 			Pubkey: []byte{1, 2, 3},
 		},
 		{
-			Pubkey:                      []byte{4, 5, 6},
+			Pubkey:                      depositInput.Pubkey,
 			WithdrawalCredentialsHash32: []byte{1},
 		},
 	}
@@ -224,9 +230,9 @@ func TestProcessDeposit_GoodWithdrawalCredentials(t *testing.T) {
 		ValidatorBalances: balances,
 		ValidatorRegistry: registry,
 	}
-	pubkey := []byte{4, 5, 6}
+	pubkey := depositInput.Pubkey
 	deposit := uint64(1000)
-	proofOfPossession := []byte{}
+	proofOfPossession := depositInput.ProofOfPossession
 	withdrawalCredentials := []byte{1}
 
 	newState, err := ProcessDeposit(
@@ -246,6 +252,7 @@ func TestProcessDeposit_GoodWithdrawalCredentials(t *testing.T) {
 }
 
 func TestProcessDeposit_PublicKeyDoesNotExist(t *testing.T) {
+	depositInput := signedDepositInput(t, []byte{1})
 	registry := []*pb.Validator{
 		{
 			Pubkey:                      []byte{1, 2, 3},
@@ -261,9 +268,9 @@ func TestProcessDeposit_PublicKeyDoesNotExist(t *testing.T) {
 		ValidatorBalances: balances,
 		ValidatorRegistry: registry,
 	}
-	pubkey := []byte{7, 8, 9}
+	pubkey := depositInput.Pubkey
 	deposit := uint64(2000)
-	proofOfPossession := []byte{}
+	proofOfPossession := depositInput.ProofOfPossession
 	withdrawalCredentials := []byte{1}
 
 	newState, err := ProcessDeposit(
@@ -286,6 +293,7 @@ func TestProcessDeposit_PublicKeyDoesNotExist(t *testing.T) {
 }
 
 func TestProcessDeposit_PublicKeyDoesNotExistAndEmptyValidator(t *testing.T) {
+	depositInput := signedDepositInput(t, []byte{1})
 	registry := []*pb.Validator{
 		{
 			Pubkey:                      []byte{1, 2, 3},
@@ -302,9 +310,9 @@ func TestProcessDeposit_PublicKeyDoesNotExistAndEmptyValidator(t *testing.T) {
 		ValidatorBalances: balances,
 		ValidatorRegistry: registry,
 	}
-	pubkey := []byte{7, 8, 9}
+	pubkey := depositInput.Pubkey
 	deposit := uint64(2000)
-	proofOfPossession := []byte{}
+	proofOfPossession := depositInput.ProofOfPossession
 	withdrawalCredentials := []byte{1}
 
 	newState, err := ProcessDeposit(
@@ -326,6 +334,53 @@ func TestProcessDeposit_PublicKeyDoesNotExistAndEmptyValidator(t *testing.T) {
 	}
 }
 
+func TestProcessDeposit_InvalidProofOfPossession(t *testing.T) {
+	beaconState := &pb.BeaconState{
+		ValidatorBalances: []uint64{},
+		ValidatorRegistry: []*pb.Validator{},
+	}
+	depositInput := signedDepositInput(t, []byte{1})
+	other := signedDepositInput(t, []byte{1})
+
+	newState, err := ProcessDeposit(
+		beaconState,
+		stateutils.ValidatorIndexMap(beaconState),
+		depositInput.Pubkey,
+		1000,
+		other.ProofOfPossession,
+		depositInput.WithdrawalCredentialsHash32,
+	)
+	invalid, ok := err.(*InvalidDepositError)
+	if !ok {
+		t.Fatalf("Expected an invalid deposit error, received %v", err)
+	}
+	if !bytes.Equal(invalid.Pubkey, depositInput.Pubkey) {
+		t.Errorf("Expected public key %#x, received %#x", depositInput.Pubkey, invalid.Pubkey)
+	}
+	if newState != beaconState || len(newState.ValidatorRegistry) != 0 {
+		t.Error("Expected the state to be returned unchanged")
+	}
+}
+
+// signedDepositInput returns a deposit input of a new key with the given
+// withdrawal credentials and a valid proof of possession.
+func signedDepositInput(t *testing.T, withdrawalCredentials []byte) *pb.DepositInput {
+	priv, err := bls.RandKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	depositInput := &pb.DepositInput{
+		Pubkey:                      priv.PublicKey().Marshal(),
+		WithdrawalCredentialsHash32: withdrawalCredentials,
+	}
+	root, err := ssz.SigningRoot(depositInput)
+	if err != nil {
+		t.Fatal(err)
+	}
+	depositInput.ProofOfPossession = priv.Sign(root[:], params.BeaconConfig().DomainDeposit).Marshal()
+	return depositInput
+}
+
 func TestActivateValidatorGenesis_OK(t *testing.T) {
 	state := &pb.BeaconState{
 		ValidatorRegistry: []*pb.Validator{
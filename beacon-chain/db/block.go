@@ -8,6 +8,7 @@ import (
 
 	"github.com/boltdb/bolt"
 	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 )
 
@@ -179,7 +180,7 @@ func (db *BeaconDB) UpdateChainHead(block *pb.BeaconBlock, beaconState *pb.Beaco
 	if !ok {
 		return errors.New("could not clone beacon state")
 	}
-	db.currentState = currentState
+	db.currentState = state.InitializeFromProto(currentState)
 
 	slotBinary := encodeSlotNumber(block.Slot)
 
@@ -1,6 +1,8 @@
 package db
 
 import (
+	"fmt"
+
 	"github.com/boltdb/bolt"
 	"github.com/gogo/protobuf/proto"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -35,3 +37,33 @@ func (db *BeaconDB) HasExit(hash [32]byte) bool {
 	}
 	return exists
 }
+
+// Exits retrieves all the exit requests from the db. These are the exits which have
+// not been seen on the beacon chain.
+func (db *BeaconDB) Exits() ([]*pb.VoluntaryExit, error) {
+	var exits []*pb.VoluntaryExit
+	err := db.view(func(tx *bolt.Tx) error {
+		b := tx.Bucket(blockOperationsBucket)
+		return b.ForEach(func(k, v []byte) error {
+			exit := &pb.VoluntaryExit{}
+			if err := proto.Unmarshal(v, exit); err != nil {
+				return fmt.Errorf("failed to unmarshal encoding: %v", err)
+			}
+			exits = append(exits, exit)
+			return nil
+		})
+	})
+	return exits, err
+}
+
+// DeleteExit deletes the exit request from the db.
+func (db *BeaconDB) DeleteExit(exit *pb.VoluntaryExit) error {
+	hash, err := hashutil.HashProto(exit)
+	if err != nil {
+		return err
+	}
+	return db.update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(blockOperationsBucket)
+		return b.Delete(hash[:])
+	})
+}
@@ -30,3 +30,36 @@ func TestBeaconDB_HasExit(t *testing.T) {
 		t.Fatal("Expected HasExit to return true")
 	}
 }
+
+func TestBeaconDB_ExitsAndDeleteExit(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	exits := []*pb.VoluntaryExit{
+		{Epoch: 100, ValidatorIndex: 1},
+		{Epoch: 100, ValidatorIndex: 2},
+	}
+	for _, exit := range exits {
+		if err := db.SaveExit(exit); err != nil {
+			t.Fatalf("Failed to save exit request: %v", err)
+		}
+	}
+	saved, err := db.Exits()
+	if err != nil {
+		t.Fatalf("Could not retrieve exits: %v", err)
+	}
+	if len(saved) != 2 {
+		t.Fatalf("Expected 2 exits, received %d", len(saved))
+	}
+
+	if err := db.DeleteExit(exits[0]); err != nil {
+		t.Fatalf("Could not delete exit: %v", err)
+	}
+	saved, err = db.Exits()
+	if err != nil {
+		t.Fatalf("Could not retrieve exits: %v", err)
+	}
+	if len(saved) != 1 || saved[0].ValidatorIndex != 2 {
+		t.Errorf("Expected only the exit of validator 2 to remain, received %v", saved)
+	}
+}
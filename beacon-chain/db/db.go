@@ -8,7 +8,7 @@ import (
 	"time"
 
 	"github.com/boltdb/bolt"
-	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/sirupsen/logrus"
 )
 
@@ -21,13 +21,14 @@ var log = logrus.WithField("prefix", "beacondb")
 // This defines methods such as getBlock, saveBlocksAndAttestations, etc.
 type BeaconDB struct {
 	stateLock    sync.RWMutex
-	currentState *pb.BeaconState
+	currentState *state.BeaconState
 	db           *bolt.DB
 	DatabasePath string
 
 	// Beacon chain deposits in memory.
 	pendingDeposits []*depositContainer
 	deposits        []*depositContainer
+	invalidDeposits []*InvalidDeposit
 	depositsLock    sync.RWMutex
 }
 
@@ -0,0 +1,65 @@
+package db
+
+import (
+	"context"
+	"math/big"
+	"sort"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+var (
+	invalidDepositsCount = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "beacondb_invalid_deposits",
+		Help: "The number of deposits with an invalid proof of possession in the beaconDB in-memory database",
+	})
+)
+
+// InvalidDeposit is a deposit from the deposit contract which does not
+// register a validator, together with the block in which the deposit
+// transaction was included and the reason the deposit is invalid.
+type InvalidDeposit struct {
+	Deposit *pb.Deposit
+	Block   *big.Int
+	Reason  string
+}
+
+// InsertInvalidDeposit records a deposit which does not register a validator.
+// The deposit should still be inserted as a deposit, as it is part of the
+// deposit contract's Merkle tree. If deposit or block number are nil then
+// this method does nothing.
+func (db *BeaconDB) InsertInvalidDeposit(ctx context.Context, d *pb.Deposit, blockNum *big.Int, reason string) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.InsertInvalidDeposit")
+	defer span.End()
+	if d == nil || blockNum == nil {
+		log.WithFields(logrus.Fields{
+			"block":   blockNum,
+			"deposit": d,
+		}).Debug("Ignoring nil invalid deposit insertion")
+		return
+	}
+	db.depositsLock.Lock()
+	defer db.depositsLock.Unlock()
+	db.invalidDeposits = append(db.invalidDeposits, &InvalidDeposit{Deposit: d, Block: blockNum, Reason: reason})
+	invalidDepositsCount.Inc()
+}
+
+// InvalidDeposits returns all the recorded invalid deposits, sorted by Merkle
+// index.
+func (db *BeaconDB) InvalidDeposits(ctx context.Context) []*InvalidDeposit {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.InvalidDeposits")
+	defer span.End()
+	db.depositsLock.RLock()
+	defer db.depositsLock.RUnlock()
+
+	deposits := make([]*InvalidDeposit, len(db.invalidDeposits))
+	copy(deposits, db.invalidDeposits)
+	sort.SliceStable(deposits, func(i, j int) bool {
+		return deposits[i].Deposit.MerkleTreeIndex < deposits[j].Deposit.MerkleTreeIndex
+	})
+	return deposits
+}
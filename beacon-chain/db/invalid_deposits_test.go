@@ -0,0 +1,38 @@
+package db
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func TestInsertInvalidDeposit_OK(t *testing.T) {
+	db := BeaconDB{}
+	db.InsertInvalidDeposit(context.Background(), &pb.Deposit{MerkleTreeIndex: 5}, big.NewInt(111), "bad")
+	db.InsertInvalidDeposit(context.Background(), &pb.Deposit{MerkleTreeIndex: 2}, big.NewInt(110), "worse")
+
+	deposits := db.InvalidDeposits(context.Background())
+	if len(deposits) != 2 {
+		t.Fatalf("Expected 2 invalid deposits, received %d", len(deposits))
+	}
+	if deposits[0].Deposit.MerkleTreeIndex != 2 || deposits[1].Deposit.MerkleTreeIndex != 5 {
+		t.Error("Invalid deposits are not sorted by Merkle index")
+	}
+	if deposits[0].Reason != "worse" || deposits[0].Block.Int64() != 110 {
+		t.Errorf("Unexpected invalid deposit %+v", deposits[0])
+	}
+	if len(db.AllDeposits(context.Background(), nil)) != 0 {
+		t.Error("Invalid deposit was inserted as a deposit")
+	}
+}
+
+func TestInsertInvalidDeposit_IgnoresNilDeposit(t *testing.T) {
+	db := BeaconDB{}
+	db.InsertInvalidDeposit(context.Background(), nil /*deposit*/, nil /*blockNum*/, "")
+
+	if len(db.InvalidDeposits(context.Background())) > 0 {
+		t.Error("Unexpected invalid deposit insertion")
+	}
+}
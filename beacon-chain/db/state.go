@@ -23,18 +23,27 @@ func (db *BeaconDB) InitializeState(genesisTime uint64, deposits []*pb.Deposit,
 	if err != nil {
 		return err
 	}
+	return db.InitializeStateFromGenesis(beaconState)
+}
 
+// InitializeStateFromGenesis saves a genesis state generated ahead of time,
+// such as one loaded from a genesis file, along with its genesis block as the
+// head of the chain.
+func (db *BeaconDB) InitializeStateFromGenesis(beaconState *pb.BeaconState) error {
 	// #nosec G104
 	stateEnc, _ := proto.Marshal(beaconState)
-	stateHash := hashutil.Hash(stateEnc)
-	genesisBlock := b.NewGenesisBlock(stateHash[:])
+	stateRoot, err := state.StateRoot(beaconState)
+	if err != nil {
+		return fmt.Errorf("could not compute state root: %v", err)
+	}
+	genesisBlock := b.NewGenesisBlock(stateRoot[:])
 	// #nosec G104
 	blockRoot, _ := hashutil.HashBeaconBlock(genesisBlock)
 	// #nosec G104
 	blockEnc, _ := proto.Marshal(genesisBlock)
 	zeroBinary := encodeSlotNumber(0)
 
-	db.currentState = beaconState
+	db.currentState = state.InitializeFromProto(beaconState)
 
 	return db.update(func(tx *bolt.Tx) error {
 		blockBkt := tx.Bucket(blockBucket)
@@ -80,9 +89,7 @@ func (db *BeaconDB) State(ctx context.Context) (*pb.BeaconState, error) {
 	db.stateLock.RLock()
 	defer db.stateLock.RUnlock()
 	if db.currentState != nil {
-		if cachedState, ok := proto.Clone(db.currentState).(*pb.BeaconState); ok {
-			return cachedState, nil
-		}
+		return db.currentState.CloneInnerState(), nil
 	}
 
 	var beaconState *pb.BeaconState
@@ -101,6 +108,30 @@ func (db *BeaconDB) State(ctx context.Context) (*pb.BeaconState, error) {
 	return beaconState, err
 }
 
+// SharedState fetches a copy-on-write copy of the canonical beacon chain's state.
+// Unlike State, it does not deep copy the validator registry, balances and RANDAO
+// mixes, which makes it the cheaper choice for speculative state transitions.
+func (db *BeaconDB) SharedState(ctx context.Context) (*state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SharedState")
+	defer span.End()
+
+	db.stateLock.RLock()
+	if db.currentState != nil {
+		defer db.stateLock.RUnlock()
+		return db.currentState.Copy(), nil
+	}
+	db.stateLock.RUnlock()
+
+	beaconState, err := db.State(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if beaconState == nil {
+		return nil, nil
+	}
+	return state.InitializeFromProto(beaconState), nil
+}
+
 // SaveState updates the beacon chain state.
 func (db *BeaconDB) SaveState(beaconState *pb.BeaconState) error {
 	db.stateLock.Lock()
@@ -110,7 +141,7 @@ func (db *BeaconDB) SaveState(beaconState *pb.BeaconState) error {
 	if !ok {
 		return errors.New("could not clone beacon state")
 	}
-	db.currentState = currentState
+	db.currentState = state.InitializeFromProto(currentState)
 	return db.update(func(tx *bolt.Tx) error {
 		chainInfo := tx.Bucket(chainInfoBucket)
 		beaconStateEnc, err := proto.Marshal(beaconState)
@@ -152,7 +183,7 @@ func (db *BeaconDB) SaveCurrentAndFinalizedState(beaconState *pb.BeaconState) er
 	if !ok {
 		return errors.New("could not clone beacon state")
 	}
-	db.currentState = currentState
+	db.currentState = state.InitializeFromProto(currentState)
 	return db.update(func(tx *bolt.Tx) error {
 		chainInfo := tx.Bucket(chainInfoBucket)
 		beaconStateEnc, err := proto.Marshal(beaconState)
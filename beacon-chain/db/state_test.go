@@ -10,8 +10,11 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/interop"
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
@@ -23,9 +26,11 @@ func setupInitialDeposits(t testing.TB, numDeposits int) ([]*pb.Deposit, []*bls.
 		if err != nil {
 			t.Fatal(err)
 		}
-		depositInput := &pb.DepositInput{
-			Pubkey: priv.PublicKey().Marshal(),
+		depositInputs, err := interop.GenerateDepositInputs([]*bls.SecretKey{priv})
+		if err != nil {
+			t.Fatal(err)
 		}
+		depositInput := depositInputs[0]
 		balance := params.BeaconConfig().MaxDepositAmount
 		depositData, err := helpers.EncodeDepositData(depositInput, balance, time.Now().Unix())
 		if err != nil {
@@ -81,6 +86,44 @@ func TestInitializeState_OK(t *testing.T) {
 	}
 }
 
+func TestInitializeStateFromGenesis_OK(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	deposits, _ := setupInitialDeposits(t, 10)
+	genesisState, err := state.GenesisBeaconState(deposits, uint64(time.Now().Unix()), &pb.Eth1Data{})
+	if err != nil {
+		t.Fatalf("Could not generate genesis state: %v", err)
+	}
+	if err := db.InitializeStateFromGenesis(genesisState); err != nil {
+		t.Fatalf("Failed to initialize state: %v", err)
+	}
+
+	beaconState, err := db.State(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get state: %v", err)
+	}
+	if !proto.Equal(beaconState, genesisState) {
+		t.Error("Expected saved state to equal the genesis state")
+	}
+	head, err := db.ChainHead()
+	if err != nil {
+		t.Fatalf("Failed to get chain head: %v", err)
+	}
+	headRoot, err := hashutil.HashBeaconBlock(head)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantRoot, err := state.GenesisBlockRoot(genesisState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if headRoot != wantRoot {
+		t.Errorf("Expected chain head root %#x, received %#x", wantRoot, headRoot)
+	}
+}
+
 func TestGenesisTime_OK(t *testing.T) {
 	db := setupDB(t)
 	defer teardownDB(t, db)
@@ -201,7 +244,7 @@ func BenchmarkState_ReadingFromCache(b *testing.B) {
 		b.Fatalf("Could not save beacon state to cache from DB: %v", err)
 	}
 
-	if db.currentState.Slot != params.BeaconConfig().GenesisSlot+1 {
+	if db.currentState.Slot() != params.BeaconConfig().GenesisSlot+1 {
 		b.Fatal("cache should be prepared on state after saving to DB")
 	}
 
@@ -216,6 +259,48 @@ func BenchmarkState_ReadingFromCache(b *testing.B) {
 	}
 }
 
+func TestSharedState_MatchesState(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	deposits, _ := setupInitialDeposits(t, 10)
+	if err := db.InitializeState(uint64(time.Now().Unix()), deposits, &pb.Eth1Data{}); err != nil {
+		t.Fatalf("Failed to initialize state: %v", err)
+	}
+	beaconState, err := db.State(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get state: %v", err)
+	}
+	shared, err := db.SharedState(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get shared state: %v", err)
+	}
+	if !proto.Equal(beaconState, shared.InnerStateUnsafe()) {
+		t.Error("Expected shared state to equal the canonical state")
+	}
+}
+
+func BenchmarkSharedState_ReadingFromCache(b *testing.B) {
+	db := setupDB(b)
+	defer teardownDB(b, db)
+	ctx := context.Background()
+
+	deposits, _ := setupInitialDeposits(b, 10)
+	if err := db.InitializeState(uint64(time.Now().Unix()), deposits, &pb.Eth1Data{}); err != nil {
+		b.Fatalf("Failed to initialize state: %v", err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := db.SharedState(ctx); err != nil {
+			b.Fatalf("Could not read shared beacon state from cache: %v", err)
+		}
+	}
+}
+
 func TestFinalizedState_NoneExists(t *testing.T) {
 	db := setupDB(t)
 	defer teardownDB(t, db)
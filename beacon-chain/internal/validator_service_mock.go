@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1 (interfaces: ValidatorServiceServer,ValidatorService_WaitForActivationServer)
+// Source: github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1 (interfaces: ValidatorServiceServer,ValidatorService_StreamDutiesServer,ValidatorService_WaitForActivationServer)
 
 package internal
 
@@ -50,6 +50,35 @@ func (mr *MockValidatorServiceServerMockRecorder) CommitteeAssignment(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitteeAssignment", reflect.TypeOf((*MockValidatorServiceServer)(nil).CommitteeAssignment), arg0, arg1)
 }
 
+// StreamDuties mocks base method
+func (m *MockValidatorServiceServer) StreamDuties(arg0 *v1.DutiesRequest, arg1 v1.ValidatorService_StreamDutiesServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamDuties", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamDuties indicates an expected call of StreamDuties
+func (mr *MockValidatorServiceServerMockRecorder) StreamDuties(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamDuties", reflect.TypeOf((*MockValidatorServiceServer)(nil).StreamDuties), arg0, arg1)
+}
+
+// ValidatorActivity mocks base method
+func (m *MockValidatorServiceServer) ValidatorActivity(arg0 context.Context, arg1 *v1.ValidatorActivityRequest) (*v1.ValidatorActivityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidatorActivity", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ValidatorActivityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidatorActivity indicates an expected call of ValidatorActivity
+func (mr *MockValidatorServiceServerMockRecorder) ValidatorActivity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorActivity", reflect.TypeOf((*MockValidatorServiceServer)(nil).ValidatorActivity), arg0, arg1)
+}
+
 // ValidatorIndex mocks base method
 func (m *MockValidatorServiceServer) ValidatorIndex(arg0 context.Context, arg1 *v1.ValidatorIndexRequest) (*v1.ValidatorIndexResponse, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +94,21 @@ func (mr *MockValidatorServiceServerMockRecorder) ValidatorIndex(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorIndex", reflect.TypeOf((*MockValidatorServiceServer)(nil).ValidatorIndex), arg0, arg1)
 }
 
+// ValidatorPerformance mocks base method
+func (m *MockValidatorServiceServer) ValidatorPerformance(arg0 context.Context, arg1 *v1.ValidatorPerformanceRequest) (*v1.ValidatorPerformanceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidatorPerformance", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ValidatorPerformanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidatorPerformance indicates an expected call of ValidatorPerformance
+func (mr *MockValidatorServiceServerMockRecorder) ValidatorPerformance(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorPerformance", reflect.TypeOf((*MockValidatorServiceServer)(nil).ValidatorPerformance), arg0, arg1)
+}
+
 // ValidatorStatus mocks base method
 func (m *MockValidatorServiceServer) ValidatorStatus(arg0 context.Context, arg1 *v1.ValidatorIndexRequest) (*v1.ValidatorStatusResponse, error) {
 	m.ctrl.T.Helper()
@@ -94,6 +138,125 @@ func (mr *MockValidatorServiceServerMockRecorder) WaitForActivation(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForActivation", reflect.TypeOf((*MockValidatorServiceServer)(nil).WaitForActivation), arg0, arg1)
 }
 
+// MockValidatorService_StreamDutiesServer is a mock of ValidatorService_StreamDutiesServer interface
+type MockValidatorService_StreamDutiesServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockValidatorService_StreamDutiesServerMockRecorder
+}
+
+// MockValidatorService_StreamDutiesServerMockRecorder is the mock recorder for MockValidatorService_StreamDutiesServer
+type MockValidatorService_StreamDutiesServerMockRecorder struct {
+	mock *MockValidatorService_StreamDutiesServer
+}
+
+// NewMockValidatorService_StreamDutiesServer creates a new mock instance
+func NewMockValidatorService_StreamDutiesServer(ctrl *gomock.Controller) *MockValidatorService_StreamDutiesServer {
+	mock := &MockValidatorService_StreamDutiesServer{ctrl: ctrl}
+	mock.recorder = &MockValidatorService_StreamDutiesServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockValidatorService_StreamDutiesServer) EXPECT() *MockValidatorService_StreamDutiesServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method
+func (m *MockValidatorService_StreamDutiesServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockValidatorService_StreamDutiesServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockValidatorService_StreamDutiesServer)(nil).Context))
+}
+
+// RecvMsg mocks base method
+func (m *MockValidatorService_StreamDutiesServer) RecvMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockValidatorService_StreamDutiesServerMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockValidatorService_StreamDutiesServer)(nil).RecvMsg), arg0)
+}
+
+// Send mocks base method
+func (m *MockValidatorService_StreamDutiesServer) Send(arg0 *v1.DutiesResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send
+func (mr *MockValidatorService_StreamDutiesServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockValidatorService_StreamDutiesServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method
+func (m *MockValidatorService_StreamDutiesServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader
+func (mr *MockValidatorService_StreamDutiesServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockValidatorService_StreamDutiesServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method
+func (m *MockValidatorService_StreamDutiesServer) SendMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockValidatorService_StreamDutiesServerMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockValidatorService_StreamDutiesServer)(nil).SendMsg), arg0)
+}
+
+// SetHeader mocks base method
+func (m *MockValidatorService_StreamDutiesServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader
+func (mr *MockValidatorService_StreamDutiesServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockValidatorService_StreamDutiesServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method
+func (m *MockValidatorService_StreamDutiesServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer
+func (mr *MockValidatorService_StreamDutiesServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockValidatorService_StreamDutiesServer)(nil).SetTrailer), arg0)
+}
+
 // MockValidatorService_WaitForActivationServer is a mock of ValidatorService_WaitForActivationServer interface
 type MockValidatorService_WaitForActivationServer struct {
 	ctrl     *gomock.Controller
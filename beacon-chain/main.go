@@ -51,12 +51,16 @@ func main() {
 		utils.CertFlag,
 		utils.KeyFlag,
 		utils.GenesisJSON,
+		utils.InteropNumValidatorsFlag,
+		utils.InteropGenesisTimeFlag,
 		utils.EnableDBCleanup,
 		utils.ChainStartDelay,
 		cmd.BootstrapNode,
 		cmd.RelayNode,
 		cmd.P2PPort,
 		cmd.DataDirFlag,
+		cmd.ForkScheduleFlag,
+		cmd.BLSBackendFlag,
 		cmd.VerbosityFlag,
 		cmd.EnableTracingFlag,
 		cmd.TracingEndpointFlag,
@@ -0,0 +1,134 @@
+package node
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/utils"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/interop"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// hasPredefinedGenesis returns true if the node starts from a genesis state
+// given on the command line rather than from the deposit contract's ChainStart log.
+func hasPredefinedGenesis(ctx *cli.Context) bool {
+	return ctx.GlobalString(utils.GenesisJSON.Name) != "" ||
+		ctx.GlobalUint64(utils.InteropNumValidatorsFlag.Name) > 0
+}
+
+// initializeGenesisState saves the genesis state from a genesis file, as produced
+// by the genesis-state-gen tool, or an interop genesis state of deterministically
+// generated validators, unless the chain was already initialized.
+func (b *BeaconNode) initializeGenesisState(ctx *cli.Context) error {
+	genesisJSON := ctx.GlobalString(utils.GenesisJSON.Name)
+	numValidators := ctx.GlobalUint64(utils.InteropNumValidatorsFlag.Name)
+	if genesisJSON == "" && numValidators == 0 {
+		return nil
+	}
+	if genesisJSON != "" && numValidators > 0 {
+		return fmt.Errorf(
+			"--%s and --%s cannot be used together",
+			utils.GenesisJSON.Name,
+			utils.InteropNumValidatorsFlag.Name,
+		)
+	}
+
+	beaconState, err := b.db.State(context.Background())
+	if err != nil {
+		return fmt.Errorf("could not fetch beacon state: %v", err)
+	}
+	if beaconState != nil {
+		log.Info("Beacon chain data already exists, ignoring genesis state")
+		return nil
+	}
+
+	var genesis *pb.GenesisState
+	if genesisJSON != "" {
+		genesis, err = loadGenesisJSON(genesisJSON)
+		if err != nil {
+			return err
+		}
+	} else {
+		genesisTime := ctx.GlobalUint64(utils.InteropGenesisTimeFlag.Name)
+		if genesisTime == 0 {
+			genesisTime = uint64(time.Now().Unix())
+		}
+		genesis, err = interopGenesisState(numValidators, genesisTime)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := b.db.InitializeStateFromGenesis(genesis.State); err != nil {
+		return fmt.Errorf("could not initialize beacon state from genesis: %v", err)
+	}
+	log.WithFields(logrus.Fields{
+		"genesisTime":      genesis.State.GenesisTime,
+		"validators":       len(genesis.State.ValidatorRegistry),
+		"genesisBlockRoot": fmt.Sprintf("%#x", genesis.GenesisBlockRootHash32),
+	}).Info("Initialized beacon state from genesis")
+	return nil
+}
+
+// loadGenesisJSON reads a genesis file and verifies it was generated for this
+// node's beacon chain configuration.
+func loadGenesisJSON(genesisJSON string) (*pb.GenesisState, error) {
+	f, err := os.Open(genesisJSON)
+	if err != nil {
+		return nil, fmt.Errorf("could not open genesis file: %v", err)
+	}
+	defer f.Close()
+	genesis := &pb.GenesisState{}
+	if err := jsonpb.Unmarshal(f, genesis); err != nil {
+		return nil, fmt.Errorf("could not unmarshal genesis file: %v", err)
+	}
+	if genesis.State == nil {
+		return nil, fmt.Errorf("genesis file %s does not contain a genesis state", genesisJSON)
+	}
+
+	// The genesis state must be generated with the same beacon chain configuration
+	// as this node's, such as both with or without --demo-config.
+	if uint64(len(genesis.State.LatestRandaoMixes)) != params.BeaconConfig().LatestRandaoMixesLength ||
+		uint64(len(genesis.State.LatestCrosslinks)) != params.BeaconConfig().ShardCount {
+		return nil, errors.New("genesis file was generated with a different beacon chain configuration")
+	}
+	blockRoot, err := state.GenesisBlockRoot(genesis.State)
+	if err != nil {
+		return nil, fmt.Errorf("could not compute genesis block root: %v", err)
+	}
+	if !bytes.Equal(blockRoot[:], genesis.GenesisBlockRootHash32) {
+		return nil, fmt.Errorf(
+			"genesis block root %#x does not match root %#x in genesis file",
+			blockRoot,
+			genesis.GenesisBlockRootHash32,
+		)
+	}
+	return genesis, nil
+}
+
+// interopGenesisState generates a genesis state in which the validators are the
+// first numValidators deterministic interop keys.
+func interopGenesisState(numValidators uint64, genesisTime uint64) (*pb.GenesisState, error) {
+	keys, err := interop.DeterministicallyGenerateKeys(0, numValidators)
+	if err != nil {
+		return nil, fmt.Errorf("could not generate interop keys: %v", err)
+	}
+	depositInputs, err := interop.GenerateDepositInputs(keys)
+	if err != nil {
+		return nil, fmt.Errorf("could not generate interop deposit inputs: %v", err)
+	}
+	genesis, err := state.GenerateGenesisState(depositInputs, genesisTime)
+	if err != nil {
+		return nil, fmt.Errorf("could not generate interop genesis state: %v", err)
+	}
+	return genesis, nil
+}
@@ -23,6 +23,7 @@ import (
 	rbcsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	"github.com/prysmaticlabs/prysm/beacon-chain/utils"
 	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/debug"
 	"github.com/prysmaticlabs/prysm/shared/p2p"
@@ -75,10 +76,32 @@ func NewBeaconNode(ctx *cli.Context) (*BeaconNode, error) {
 		params.UseDemoBeaconConfig()
 	}
 
+	if schedule := ctx.GlobalString(cmd.ForkScheduleFlag.Name); schedule != "" {
+		forks, err := params.ParseForkSchedule(schedule)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse fork schedule: %v", err)
+		}
+		log.WithField("forkSchedule", forks).Info("Using custom fork schedule")
+		c := params.BeaconConfig()
+		c.ForkSchedule = forks
+		params.OverrideBeaconConfig(c)
+	}
+
+	if backend := ctx.GlobalString(cmd.BLSBackendFlag.Name); backend != "" {
+		if err := bls.SetBackend(backend); err != nil {
+			return nil, err
+		}
+		log.WithField("backend", backend).Info("Using custom BLS backend")
+	}
+
 	if err := beacon.startDB(ctx); err != nil {
 		return nil, err
 	}
 
+	if err := beacon.initializeGenesisState(ctx); err != nil {
+		return nil, err
+	}
+
 	if err := beacon.registerP2P(ctx); err != nil {
 		return nil, err
 	}
@@ -233,6 +256,21 @@ func (b *BeaconNode) registerPOWChainService(cliCtx *cli.Context) error {
 
 	depAddress := b.ctx.GlobalString(utils.DepositContractFlag.Name)
 
+	// A node started from a genesis file or an interop genesis state does not
+	// need the deposit contract to start the chain, so it can run without an
+	// ETH1.0 chain.
+	if depAddress == "" && hasPredefinedGenesis(b.ctx) {
+		beaconState, err := b.db.State(context.Background())
+		if err != nil {
+			return fmt.Errorf("could not fetch beacon state: %v", err)
+		}
+		web3Service, err := powchain.NewGenesisWeb3Service(context.Background(), beaconState.GenesisTime)
+		if err != nil {
+			return fmt.Errorf("could not register proof-of-work chain web3Service: %v", err)
+		}
+		return b.services.RegisterService(web3Service)
+	}
+
 	if depAddress == "" {
 		log.Fatal("No deposit contract specified. Add --deposit-contract with a valid deposit contract address to start.")
 	}
@@ -322,6 +360,16 @@ func (b *BeaconNode) registerRPCService(ctx *cli.Context) error {
 		return err
 	}
 
+	var p2pService *p2p.Server
+	if err := b.services.FetchService(&p2pService); err != nil {
+		return err
+	}
+
+	var syncService *rbcsync.Service
+	if err := b.services.FetchService(&syncService); err != nil {
+		return err
+	}
+
 	port := ctx.GlobalString(utils.RPCPort.Name)
 	cert := ctx.GlobalString(utils.CertFlag.Name)
 	key := ctx.GlobalString(utils.KeyFlag.Name)
@@ -336,6 +384,8 @@ func (b *BeaconNode) registerRPCService(ctx *cli.Context) error {
 		ChainService:        chainService,
 		OperationService:    operationService,
 		POWChainService:     web3Service,
+		P2P:                 p2pService,
+		SyncService:         syncService,
 	})
 
 	return b.services.RegisterService(rpcService)
@@ -7,8 +7,11 @@ import (
 	"sort"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bitutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	handler "github.com/prysmaticlabs/prysm/shared/messagehandler"
@@ -100,27 +103,84 @@ func (s *Service) IncomingProcessedBlockFeed() *event.Feed {
 }
 
 // PendingAttestations returns the attestations that have not seen on the beacon chain, the attestations are
-// returns in slot ascending order and up to MaxAttestations capacity. The attestations get
-// deleted in DB after they have been retrieved.
+// returns in slot ascending order and up to MaxAttestations capacity. Aggregates are preferred over the
+// attestations they cover: an attestation whose participants all take part in another pending attestation
+// of the same data is left out. The attestations get deleted in DB after they have been retrieved.
 func (s *Service) PendingAttestations() ([]*pb.Attestation, error) {
 	var attestations []*pb.Attestation
 	attestationsFromDB, err := s.beaconDB.Attestations()
 	if err != nil {
 		return nil, fmt.Errorf("could not retrieve attestations from DB")
 	}
-	sort.Slice(attestationsFromDB, func(i, j int) bool {
-		return attestationsFromDB[i].Data.Slot < attestationsFromDB[j].Data.Slot
+	// Within a slot, the attestations with the most participants come first, so that
+	// the attestations they cover are left out.
+	sort.SliceStable(attestationsFromDB, func(i, j int) bool {
+		if attestationsFromDB[i].Data.Slot != attestationsFromDB[j].Data.Slot {
+			return attestationsFromDB[i].Data.Slot < attestationsFromDB[j].Data.Slot
+		}
+		return bitutil.BitSetCount(attestationsFromDB[i].AggregationBitfield) >
+			bitutil.BitSetCount(attestationsFromDB[j].AggregationBitfield)
 	})
-	for i := range attestationsFromDB {
+	for _, att := range attestationsFromDB {
 		// Stop the max attestation number per beacon block is reached.
-		if uint64(i) == params.BeaconConfig().MaxAttestations {
+		if uint64(len(attestations)) == params.BeaconConfig().MaxAttestations {
 			break
 		}
-		attestations = append(attestations, attestationsFromDB[i])
+		if isCovered(att, attestations) {
+			continue
+		}
+		attestations = append(attestations, att)
 	}
 	return attestations, nil
 }
 
+// isCovered returns true if the participants of the attestation all take part in
+// one of the other attestations of the same data.
+func isCovered(att *pb.Attestation, others []*pb.Attestation) bool {
+	for _, other := range others {
+		if proto.Equal(other.Data, att.Data) && bitutil.Covers(other.AggregationBitfield, att.AggregationBitfield) {
+			return true
+		}
+	}
+	return false
+}
+
+// PendingExits returns the exits which have not been seen on the beacon chain and can
+// be included in a block on top of the given beacon state, in validator index ascending
+// order and up to MaxVoluntaryExits capacity. Only the first exit of each validator is
+// returned. Exits which can never become valid, such as those of validators which are
+// already exiting, are removed from the pool, while exits for a future epoch are kept.
+func (s *Service) PendingExits(beaconState *pb.BeaconState) ([]*pb.VoluntaryExit, error) {
+	exits, err := s.beaconDB.Exits()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve exits from DB: %v", err)
+	}
+	sort.Slice(exits, func(i, j int) bool {
+		return exits[i].ValidatorIndex < exits[j].ValidatorIndex
+	})
+	currentEpoch := helpers.CurrentEpoch(beaconState)
+	exiting := make(map[uint64]bool)
+	validExits := make([]*pb.VoluntaryExit, 0, len(exits))
+	for _, exit := range exits {
+		if exiting[exit.ValidatorIndex] || exit.Epoch > currentEpoch {
+			continue
+		}
+		if err := blocks.VerifyExit(beaconState, exit, true /* verify signatures */); err != nil {
+			log.WithField("validatorIndex", exit.ValidatorIndex).Debugf("Removing invalid pending exit: %v", err)
+			if err := s.beaconDB.DeleteExit(exit); err != nil {
+				return nil, fmt.Errorf("could not delete invalid exit from DB: %v", err)
+			}
+			continue
+		}
+		exiting[exit.ValidatorIndex] = true
+		validExits = append(validExits, exit)
+	}
+	if uint64(len(validExits)) > params.BeaconConfig().MaxVoluntaryExits {
+		validExits = validExits[:params.BeaconConfig().MaxVoluntaryExits]
+	}
+	return validExits, nil
+}
+
 // saveOperations saves the newly broadcasted beacon block operations
 // that was received from sync service.
 func (s *Service) saveOperations() {
@@ -200,6 +260,11 @@ func (s *Service) removeOperations() {
 				log.Errorf("Could not remove old attestations from DB at slot %d: %v", block.Slot, err)
 				return
 			}
+			// Removes the pending exits included in the processed block body in DB.
+			if err := s.removePendingExits(block.Body.VoluntaryExits); err != nil {
+				log.Errorf("Could not remove processed exits from DB: %v", err)
+				return
+			}
 		}
 	}
 }
@@ -213,8 +278,13 @@ func (s *Service) handleProcessedBlock(message proto.Message) {
 	}
 }
 
-// removePendingAttestations removes a list of attestations from DB.
+// removePendingAttestations removes a list of attestations from DB, along with the
+// pending attestations they cover, which are no longer worth including.
 func (s *Service) removePendingAttestations(attestations []*pb.Attestation) error {
+	pending, err := s.beaconDB.Attestations()
+	if err != nil {
+		return err
+	}
 	for _, attestation := range attestations {
 		if err := s.beaconDB.DeleteAttestation(attestation); err != nil {
 			return err
@@ -225,6 +295,25 @@ func (s *Service) removePendingAttestations(attestations []*pb.Attestation) erro
 		}
 		log.WithField("attestationRoot", fmt.Sprintf("0x%x", h)).Info("Attestation removed")
 	}
+	for _, att := range pending {
+		if !isCovered(att, attestations) {
+			continue
+		}
+		if err := s.beaconDB.DeleteAttestation(att); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removePendingExits removes a list of exits from DB.
+func (s *Service) removePendingExits(exits []*pb.VoluntaryExit) error {
+	for _, exit := range exits {
+		if err := s.beaconDB.DeleteExit(exit); err != nil {
+			return err
+		}
+		log.WithField("validatorIndex", exit.ValidatorIndex).Info("Exit removed")
+	}
 	return nil
 }
 
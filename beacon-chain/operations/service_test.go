@@ -2,6 +2,7 @@ package operations
 
 import (
 	"context"
+	"crypto/rand"
 	"errors"
 	"fmt"
 	"reflect"
@@ -9,8 +10,11 @@ import (
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/forkutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/ssz"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/sirupsen/logrus"
 	logTest "github.com/sirupsen/logrus/hooks/test"
@@ -193,6 +197,48 @@ func TestRemoveProcessedAttestations_Ok(t *testing.T) {
 	}
 }
 
+func TestPendingAttestations_PrefersAggregates(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	s := NewOpsPoolService(context.Background(), &Config{BeaconDB: db})
+
+	data := &pb.AttestationData{Slot: 5, Shard: 1}
+	aggregate := &pb.Attestation{Data: data, AggregationBitfield: []byte{0xe0}}
+	covered := &pb.Attestation{Data: data, AggregationBitfield: []byte{0x80}}
+	uncovered := &pb.Attestation{Data: data, AggregationBitfield: []byte{0x10}}
+	otherData := &pb.Attestation{Data: &pb.AttestationData{Slot: 5, Shard: 2}, AggregationBitfield: []byte{0x80}}
+	for _, att := range []*pb.Attestation{covered, uncovered, otherData, aggregate} {
+		if err := s.beaconDB.SaveAttestation(att); err != nil {
+			t.Fatalf("Failed to save attestation: %v", err)
+		}
+	}
+
+	atts, err := s.PendingAttestations()
+	if err != nil {
+		t.Fatalf("Could not retrieve attestations: %v", err)
+	}
+	if len(atts) != 3 || !reflect.DeepEqual(atts[0], aggregate) {
+		t.Fatalf("Expected the aggregate first and the covered attestation left out, received %v", atts)
+	}
+	for _, att := range atts {
+		if reflect.DeepEqual(att, covered) {
+			t.Error("Expected the attestation covered by the aggregate to be left out")
+		}
+	}
+
+	// Once the aggregate is included in a block, the attestations it covers go too.
+	if err := s.removePendingAttestations([]*pb.Attestation{aggregate}); err != nil {
+		t.Fatalf("Could not remove pending attestations: %v", err)
+	}
+	remaining, err := s.beaconDB.Attestations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(remaining) != 2 {
+		t.Errorf("Expected the uncovered attestations to remain, received %v", remaining)
+	}
+}
+
 func TestCleanUpAttestations_OlderThanOneEpoch(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
@@ -253,9 +299,15 @@ func TestReceiveBlkRemoveOps_Ok(t *testing.T) {
 			len(attestations), len(atts))
 	}
 
+	exit := &pb.VoluntaryExit{Epoch: 100, ValidatorIndex: 3}
+	if err := s.beaconDB.SaveExit(exit); err != nil {
+		t.Fatalf("Failed to save exit: %v", err)
+	}
+
 	block := &pb.BeaconBlock{
 		Body: &pb.BeaconBlockBody{
-			Attestations: attestations,
+			Attestations:   attestations,
+			VoluntaryExits: []*pb.VoluntaryExit{exit},
 		},
 	}
 
@@ -273,4 +325,135 @@ func TestReceiveBlkRemoveOps_Ok(t *testing.T) {
 	if len(atts) != 0 {
 		t.Errorf("Attestation pool should be empty but got a length of %d", len(atts))
 	}
+	exits, _ := s.beaconDB.Exits()
+	if len(exits) != 0 {
+		t.Errorf("Exit pool should be empty but got a length of %d", len(exits))
+	}
+}
+
+// exitState returns a beacon state at genesis with a validator registry of the given
+// size, along with a function which signs an exit of one of its validators.
+func exitState(t *testing.T, keys uint64) (*pb.BeaconState, func(index uint64, epoch uint64) *pb.VoluntaryExit) {
+	privKeys := make([]*bls.SecretKey, keys)
+	registry := make([]*pb.Validator, keys)
+	for i := range privKeys {
+		priv, err := bls.RandKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		privKeys[i] = priv
+		registry[i] = &pb.Validator{
+			Pubkey:          priv.PublicKey().Marshal(),
+			ActivationEpoch: params.BeaconConfig().GenesisEpoch,
+			ExitEpoch:       params.BeaconConfig().FarFutureEpoch,
+		}
+	}
+	beaconState := &pb.BeaconState{
+		Slot: params.BeaconConfig().GenesisSlot,
+		Fork: &pb.Fork{
+			PreviousVersion: params.BeaconConfig().GenesisForkVersion,
+			CurrentVersion:  params.BeaconConfig().GenesisForkVersion,
+			Epoch:           params.BeaconConfig().GenesisEpoch,
+		},
+		ValidatorRegistry: registry,
+	}
+	sign := func(index uint64, epoch uint64) *pb.VoluntaryExit {
+		exit := &pb.VoluntaryExit{
+			Epoch:          epoch,
+			ValidatorIndex: index,
+		}
+		root, err := ssz.SigningRoot(exit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		domain := forkutil.DomainVersion(beaconState.Fork, exit.Epoch, params.BeaconConfig().DomainExit)
+		exit.Signature = privKeys[index].Sign(root[:], domain).Marshal()
+		return exit
+	}
+	return beaconState, sign
+}
+
+func TestPendingExits_OrderedAndCapped(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	s := NewOpsPoolService(context.Background(), &Config{BeaconDB: db})
+
+	numExits := params.BeaconConfig().MaxVoluntaryExits + 2
+	beaconState, sign := exitState(t, numExits)
+	for i := numExits; i > 0; i-- {
+		if err := s.beaconDB.SaveExit(sign(i-1, params.BeaconConfig().GenesisEpoch)); err != nil {
+			t.Fatalf("Failed to save exit: %v", err)
+		}
+	}
+	exits, err := s.PendingExits(beaconState)
+	if err != nil {
+		t.Fatalf("Could not retrieve pending exits: %v", err)
+	}
+	if uint64(len(exits)) != params.BeaconConfig().MaxVoluntaryExits {
+		t.Fatalf("Expected %d exits, received %d", params.BeaconConfig().MaxVoluntaryExits, len(exits))
+	}
+	for i, exit := range exits {
+		if exit.ValidatorIndex != uint64(i) {
+			t.Errorf("Expected exit %d to be of validator %d, received %d", i, i, exit.ValidatorIndex)
+		}
+	}
+}
+
+func TestPendingExits_RemovesInvalidExits(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	s := NewOpsPoolService(context.Background(), &Config{BeaconDB: db})
+
+	genesisEpoch := params.BeaconConfig().GenesisEpoch
+	numStale := params.BeaconConfig().MaxVoluntaryExits
+	beaconState, sign := exitState(t, numStale+3)
+	// The validators of the lowest indices are already exiting, so their exits
+	// would fill every slot of a block ahead of the valid exits.
+	var stale []*pb.VoluntaryExit
+	for i := uint64(0); i < numStale; i++ {
+		beaconState.ValidatorRegistry[i].ExitEpoch = genesisEpoch
+		stale = append(stale, sign(i, genesisEpoch))
+	}
+	forged := sign(numStale, genesisEpoch)
+	forged.ValidatorIndex = numStale + 1
+	valid := []*pb.VoluntaryExit{sign(numStale, genesisEpoch), sign(numStale+1, genesisEpoch)}
+	future := sign(numStale+2, genesisEpoch+1)
+	duplicate := sign(numStale, genesisEpoch-1)
+
+	pool := append(append([]*pb.VoluntaryExit{forged, future, duplicate}, valid...), stale...)
+	for _, exit := range pool {
+		if err := s.beaconDB.SaveExit(exit); err != nil {
+			t.Fatalf("Failed to save exit: %v", err)
+		}
+	}
+	exits, err := s.PendingExits(beaconState)
+	if err != nil {
+		t.Fatalf("Could not retrieve pending exits: %v", err)
+	}
+	if len(exits) != len(valid) {
+		t.Fatalf("Expected %d exits, received %d", len(valid), len(exits))
+	}
+	for i, exit := range exits {
+		if exit.ValidatorIndex != valid[i].ValidatorIndex {
+			t.Errorf("Expected exit %d to be of validator %d, received %d", i, valid[i].ValidatorIndex, exit.ValidatorIndex)
+		}
+	}
+
+	// The stale and forged exits are removed, while the exit for a future epoch
+	// and the second exit of a validator stay in the pool.
+	remaining, err := s.beaconDB.Exits()
+	if err != nil {
+		t.Fatalf("Could not retrieve exits from DB: %v", err)
+	}
+	if len(remaining) != len(valid)+2 {
+		t.Errorf("Expected %d exits left in the pool, received %d", len(valid)+2, len(remaining))
+	}
+	for _, exit := range remaining {
+		if exit.ValidatorIndex < numStale {
+			t.Errorf("Expected exit of validator %d to be removed", exit.ValidatorIndex)
+		}
+		if reflect.DeepEqual(exit, forged) {
+			t.Error("Expected forged exit to be removed")
+		}
+	}
 }
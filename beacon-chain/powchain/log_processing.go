@@ -12,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
 	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
@@ -28,6 +29,9 @@ var (
 // HasChainStartLogOccurred queries all logs in the deposit contract to verify
 // if ChainStart has occurred. If so, it returns true alongside the ChainStart timestamp.
 func (w *Web3Service) HasChainStartLogOccurred() (bool, uint64, error) {
+	if !w.IsConnectedToETH1() {
+		return w.chainStarted, w.genesisTime, nil
+	}
 	genesisTime, err := w.depositContractCaller.GenesisTime(&bind.CallOpts{})
 	if err != nil {
 		return false, 0, fmt.Errorf("could not query contract to verify chain started: %v", err)
@@ -86,13 +90,26 @@ func (w *Web3Service) ProcessDepositLog(depositLog gethTypes.Log) {
 		DepositData:     depositData,
 		MerkleTreeIndex: index,
 	}
+	blockNum := big.NewInt(int64(depositLog.BlockNumber))
+	// Deposits with an invalid proof of possession are still part of the
+	// deposit trie and get included in blocks, where they are skipped, so we
+	// store them like any other deposit but also record why they are invalid.
 	if !w.chainStarted {
 		w.chainStartDeposits = append(w.chainStartDeposits, depositData)
 	} else {
-		w.beaconDB.InsertPendingDeposit(w.ctx, deposit, big.NewInt(int64(depositLog.BlockNumber)))
+		w.beaconDB.InsertPendingDeposit(w.ctx, deposit, blockNum)
 	}
 	// We always store all historical deposits in the DB.
-	w.beaconDB.InsertDeposit(w.ctx, deposit, big.NewInt(int64(depositLog.BlockNumber)))
+	w.beaconDB.InsertDeposit(w.ctx, deposit, blockNum)
+	if err := validators.VerifyProofOfPossession(depositInput); err != nil {
+		w.beaconDB.InsertInvalidDeposit(w.ctx, deposit, blockNum, err.Error())
+		log.WithFields(logrus.Fields{
+			"publicKey":       fmt.Sprintf("%#x", depositInput.Pubkey),
+			"merkleTreeIndex": index,
+		}).Warnf("Deposit has an invalid proof of possession and will not register a validator: %v", err)
+		invalidDepositsCount.Inc()
+		return
+	}
 	log.WithFields(logrus.Fields{
 		"publicKey":       fmt.Sprintf("%#x", depositInput.Pubkey),
 		"merkleTreeIndex": index,
@@ -13,6 +13,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/interop"
 	"github.com/prysmaticlabs/prysm/shared/ssz"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	logTest "github.com/sirupsen/logrus/hooks/test"
@@ -39,14 +40,15 @@ func TestProcessDepositLog_OK(t *testing.T) {
 
 	testAcc.backend.Commit()
 
-	var stub [48]byte
-	copy(stub[:], []byte("testing"))
-
-	data := &pb.DepositInput{
-		Pubkey:                      stub[:],
-		ProofOfPossession:           stub[:],
-		WithdrawalCredentialsHash32: []byte("withdraw"),
+	keys, err := interop.DeterministicallyGenerateKeys(0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	depositInputs, err := interop.GenerateDepositInputs(keys)
+	if err != nil {
+		t.Fatal(err)
 	}
+	data := depositInputs[0]
 
 	serializedData := new(bytes.Buffer)
 	if err := ssz.Encode(serializedData, data); err != nil {
@@ -81,6 +83,85 @@ func TestProcessDepositLog_OK(t *testing.T) {
 	hook.Reset()
 }
 
+func TestProcessDepositLog_InvalidProofOfPossession(t *testing.T) {
+	hook := logTest.NewGlobal()
+	endpoint := "ws://127.0.0.1"
+	beaconDB := &db.BeaconDB{}
+	testAcc, err := setup()
+	if err != nil {
+		t.Fatalf("Unable to set up simulated backend %v", err)
+	}
+	web3Service, err := NewWeb3Service(context.Background(), &Web3ServiceConfig{
+		Endpoint:        endpoint,
+		DepositContract: testAcc.contractAddr,
+		Reader:          &goodReader{},
+		Logger:          &goodLogger{},
+		ContractBackend: testAcc.backend,
+		BeaconDB:        beaconDB,
+	})
+	if err != nil {
+		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+
+	testAcc.backend.Commit()
+
+	keys, err := interop.DeterministicallyGenerateKeys(0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	depositInputs, err := interop.GenerateDepositInputs(keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Deposit the first key with the proof of possession of the second one.
+	data := depositInputs[0]
+	data.ProofOfPossession = depositInputs[1].ProofOfPossession
+
+	serializedData := new(bytes.Buffer)
+	if err := ssz.Encode(serializedData, data); err != nil {
+		t.Fatalf("Could not serialize data %v", err)
+	}
+
+	testAcc.txOpts.Value = amount32Eth
+	if _, err := testAcc.contract.Deposit(testAcc.txOpts, serializedData.Bytes()); err != nil {
+		t.Fatalf("Could not deposit to deposit contract %v", err)
+	}
+
+	testAcc.backend.Commit()
+
+	query := ethereum.FilterQuery{
+		Addresses: []common.Address{
+			web3Service.depositContractAddress,
+		},
+	}
+
+	logs, err := testAcc.backend.FilterLogs(web3Service.ctx, query)
+	if err != nil {
+		t.Fatalf("Unable to retrieve logs %v", err)
+	}
+
+	web3Service.ProcessLog(logs[0])
+
+	testutil.AssertLogsDoNotContain(t, hook, "Could not unpack log")
+	testutil.AssertLogsDoNotContain(t, hook, "Could not save in trie")
+	testutil.AssertLogsDoNotContain(t, hook, "Could not decode deposit input")
+	testutil.AssertLogsContain(t, hook, "Deposit has an invalid proof of possession")
+	testutil.AssertLogsDoNotContain(t, hook, "Validator registered in deposit contract")
+
+	invalidDeps := beaconDB.InvalidDeposits(context.Background())
+	if len(invalidDeps) != 1 {
+		t.Fatalf("Expected 1 invalid deposit, received %d", len(invalidDeps))
+	}
+	if invalidDeps[0].Reason == "" {
+		t.Error("Expected the invalid deposit to record a reason")
+	}
+	if len(beaconDB.AllDeposits(context.Background(), nil)) != 1 {
+		t.Error("Expected the invalid deposit to still be inserted into the deposit trie")
+	}
+
+	hook.Reset()
+}
+
 func TestProcessDepositLog_InsertsPendingDeposit(t *testing.T) {
 	endpoint := "ws://127.0.0.1"
 	testAcc, err := setup()
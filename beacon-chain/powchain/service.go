@@ -30,6 +30,10 @@ var (
 		Name: "powchain_valid_deposits_received",
 		Help: "The number of valid deposits received in the deposit contract",
 	})
+	invalidDepositsCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "powchain_invalid_deposits_received",
+		Help: "The number of deposits with an invalid proof of possession received in the deposit contract",
+	})
 	chainStartCount = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "powchain_chainstart_logs",
 		Help: "The number of chainstart logs received from the deposit contract",
@@ -87,6 +91,7 @@ type Web3Service struct {
 	depositTrie             *trieutil.MerkleTrie
 	chainStartDeposits      [][]byte
 	chainStarted            bool
+	genesisTime             uint64 // genesis time of a service created without an ETH1.0 chain.
 	beaconDB                *db.BeaconDB
 	lastReceivedMerkleIndex int64 // Keeps track of the last received index to prevent log spam.
 	isRunning               bool
@@ -152,8 +157,38 @@ func NewWeb3Service(ctx context.Context, config *Web3ServiceConfig) (*Web3Servic
 	}, nil
 }
 
+// NewGenesisWeb3Service creates a web3 service for a beacon node started from a
+// pre-generated genesis state instead of the ChainStart log of the deposit contract.
+// The service does not connect to an ETH1.0 chain and reports the chain as started
+// at the given genesis time.
+func NewGenesisWeb3Service(ctx context.Context, genesisTime uint64) (*Web3Service, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	depositTrie, err := trieutil.GenerateTrieFromItems([][]byte{{}}, int(params.BeaconConfig().DepositContractTreeDepth))
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not setup deposit trie: %v", err)
+	}
+	return &Web3Service{
+		ctx:                     ctx,
+		cancel:                  cancel,
+		blockHash:               common.BytesToHash([]byte{}),
+		blockCache:              newBlockCache(),
+		chainStartFeed:          new(event.Feed),
+		depositTrie:             depositTrie,
+		chainStartDeposits:      [][]byte{},
+		chainStarted:            true,
+		genesisTime:             genesisTime,
+		lastReceivedMerkleIndex: -1,
+		lastRequestedBlock:      big.NewInt(0),
+	}, nil
+}
+
 // Start a web3 service's main event loop.
 func (w *Web3Service) Start() {
+	if !w.IsConnectedToETH1() {
+		log.Info("Not connected to an ETH1.0 chain, skipping deposit contract processing")
+		return
+	}
 	log.WithFields(logrus.Fields{
 		"endpoint": w.endpoint,
 	}).Info("Starting service")
@@ -226,6 +261,12 @@ func (w *Web3Service) LatestBlockHash() common.Hash {
 	return w.blockHash
 }
 
+// IsConnectedToETH1 returns false if the service was created without an
+// ETH1.0 endpoint and deposit contract to read the chain start and deposits from.
+func (w *Web3Service) IsConnectedToETH1() bool {
+	return w.depositContractCaller != nil
+}
+
 // Client for interacting with the ETH1.0 chain.
 func (w *Web3Service) Client() Client {
 	return w.client
@@ -225,6 +225,36 @@ func TestStart_OK(t *testing.T) {
 	web3Service.cancel()
 }
 
+func TestGenesisWeb3Service_ReportsChainStarted(t *testing.T) {
+	hook := logTest.NewGlobal()
+	genesisTime := uint64(time.Now().Unix())
+	web3Service, err := NewGenesisWeb3Service(context.Background(), genesisTime)
+	if err != nil {
+		t.Fatalf("Unable to setup genesis web3 service: %v", err)
+	}
+	if web3Service.IsConnectedToETH1() {
+		t.Error("Expected genesis web3 service to not be connected to an ETH1.0 chain")
+	}
+
+	web3Service.Start()
+	testutil.AssertLogsContain(t, hook, "Not connected to an ETH1.0 chain")
+
+	started, startTime, err := web3Service.HasChainStartLogOccurred()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !started {
+		t.Error("Expected chain to have started")
+	}
+	if startTime != genesisTime {
+		t.Errorf("Expected genesis time %d, received %d", genesisTime, startTime)
+	}
+	if err := web3Service.Stop(); err != nil {
+		t.Fatalf("Unable to stop web3 service: %v", err)
+	}
+	hook.Reset()
+}
+
 func TestStop_OK(t *testing.T) {
 	hook := logTest.NewGlobal()
 
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
@@ -19,6 +21,7 @@ import (
 type AttesterServer struct {
 	beaconDB         *db.BeaconDB
 	operationService operationService
+	p2p              p2pService
 }
 
 // AttestHead is a function called by an attester in a sharding validator to vote
@@ -33,6 +36,43 @@ func (as *AttesterServer) AttestHead(ctx context.Context, att *pbp2p.Attestation
 	return &pb.AttestResponse{AttestationHash: h[:]}, nil
 }
 
+// AttestationsToAggregate returns the attestations in the operations pool which have the
+// attestation data, for an aggregator of their committee to aggregate.
+func (as *AttesterServer) AttestationsToAggregate(ctx context.Context, data *pbp2p.AttestationData) (*pb.AttestationsToAggregateResponse, error) {
+	atts, err := as.beaconDB.Attestations()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve attestations from DB: %v", err)
+	}
+	var matching []*pbp2p.Attestation
+	for _, att := range atts {
+		if proto.Equal(att.Data, data) {
+			matching = append(matching, att)
+		}
+	}
+	return &pb.AttestationsToAggregateResponse{Attestations: matching}, nil
+}
+
+// SubmitAggregateAndProof is called by an aggregator to publish the aggregate of the
+// attestations of its committee. The aggregate is verified along with the selection
+// proof of the aggregator, then added to the operations pool, from which proposers
+// include it in place of the attestations it covers, and broadcast to the network.
+func (as *AttesterServer) SubmitAggregateAndProof(ctx context.Context, aggregateAndProof *pbp2p.AggregateAndProof) (*pb.AttestResponse, error) {
+	beaconState, err := as.beaconDB.State(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve beacon state: %v", err)
+	}
+	if err := attestations.VerifyAggregateAndProof(beaconState, aggregateAndProof); err != nil {
+		return nil, fmt.Errorf("could not verify aggregate: %v", err)
+	}
+	h, err := hashutil.HashProto(aggregateAndProof.Aggregate)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash aggregate: %v", err)
+	}
+	as.operationService.IncomingAttFeed().Send(aggregateAndProof.Aggregate)
+	as.p2p.Broadcast(aggregateAndProof)
+	return &pb.AttestResponse{AttestationHash: h[:]}, nil
+}
+
 // AttestationDataAtSlot fetches the necessary information from the current canonical head
 // and beacon state for an assigned attester to perform necessary responsibilities. This includes
 // fetching the epoch boundary roots, the latest justified block root, among others.
@@ -170,3 +170,75 @@ func TestAttestationDataAtSlot_OK(t *testing.T) {
 		t.Errorf("Expected attestation info to match, received %v, wanted %v", res, expectedInfo)
 	}
 }
+
+func TestAttestationsToAggregate_FiltersByData(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	data := &pbp2p.AttestationData{
+		Slot:                    params.BeaconConfig().GenesisSlot + 1,
+		Shard:                   1,
+		CrosslinkDataRootHash32: []byte{'a'},
+	}
+	other := proto.Clone(data).(*pbp2p.AttestationData)
+	other.Shard = 2
+	atts := []*pbp2p.Attestation{
+		{Data: data, AggregationBitfield: []byte{0x80}},
+		{Data: other, AggregationBitfield: []byte{0x80}},
+		{Data: data, AggregationBitfield: []byte{0x40}},
+	}
+	for _, att := range atts {
+		if err := db.SaveAttestation(att); err != nil {
+			t.Fatalf("Could not save attestation: %v", err)
+		}
+	}
+	attesterServer := &AttesterServer{
+		beaconDB: db,
+	}
+	res, err := attesterServer.AttestationsToAggregate(context.Background(), data)
+	if err != nil {
+		t.Fatalf("Could not fetch attestations to aggregate: %v", err)
+	}
+	if len(res.Attestations) != 2 {
+		t.Fatalf("Expected 2 attestations, received %d", len(res.Attestations))
+	}
+	for _, att := range res.Attestations {
+		if !proto.Equal(att.Data, data) {
+			t.Errorf("Expected attestation of data %v, received %v", data, att.Data)
+		}
+	}
+}
+
+func TestSubmitAggregateAndProof_RejectsInvalidAggregate(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	beaconState, err := genesisState(params.BeaconConfig().SlotsPerEpoch)
+	if err != nil {
+		t.Fatalf("Could not generate genesis state: %v", err)
+	}
+	if err := db.SaveState(beaconState); err != nil {
+		t.Fatalf("Could not save state: %v", err)
+	}
+	p2p := &mockP2P{}
+	attesterServer := &AttesterServer{
+		beaconDB:         db,
+		operationService: &mockOperationService{},
+		p2p:              p2p,
+	}
+	aggregateAndProof := &pbp2p.AggregateAndProof{
+		AggregatorIndex: 0,
+		Aggregate: &pbp2p.Attestation{
+			Data: &pbp2p.AttestationData{
+				Slot:  beaconState.Slot,
+				Shard: params.BeaconConfig().ShardCount,
+			},
+		},
+	}
+	want := "could not verify aggregate"
+	if _, err := attesterServer.SubmitAggregateAndProof(context.Background(), aggregateAndProof); err == nil ||
+		!strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+	if len(p2p.broadcasted) != 0 {
+		t.Errorf("Expected invalid aggregate not to be broadcast, broadcast %v", p2p.broadcasted)
+	}
+}
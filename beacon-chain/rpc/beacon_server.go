@@ -8,6 +8,7 @@ import (
 	"time"
 
 	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
@@ -26,6 +27,7 @@ type BeaconServer struct {
 	chainService        chainService
 	chainStartDelayFlag uint64
 	operationService    operationService
+	syncService         syncService
 	incomingAttestation chan *pbp2p.Attestation
 	canonicalStateChan  chan *pbp2p.BeaconState
 	chainStartChan      chan time.Time
@@ -107,6 +109,21 @@ func (bs *BeaconServer) ForkData(ctx context.Context, _ *ptypes.Empty) (*pbp2p.F
 	return state.Fork, nil
 }
 
+// SyncStatus returns whether the beacon node is syncing with its peers, along with
+// the slot of its canonical head, from which validator clients tell whether the
+// beacon node is healthy enough to perform their duties through.
+func (bs *BeaconServer) SyncStatus(ctx context.Context, _ *ptypes.Empty) (*pb.SyncStatusResponse, error) {
+	block, err := bs.beaconDB.ChainHead()
+	if err != nil {
+		return nil, fmt.Errorf("could not get canonical head block: %v", err)
+	}
+	res := &pb.SyncStatusResponse{Syncing: bs.syncService.Syncing()}
+	if block != nil {
+		res.HeadSlot = block.Slot
+	}
+	return res, nil
+}
+
 // Eth1Data is a mechanism used by block proposers vote on a recent Ethereum 1.0 block hash and an
 // associated deposit root found in the Ethereum 1.0 deposit contract. When consensus is formed,
 // state.latest_eth1_data is updated, and validator deposits up to this root can be processed.
@@ -117,6 +134,11 @@ func (bs *BeaconServer) Eth1Data(ctx context.Context, _ *ptypes.Empty) (*pb.Eth1
 	if err != nil {
 		return nil, fmt.Errorf("could not fetch beacon state: %v", err)
 	}
+	// Without an eth1.0 chain there are no newer eth1.0 blocks to vote for,
+	// so proposers keep voting for the state's latest eth1 data.
+	if !bs.powChainService.IsConnectedToETH1() {
+		return &pb.Eth1DataResponse{Eth1Data: beaconState.LatestEth1Data}, nil
+	}
 	// Fetch the current canonical chain height from the eth1.0 chain.
 	currentHeight := bs.powChainService.LatestBlockHeight()
 	eth1FollowDistance := int64(params.BeaconConfig().Eth1FollowDistance)
@@ -204,6 +226,10 @@ func (bs *BeaconServer) Eth1Data(ctx context.Context, _ *ptypes.Empty) (*pb.Eth1
 // PendingDeposits returns a list of pending deposits that are ready for
 // inclusion in the next beacon block.
 func (bs *BeaconServer) PendingDeposits(ctx context.Context, _ *ptypes.Empty) (*pb.PendingDepositsResponse, error) {
+	// Deposits can only be made through the deposit contract on the eth1.0 chain.
+	if !bs.powChainService.IsConnectedToETH1() {
+		return &pb.PendingDepositsResponse{PendingDeposits: nil}, nil
+	}
 	bNum := bs.powChainService.LatestBlockHeight()
 	if bNum == nil {
 		return nil, errors.New("latest PoW block number is unknown")
@@ -255,6 +281,27 @@ func (bs *BeaconServer) PendingDeposits(ctx context.Context, _ *ptypes.Empty) (*
 	return &pb.PendingDepositsResponse{PendingDeposits: pendingDeps}, nil
 }
 
+// InvalidDeposits returns the deposits from the deposit contract which do not
+// register a validator because their proof of possession is invalid, together
+// with the reason.
+func (bs *BeaconServer) InvalidDeposits(ctx context.Context, _ *ptypes.Empty) (*pb.InvalidDepositsResponse, error) {
+	invalidDeps := bs.beaconDB.InvalidDeposits(ctx)
+	res := make([]*pb.InvalidDeposit, len(invalidDeps))
+	for i, d := range invalidDeps {
+		depositInput, err := helpers.DecodeDepositInput(d.Deposit.DepositData)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode deposit input: %v", err)
+		}
+		res[i] = &pb.InvalidDeposit{
+			Deposit:     d.Deposit,
+			PublicKey:   depositInput.Pubkey,
+			BlockNumber: d.Block.Uint64(),
+			Reason:      d.Reason,
+		}
+	}
+	return &pb.InvalidDepositsResponse{InvalidDeposits: res}, nil
+}
+
 func (bs *BeaconServer) defaultDataResponse(ctx context.Context, currentHeight *big.Int, eth1FollowDistance int64) (*pb.Eth1DataResponse, error) {
 	ancestorHeight := big.NewInt(0).Sub(currentHeight, big.NewInt(eth1FollowDistance))
 	blockHash, err := bs.powChainService.BlockHashByHeight(ctx, ancestorHeight)
@@ -11,8 +11,10 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
 	ptypes "github.com/gogo/protobuf/types"
 	"github.com/golang/mock/gomock"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
@@ -63,10 +65,15 @@ func (f *faultyPOWChainService) ChainStartDeposits() [][]byte {
 	return [][]byte{}
 }
 
+func (f *faultyPOWChainService) IsConnectedToETH1() bool {
+	return true
+}
+
 type mockPOWChainService struct {
 	chainStartFeed    *event.Feed
 	latestBlockNumber *big.Int
 	hashesByHeight    map[int][]byte
+	withoutETH1       bool
 }
 
 func (m *mockPOWChainService) HasChainStartLogOccurred() (bool, uint64, error) {
@@ -115,6 +122,10 @@ func (m *mockPOWChainService) ChainStartDeposits() [][]byte {
 	return [][]byte{}
 }
 
+func (m *mockPOWChainService) IsConnectedToETH1() bool {
+	return !m.withoutETH1
+}
+
 func TestWaitForChainStart_ContextClosed(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	beaconServer := &BeaconServer{
@@ -289,6 +300,22 @@ func TestPendingDeposits_UnknownBlockNum(t *testing.T) {
 	}
 }
 
+func TestPendingDeposits_WithoutETH1Chain(t *testing.T) {
+	p := &mockPOWChainService{
+		latestBlockNumber: nil,
+		withoutETH1:       true,
+	}
+	bs := BeaconServer{powChainService: p}
+
+	res, err := bs.PendingDeposits(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.PendingDeposits) != 0 {
+		t.Errorf("Expected no pending deposits, received %d", len(res.PendingDeposits))
+	}
+}
+
 func TestPendingDeposits_OutsideEth1FollowWindow(t *testing.T) {
 	height := big.NewInt(int64(params.BeaconConfig().Eth1FollowDistance))
 	p := &mockPOWChainService{
@@ -367,6 +394,73 @@ func TestPendingDeposits_OutsideEth1FollowWindow(t *testing.T) {
 	}
 }
 
+func TestInvalidDeposits_OK(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	pubkey := []byte{'A'}
+	data, err := helpers.EncodeDepositData(
+		&pbp2p.DepositInput{Pubkey: pubkey},
+		params.BeaconConfig().MaxDepositAmount,
+		time.Now().Unix(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	deposit := &pbp2p.Deposit{DepositData: data, MerkleTreeIndex: 3}
+	db.InsertInvalidDeposit(ctx, deposit, big.NewInt(10), "invalid signature")
+
+	bs := &BeaconServer{beaconDB: db}
+	res, err := bs.InvalidDeposits(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.InvalidDeposits) != 1 {
+		t.Fatalf("Expected 1 invalid deposit, received %d", len(res.InvalidDeposits))
+	}
+	want := &pb.InvalidDeposit{
+		Deposit:     deposit,
+		PublicKey:   pubkey,
+		BlockNumber: 10,
+		Reason:      "invalid signature",
+	}
+	if !proto.Equal(res.InvalidDeposits[0], want) {
+		t.Errorf("Received %v, wanted %v", res.InvalidDeposits[0], want)
+	}
+}
+
+type mockSyncService struct {
+	syncing bool
+}
+
+func (ms *mockSyncService) Syncing() bool {
+	return ms.syncing
+}
+
+func TestSyncStatus_ReportsHeadSlot(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	block := &pbp2p.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot + 5}
+	if err := db.SaveBlock(block); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateChainHead(block, &pbp2p.BeaconState{Slot: block.Slot}); err != nil {
+		t.Fatal(err)
+	}
+	bs := &BeaconServer{beaconDB: db, syncService: &mockSyncService{syncing: true}}
+	res, err := bs.SyncStatus(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatalf("Could not get sync status: %v", err)
+	}
+	want := &pb.SyncStatusResponse{Syncing: true, HeadSlot: block.Slot}
+	if !proto.Equal(res, want) {
+		t.Errorf("Received %v, wanted %v", res, want)
+	}
+}
+
 func TestEth1Data_EmptyVotesFetchBlockHashFailure(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
@@ -391,6 +485,33 @@ func TestEth1Data_EmptyVotesFetchBlockHashFailure(t *testing.T) {
 	}
 }
 
+func TestEth1Data_WithoutETH1ChainUsesStateEth1Data(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	beaconServer := &BeaconServer{
+		beaconDB:        db,
+		powChainService: &mockPOWChainService{withoutETH1: true},
+	}
+	eth1Data := &pbp2p.Eth1Data{
+		DepositRootHash32: []byte{'a'},
+		BlockHash32:       []byte{'b'},
+	}
+	beaconState := &pbp2p.BeaconState{
+		LatestEth1Data: eth1Data,
+	}
+	if err := beaconServer.beaconDB.SaveState(beaconState); err != nil {
+		t.Fatal(err)
+	}
+	res, err := beaconServer.Eth1Data(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(res.Eth1Data.DepositRootHash32, eth1Data.DepositRootHash32) ||
+		!bytes.Equal(res.Eth1Data.BlockHash32, eth1Data.BlockHash32) {
+		t.Errorf("Expected eth1 data %v, received %v", eth1Data, res.Eth1Data)
+	}
+}
+
 func TestEth1Data_EmptyVotesOk(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
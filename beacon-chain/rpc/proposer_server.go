@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	ptypes "github.com/gogo/protobuf/types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
@@ -115,18 +116,32 @@ func (ps *ProposerServer) PendingAttestations(ctx context.Context, req *pb.Pendi
 	}, nil
 }
 
+// PendingExits retrieves the exits kept in the beacon node's operations pool which can be
+// included in a block on top of the current beacon state.
+func (ps *ProposerServer) PendingExits(ctx context.Context, _ *ptypes.Empty) (*pb.PendingExitsResponse, error) {
+	beaconState, err := ps.beaconDB.State(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve beacon state: %v", err)
+	}
+	exits, err := ps.operationService.PendingExits(beaconState)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve pending exits from operations service: %v", err)
+	}
+	return &pb.PendingExitsResponse{PendingExits: exits}, nil
+}
+
 // ComputeStateRoot computes the state root after a block has been processed through a state transition and
 // returns it to the validator client.
 func (ps *ProposerServer) ComputeStateRoot(ctx context.Context, req *pbp2p.BeaconBlock) (*pb.StateRootResponse, error) {
-	beaconState, err := ps.beaconDB.State(ctx)
+	beaconState, err := ps.beaconDB.SharedState(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("could not get beacon state: %v", err)
 	}
 
 	parentHash := bytesutil.ToBytes32(req.ParentRootHash32)
 	// Check for skipped slots.
-	for beaconState.Slot < req.Slot-1 {
-		beaconState, err = state.ExecuteStateTransition(
+	for beaconState.Slot() < req.Slot-1 {
+		beaconState, err = state.ExecuteSharedStateTransition(
 			ctx,
 			beaconState,
 			nil,
@@ -137,7 +152,7 @@ func (ps *ProposerServer) ComputeStateRoot(ctx context.Context, req *pbp2p.Beaco
 			return nil, fmt.Errorf("could not execute state transition %v", err)
 		}
 	}
-	beaconState, err = state.ExecuteStateTransition(
+	beaconState, err = state.ExecuteSharedStateTransition(
 		ctx,
 		beaconState,
 		req,
@@ -148,7 +163,7 @@ func (ps *ProposerServer) ComputeStateRoot(ctx context.Context, req *pbp2p.Beaco
 		return nil, fmt.Errorf("could not execute state transition %v", err)
 	}
 
-	beaconStateHash, err := hashutil.HashProto(beaconState)
+	beaconStateHash, err := state.StateRoot(beaconState.InnerStateUnsafe())
 	if err != nil {
 		return nil, fmt.Errorf("could not tree hash beacon state: %v", err)
 	}
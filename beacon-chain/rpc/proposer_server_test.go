@@ -2,10 +2,13 @@ package rpc
 
 import (
 	"context"
+	"reflect"
 	"strconv"
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
+	ptypes "github.com/gogo/protobuf/types"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
@@ -227,3 +230,29 @@ func TestPendingAttestations_OK(t *testing.T) {
 		t.Error("Expected pending attestations list to be non-empty")
 	}
 }
+
+func TestPendingExits_UsesHeadState(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	beaconState, sign := exitState(t, 2)
+	if err := db.SaveState(beaconState); err != nil {
+		t.Fatalf("Could not save state: %v", err)
+	}
+	exits := []*pbp2p.VoluntaryExit{sign(0), sign(1)}
+	opService := &mockOperationService{pendingExits: exits}
+	proposerServer := &ProposerServer{
+		beaconDB:         db,
+		operationService: opService,
+	}
+	res, err := proposerServer.PendingExits(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatalf("Could not retrieve pending exits: %v", err)
+	}
+	if !reflect.DeepEqual(res.PendingExits, exits) {
+		t.Errorf("Expected pending exits %v, received %v", exits, res.PendingExits)
+	}
+	if !proto.Equal(opService.exitsState, beaconState) {
+		t.Error("Expected pending exits to be checked against the head state")
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
 	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
@@ -41,6 +42,15 @@ type operationService interface {
 	IncomingExitFeed() *event.Feed
 	IncomingAttFeed() *event.Feed
 	PendingAttestations() ([]*pbp2p.Attestation, error)
+	PendingExits(beaconState *pbp2p.BeaconState) ([]*pbp2p.VoluntaryExit, error)
+}
+
+type p2pService interface {
+	Broadcast(msg proto.Message)
+}
+
+type syncService interface {
+	Syncing() bool
 }
 
 type powChainService interface {
@@ -52,6 +62,7 @@ type powChainService interface {
 	DepositRoot() [32]byte
 	DepositTrie() *trieutil.MerkleTrie
 	ChainStartDeposits() [][]byte
+	IsConnectedToETH1() bool
 }
 
 // Service defining an RPC server for a beacon node.
@@ -62,6 +73,8 @@ type Service struct {
 	chainService          chainService
 	powChainService       powChainService
 	operationService      operationService
+	p2p                   p2pService
+	syncService           syncService
 	port                  string
 	chainStartDelayFlag   uint64
 	listener              net.Listener
@@ -86,6 +99,8 @@ type Config struct {
 	ChainService        chainService
 	POWChainService     powChainService
 	OperationService    operationService
+	P2P                 p2pService
+	SyncService         syncService
 }
 
 // NewRPCService creates a new instance of a struct implementing the BeaconServiceServer
@@ -99,6 +114,8 @@ func NewRPCService(ctx context.Context, cfg *Config) *Service {
 		chainService:          cfg.ChainService,
 		powChainService:       cfg.POWChainService,
 		operationService:      cfg.OperationService,
+		p2p:                   cfg.P2P,
+		syncService:           cfg.SyncService,
 		port:                  cfg.Port,
 		withCert:              cfg.CertFlag,
 		withKey:               cfg.KeyFlag,
@@ -149,6 +166,7 @@ func (s *Service) Start() {
 		powChainService:     s.powChainService,
 		chainService:        s.chainService,
 		operationService:    s.operationService,
+		syncService:         s.syncService,
 		incomingAttestation: s.incomingAttestation,
 		canonicalStateChan:  s.canonicalStateChan,
 		chainStartDelayFlag: s.chainStartDelayFlag,
@@ -164,11 +182,14 @@ func (s *Service) Start() {
 	attesterServer := &AttesterServer{
 		beaconDB:         s.beaconDB,
 		operationService: s.operationService,
+		p2p:              s.p2p,
 	}
 	validatorServer := &ValidatorServer{
 		ctx:                s.ctx,
 		beaconDB:           s.beaconDB,
 		chainService:       s.chainService,
+		operationService:   s.operationService,
+		p2p:                s.p2p,
 		canonicalStateChan: s.canonicalStateChan,
 	}
 	pb.RegisterBeaconServiceServer(s.grpcServer, beaconServer)
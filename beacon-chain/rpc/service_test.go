@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"testing"
 
+	"github.com/gogo/protobuf/proto"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -31,6 +32,8 @@ func (t *TestLogger) Errorf(format string, args ...interface{}) {
 
 type mockOperationService struct {
 	pendingAttestations []*pb.Attestation
+	pendingExits        []*pb.VoluntaryExit
+	exitsState          *pb.BeaconState
 }
 
 func (ms *mockOperationService) IncomingAttFeed() *event.Feed {
@@ -67,11 +70,25 @@ func (ms *mockOperationService) PendingAttestations() ([]*pb.Attestation, error)
 	}, nil
 }
 
+func (ms *mockOperationService) PendingExits(beaconState *pb.BeaconState) ([]*pb.VoluntaryExit, error) {
+	ms.exitsState = beaconState
+	return ms.pendingExits, nil
+}
+
+type mockP2P struct {
+	broadcasted []proto.Message
+}
+
+func (mp *mockP2P) Broadcast(msg proto.Message) {
+	mp.broadcasted = append(mp.broadcasted, msg)
+}
+
 type mockChainService struct {
 	blockFeed            *event.Feed
 	stateFeed            *event.Feed
 	attestationFeed      *event.Feed
 	stateInitializedFeed *event.Feed
+	canonicalBlockFeed   *event.Feed
 }
 
 func (m *mockChainService) StateInitializedFeed() *event.Feed {
@@ -87,7 +104,7 @@ func (m *mockChainService) ApplyForkChoiceRule(ctx context.Context, block *pb.Be
 }
 
 func (m *mockChainService) CanonicalBlockFeed() *event.Feed {
-	return new(event.Feed)
+	return m.canonicalBlockFeed
 }
 
 func newMockChainService() *mockChainService {
@@ -96,6 +113,7 @@ func newMockChainService() *mockChainService {
 		stateFeed:            new(event.Feed),
 		attestationFeed:      new(event.Feed),
 		stateInitializedFeed: new(event.Feed),
+		canonicalBlockFeed:   new(event.Feed),
 	}
 }
 
@@ -1,15 +1,19 @@
 package rpc
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
@@ -21,51 +25,62 @@ type ValidatorServer struct {
 	ctx                context.Context
 	beaconDB           *db.BeaconDB
 	chainService       chainService
+	operationService   operationService
+	p2p                p2pService
 	canonicalStateChan chan *pbp2p.BeaconState
 }
 
-// WaitForActivation checks if a validator public key exists in the active validator registry of the current
-// beacon state, if not, then it creates a stream which listens for canonical states which contain
-// the validator with the public key as an active validator record.
+// WaitForActivation checks if any of the validator public keys exist in the validator registry of the
+// current beacon state, if not, then it creates a stream which listens for canonical states which contain
+// the validators with the public keys as validator records. It sends the records of all the public keys
+// which are in the registry.
 func (vs *ValidatorServer) WaitForActivation(req *pb.ValidatorActivationRequest, stream pb.ValidatorService_WaitForActivationServer) error {
-	if vs.beaconDB.HasValidator(req.Pubkey) {
-		beaconState, err := vs.beaconDB.State(vs.ctx)
-		if err != nil {
-			return fmt.Errorf("could not retrieve beacon state: %v", err)
-		}
-		activeVal, err := vs.retrieveActiveValidator(beaconState, req.Pubkey)
-		if err != nil {
-			return fmt.Errorf("could not retrieve active validator from state: %v", err)
-		}
-		res := &pb.ValidatorActivationResponse{
-			Validator: activeVal,
-		}
-		return stream.Send(res)
+	if vs.hasAnyValidator(req.PublicKeys) {
+		return vs.sendActivatedValidators(req.PublicKeys, stream)
 	}
 	for {
 		select {
 		case <-time.After(3 * time.Second):
-			if !vs.beaconDB.HasValidator(req.Pubkey) {
+			if !vs.hasAnyValidator(req.PublicKeys) {
 				continue
 			}
-			beaconState, err := vs.beaconDB.State(vs.ctx)
-			if err != nil {
-				return fmt.Errorf("could not retrieve beacon state: %v", err)
-			}
-			activeVal, err := vs.retrieveActiveValidator(beaconState, req.Pubkey)
-			if err != nil {
-				return fmt.Errorf("could not retrieve active validator from state: %v", err)
-			}
-			res := &pb.ValidatorActivationResponse{
-				Validator: activeVal,
-			}
-			return stream.Send(res)
+			return vs.sendActivatedValidators(req.PublicKeys, stream)
 		case <-vs.ctx.Done():
 			return errors.New("rpc context closed, exiting goroutine")
 		}
 	}
 }
 
+func (vs *ValidatorServer) hasAnyValidator(pubkeys [][]byte) bool {
+	for _, pubkey := range pubkeys {
+		if vs.beaconDB.HasValidator(pubkey) {
+			return true
+		}
+	}
+	return false
+}
+
+// sendActivatedValidators sends the validator records of the public keys which are
+// in the validator registry.
+func (vs *ValidatorServer) sendActivatedValidators(pubkeys [][]byte, stream pb.ValidatorService_WaitForActivationServer) error {
+	beaconState, err := vs.beaconDB.State(vs.ctx)
+	if err != nil {
+		return fmt.Errorf("could not retrieve beacon state: %v", err)
+	}
+	res := &pb.ValidatorActivationResponse{}
+	for _, pubkey := range pubkeys {
+		if !vs.beaconDB.HasValidator(pubkey) {
+			continue
+		}
+		activeVal, err := vs.retrieveActiveValidator(beaconState, pubkey)
+		if err != nil {
+			return fmt.Errorf("could not retrieve active validator from state: %v", err)
+		}
+		res.Validators = append(res.Validators, activeVal)
+	}
+	return stream.Send(res)
+}
+
 // ValidatorIndex is called by a validator to get its index location that corresponds
 // to the attestation bit fields.
 func (vs *ValidatorServer) ValidatorIndex(ctx context.Context, req *pb.ValidatorIndexRequest) (*pb.ValidatorIndexResponse, error) {
@@ -77,44 +92,142 @@ func (vs *ValidatorServer) ValidatorIndex(ctx context.Context, req *pb.Validator
 	return &pb.ValidatorIndexResponse{Index: uint64(index)}, nil
 }
 
-// CommitteeAssignment returns the committee assignment response from a given validator public key.
-// The committee assignment response contains the following fields for the current and previous epoch:
+// CommitteeAssignment returns the committee assignments of the given validator public keys
+// in a single response. Each committee assignment contains the following fields for the
+// current and previous epoch:
 //	1.) The list of validators in the committee.
 //	2.) The shard to which the committee is assigned.
 //	3.) The slot at which the committee is assigned.
 //	4.) The bool signalling if the validator is expected to propose a block at the assigned slot.
+//	5.) The public key of the validator.
+//	6.) The index of the validator.
+// Public keys of validators which are not yet in the registry, or not active in the
+// requested epoch, have no assignment.
 func (vs *ValidatorServer) CommitteeAssignment(
 	ctx context.Context,
 	req *pb.ValidatorEpochAssignmentsRequest) (*pb.CommitteeAssignmentResponse, error) {
 
-	if len(req.PublicKey) != params.BeaconConfig().BLSPubkeyLength {
-		return nil, fmt.Errorf(
-			"expected public key to have length %d, received %d",
-			params.BeaconConfig().BLSPubkeyLength,
-			len(req.PublicKey),
-		)
+	for _, pubkey := range req.PublicKeys {
+		if len(pubkey) != params.BeaconConfig().BLSPubkeyLength {
+			return nil, fmt.Errorf(
+				"expected public key to have length %d, received %d",
+				params.BeaconConfig().BLSPubkeyLength,
+				len(pubkey),
+			)
+		}
 	}
 
 	beaconState, err := vs.beaconDB.State(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("could not fetch beacon state: %v", err)
 	}
-	idx, err := vs.beaconDB.ValidatorIndex(req.PublicKey)
-	if err != nil {
-		return nil, fmt.Errorf("could not get active validator index: %v", err)
-	}
+	return vs.committeeAssignments(beaconState, req.EpochStart, req.PublicKeys)
+}
 
-	committee, shard, slot, isProposer, err :=
-		helpers.CommitteeAssignment(beaconState, req.EpochStart, uint64(idx), false)
-	if err != nil {
-		return nil, fmt.Errorf("could not get next epoch committee assignment: %v", err)
+// committeeAssignments returns the committee assignments of the public keys of the
+// validators active in the epoch starting at the slot.
+func (vs *ValidatorServer) committeeAssignments(
+	beaconState *pbp2p.BeaconState,
+	epochStart uint64,
+	pubkeys [][]byte) (*pb.CommitteeAssignmentResponse, error) {
+
+	epoch := helpers.SlotToEpoch(epochStart)
+	var assignments []*pb.CommitteeAssignmentResponse_CommitteeAssignment
+	for _, pubkey := range pubkeys {
+		if !vs.beaconDB.HasValidator(pubkey) {
+			continue
+		}
+		idx, err := vs.beaconDB.ValidatorIndex(pubkey)
+		if err != nil {
+			return nil, fmt.Errorf("could not get active validator index: %v", err)
+		}
+		if idx >= uint64(len(beaconState.ValidatorRegistry)) ||
+			!helpers.IsActiveValidator(beaconState.ValidatorRegistry[idx], epoch) {
+			continue
+		}
+		committee, shard, slot, isProposer, err :=
+			helpers.CommitteeAssignment(beaconState, epochStart, uint64(idx), false)
+		if err != nil {
+			return nil, fmt.Errorf("could not get next epoch committee assignment: %v", err)
+		}
+		assignments = append(assignments, &pb.CommitteeAssignmentResponse_CommitteeAssignment{
+			Committee:      committee,
+			Shard:          shard,
+			Slot:           slot,
+			IsProposer:     isProposer,
+			PublicKey:      pubkey,
+			ValidatorIndex: uint64(idx),
+		})
 	}
 
 	return &pb.CommitteeAssignmentResponse{
-		Committee:  committee,
-		Shard:      shard,
-		Slot:       slot,
-		IsProposer: isProposer,
+		Assignment: assignments,
+	}, nil
+}
+
+// StreamDuties sends the committee assignments of the public keys for the current and
+// the next epoch of the head state, then listens for new canonical blocks and sends the
+// assignments again whenever they change, as they do at an epoch transition, after a
+// reorg, or after a registry update. This spares validator clients from polling for
+// their assignments.
+func (vs *ValidatorServer) StreamDuties(req *pb.DutiesRequest, stream pb.ValidatorService_StreamDutiesServer) error {
+	for _, pubkey := range req.PublicKeys {
+		if len(pubkey) != params.BeaconConfig().BLSPubkeyLength {
+			return fmt.Errorf(
+				"expected public key to have length %d, received %d",
+				params.BeaconConfig().BLSPubkeyLength,
+				len(pubkey),
+			)
+		}
+	}
+	blockChan := make(chan *pbp2p.BeaconBlockAnnounce, 1)
+	sub := vs.chainService.CanonicalBlockFeed().Subscribe(blockChan)
+	defer sub.Unsubscribe()
+
+	var sent *pb.DutiesResponse
+	for {
+		res, err := vs.duties(stream.Context(), req.PublicKeys)
+		if err != nil {
+			return err
+		}
+		if !proto.Equal(res, sent) {
+			if err := stream.Send(res); err != nil {
+				return err
+			}
+			sent = res
+		}
+		select {
+		case <-blockChan:
+		case <-sub.Err():
+			return errors.New("subscriber closed, exiting goroutine")
+		case <-stream.Context().Done():
+			return nil
+		case <-vs.ctx.Done():
+			return errors.New("rpc context closed, exiting goroutine")
+		}
+	}
+}
+
+// duties returns the committee assignments of the public keys for the current and the
+// next epoch of the head state.
+func (vs *ValidatorServer) duties(ctx context.Context, pubkeys [][]byte) (*pb.DutiesResponse, error) {
+	beaconState, err := vs.beaconDB.State(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch beacon state: %v", err)
+	}
+	currentEpoch := helpers.CurrentEpoch(beaconState)
+	current, err := vs.committeeAssignments(beaconState, helpers.StartSlot(currentEpoch), pubkeys)
+	if err != nil {
+		return nil, fmt.Errorf("could not get current epoch assignments: %v", err)
+	}
+	next, err := vs.committeeAssignments(beaconState, helpers.StartSlot(currentEpoch+1), pubkeys)
+	if err != nil {
+		return nil, fmt.Errorf("could not get next epoch assignments: %v", err)
+	}
+	return &pb.DutiesResponse{
+		CurrentEpoch:       currentEpoch,
+		CurrentEpochDuties: current,
+		NextEpochDuties:    next,
 	}, nil
 }
 
@@ -134,15 +247,27 @@ func (vs *ValidatorServer) ValidatorStatus(
 	if err != nil {
 		return nil, fmt.Errorf("could not fetch beacon state: %v", err)
 	}
+	// A validator whose deposits all had an invalid proof of possession will
+	// never be registered, so we report why instead of failing the lookup.
+	if !vs.beaconDB.HasValidator(req.PublicKey) && vs.hasInvalidDeposit(ctx, req.PublicKey) {
+		return &pb.ValidatorStatusResponse{
+			Status: pb.ValidatorStatus_INVALID_DEPOSIT,
+		}, nil
+	}
 	idx, err := vs.beaconDB.ValidatorIndex(req.PublicKey)
 	if err != nil {
 		return nil, fmt.Errorf("could not get active validator index: %v", err)
 	}
 
+	return &pb.ValidatorStatusResponse{
+		Status: validatorStatus(beaconState.ValidatorRegistry[idx], helpers.CurrentEpoch(beaconState)),
+	}, nil
+}
+
+// validatorStatus returns the status of the validator at the epoch.
+func validatorStatus(v *pbp2p.Validator, epoch uint64) pb.ValidatorStatus {
 	var status pb.ValidatorStatus
-	v := beaconState.ValidatorRegistry[idx]
 	farFutureEpoch := params.BeaconConfig().FarFutureEpoch
-	epoch := helpers.CurrentEpoch(beaconState)
 
 	if v.ActivationEpoch == farFutureEpoch {
 		status = pb.ValidatorStatus_PENDING_ACTIVE
@@ -159,10 +284,260 @@ func (vs *ValidatorServer) ValidatorStatus(
 	} else {
 		status = pb.ValidatorStatus_UNKNOWN_STATUS
 	}
+	return status
+}
 
-	return &pb.ValidatorStatusResponse{
-		Status: status,
-	}, nil
+// ValidatorPerformance returns the balances and statuses of the validators of the public
+// keys in the head state, along with how they performed their duties in the previous
+// epoch: whether and how soon their attestations were included, whether those voted for
+// the canonical source, target and head, and how many of their proposals have a block in
+// the canonical chain. The attestations of an epoch can be included until the end of the
+// next epoch, so the performance of an epoch is final at the last slot of the next one.
+func (vs *ValidatorServer) ValidatorPerformance(
+	ctx context.Context,
+	req *pb.ValidatorPerformanceRequest) (*pb.ValidatorPerformanceResponse, error) {
+
+	beaconState, err := vs.beaconDB.State(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch beacon state: %v", err)
+	}
+	epoch := helpers.PrevEpoch(beaconState)
+
+	// The earliest included attestation of each validator in the epoch.
+	included := make(map[uint64]*pbp2p.PendingAttestation)
+	for _, att := range beaconState.LatestAttestations {
+		if helpers.SlotToEpoch(att.Data.Slot) != epoch {
+			continue
+		}
+		participants, err := helpers.AttestationParticipants(beaconState, att.Data, att.AggregationBitfield)
+		if err != nil {
+			return nil, fmt.Errorf("could not get attestation participants: %v", err)
+		}
+		for _, idx := range participants {
+			if earliest, ok := included[idx]; !ok || att.InclusionSlot < earliest.InclusionSlot {
+				included[idx] = att
+			}
+		}
+	}
+	proposals, missedProposals, err := proposalCounts(beaconState, epoch)
+	if err != nil {
+		return nil, fmt.Errorf("could not get proposals: %v", err)
+	}
+
+	res := &pb.ValidatorPerformanceResponse{
+		Epoch: epoch,
+	}
+	for _, pubkey := range req.PublicKeys {
+		if !vs.beaconDB.HasValidator(pubkey) {
+			continue
+		}
+		idx, err := vs.beaconDB.ValidatorIndex(pubkey)
+		if err != nil {
+			return nil, fmt.Errorf("could not get validator index: %v", err)
+		}
+		if idx >= uint64(len(beaconState.ValidatorRegistry)) {
+			continue
+		}
+		performance := &pb.ValidatorPerformance{
+			PublicKey:        pubkey,
+			Status:           validatorStatus(beaconState.ValidatorRegistry[idx], helpers.CurrentEpoch(beaconState)),
+			Balance:          beaconState.ValidatorBalances[idx],
+			EffectiveBalance: helpers.EffectiveBalance(beaconState, idx),
+			Proposals:        proposals[idx],
+			MissedProposals:  missedProposals[idx],
+		}
+		if att, ok := included[idx]; ok {
+			boundaryRoot, err := blocks.BlockRoot(beaconState, helpers.StartSlot(epoch))
+			if err != nil {
+				return nil, fmt.Errorf("could not get epoch boundary block root: %v", err)
+			}
+			headRoot, err := blocks.BlockRoot(beaconState, att.Data.Slot)
+			if err != nil {
+				return nil, fmt.Errorf("could not get head block root: %v", err)
+			}
+			performance.AttestationIncluded = true
+			performance.InclusionDistance = att.InclusionSlot - att.Data.Slot
+			performance.CorrectSource = att.Data.JustifiedEpoch == beaconState.PreviousJustifiedEpoch
+			performance.CorrectTarget = bytes.Equal(att.Data.EpochBoundaryRootHash32, boundaryRoot)
+			performance.CorrectHead = bytes.Equal(att.Data.BeaconBlockRootHash32, headRoot)
+		}
+		res.Validators = append(res.Validators, performance)
+	}
+	return res, nil
+}
+
+// proposalCounts returns how many of the past slots of the epoch each validator was the
+// proposer of, and how many of those have no block in the canonical chain, keyed by
+// validator index.
+func proposalCounts(beaconState *pbp2p.BeaconState, epoch uint64) (map[uint64]uint64, map[uint64]uint64, error) {
+	proposals := make(map[uint64]uint64)
+	missed := make(map[uint64]uint64)
+	for slot := helpers.StartSlot(epoch); slot < helpers.StartSlot(epoch+1) && slot < beaconState.Slot; slot++ {
+		// The genesis block has no proposer.
+		if slot == params.BeaconConfig().GenesisSlot {
+			continue
+		}
+		idx, err := helpers.BeaconProposerIndex(beaconState, slot)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not get proposer index at slot %d: %v",
+				slot-params.BeaconConfig().GenesisSlot, err)
+		}
+		proposals[idx]++
+		// The block root of a slot without a block is the one of the slot before.
+		root, err := blocks.BlockRoot(beaconState, slot)
+		if err != nil {
+			return nil, nil, err
+		}
+		prevRoot, err := blocks.BlockRoot(beaconState, slot-1)
+		if err != nil {
+			return nil, nil, err
+		}
+		if bytes.Equal(root, prevRoot) {
+			missed[idx]++
+		}
+	}
+	return proposals, missed, nil
+}
+
+// ValidatorActivity returns the validators of the public keys which attested or proposed
+// a block since the slot of the request. The attestations included in the head state and
+// the ones still waiting in the operations pool are checked, as an attestation is only
+// included after the minimum inclusion delay, along with the blocks in the block roots of
+// the head state. Only the slots of the previous and the current epoch are looked into, as
+// the validator client asks at every epoch.
+func (vs *ValidatorServer) ValidatorActivity(
+	ctx context.Context,
+	req *pb.ValidatorActivityRequest) (*pb.ValidatorActivityResponse, error) {
+
+	beaconState, err := vs.beaconDB.State(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch beacon state: %v", err)
+	}
+	since := req.SinceSlot
+	if start := helpers.StartSlot(helpers.PrevEpoch(beaconState)); since < start {
+		since = start
+	}
+
+	// The latest attestation and block of each validator, keyed by validator index.
+	attested := make(map[uint64]uint64)
+	addAttesters := func(data *pbp2p.AttestationData, bitfield []byte) error {
+		if data.Slot < since {
+			return nil
+		}
+		participants, err := helpers.AttestationParticipants(beaconState, data, bitfield)
+		if err != nil {
+			return fmt.Errorf("could not get attestation participants: %v", err)
+		}
+		for _, idx := range participants {
+			if data.Slot > attested[idx] {
+				attested[idx] = data.Slot
+			}
+		}
+		return nil
+	}
+	for _, att := range beaconState.LatestAttestations {
+		if err := addAttesters(att.Data, att.AggregationBitfield); err != nil {
+			return nil, err
+		}
+	}
+	pending, err := vs.beaconDB.Attestations()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve pending attestations: %v", err)
+	}
+	for _, att := range pending {
+		// The committees of the slots after the next epoch aren't known yet.
+		if helpers.SlotToEpoch(att.Data.Slot) > helpers.NextEpoch(beaconState) {
+			continue
+		}
+		if err := addAttesters(att.Data, att.AggregationBitfield); err != nil {
+			return nil, err
+		}
+	}
+	proposed, err := blockProposers(beaconState, since)
+	if err != nil {
+		return nil, fmt.Errorf("could not get block proposers: %v", err)
+	}
+
+	res := &pb.ValidatorActivityResponse{}
+	for _, pubkey := range req.PublicKeys {
+		activity := &pb.ValidatorActivity{PublicKey: pubkey}
+		if vs.beaconDB.HasValidator(pubkey) {
+			idx, err := vs.beaconDB.ValidatorIndex(pubkey)
+			if err != nil {
+				return nil, fmt.Errorf("could not get validator index: %v", err)
+			}
+			activity.AttestationSlot = attested[idx]
+			activity.BlockSlot = proposed[idx]
+		}
+		if activity.AttestationSlot != 0 || activity.BlockSlot != 0 {
+			res.ActiveValidators = append(res.ActiveValidators, activity)
+		}
+	}
+	return res, nil
+}
+
+// blockProposers returns the slot of the latest block in the canonical chain each
+// validator proposed from the slot on, keyed by validator index.
+func blockProposers(beaconState *pbp2p.BeaconState, slot uint64) (map[uint64]uint64, error) {
+	proposed := make(map[uint64]uint64)
+	for ; slot < beaconState.Slot; slot++ {
+		// The genesis block has no proposer.
+		if slot == params.BeaconConfig().GenesisSlot {
+			continue
+		}
+		// The block root of a slot without a block is the one of the slot before.
+		root, err := blocks.BlockRoot(beaconState, slot)
+		if err != nil {
+			return nil, err
+		}
+		prevRoot, err := blocks.BlockRoot(beaconState, slot-1)
+		if err != nil {
+			return nil, err
+		}
+		if bytes.Equal(root, prevRoot) {
+			continue
+		}
+		idx, err := helpers.BeaconProposerIndex(beaconState, slot)
+		if err != nil {
+			return nil, fmt.Errorf("could not get proposer index at slot %d: %v",
+				slot-params.BeaconConfig().GenesisSlot, err)
+		}
+		proposed[idx] = slot
+	}
+	return proposed, nil
+}
+
+// ProposeExit is called by a validator to voluntarily exit the validator registry. The
+// signed exit is verified against the current beacon state, then added to the operations
+// pool, from which proposers include it in a block, and broadcast to the network.
+func (vs *ValidatorServer) ProposeExit(ctx context.Context, exit *pbp2p.VoluntaryExit) (*pb.ProposeExitResponse, error) {
+	beaconState, err := vs.beaconDB.State(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve beacon state: %v", err)
+	}
+	if err := blocks.VerifyExit(beaconState, exit, true /* verify signatures */); err != nil {
+		return nil, fmt.Errorf("could not verify exit: %v", err)
+	}
+	h, err := hashutil.HashProto(exit)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash exit: %v", err)
+	}
+	log.WithField("validatorIndex", exit.ValidatorIndex).Info("Received voluntary exit via RPC")
+	vs.operationService.IncomingExitFeed().Send(exit)
+	vs.p2p.Broadcast(exit)
+	return &pb.ProposeExitResponse{ExitHash: h[:]}, nil
+}
+
+// hasInvalidDeposit returns true if a deposit for the public key was rejected
+// because of an invalid proof of possession.
+func (vs *ValidatorServer) hasInvalidDeposit(ctx context.Context, pubkey []byte) bool {
+	for _, d := range vs.beaconDB.InvalidDeposits(ctx) {
+		depositInput, err := helpers.DecodeDepositInput(d.Deposit.DepositData)
+		if err == nil && bytes.Equal(depositInput.Pubkey, pubkey) {
+			return true
+		}
+	}
+	return false
 }
 
 func (vs *ValidatorServer) retrieveActiveValidator(beaconState *pbp2p.BeaconState, pubkey []byte) (*pbp2p.Validator, error) {
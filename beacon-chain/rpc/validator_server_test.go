@@ -1,10 +1,12 @@
 package rpc
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
 	"encoding/binary"
 	"fmt"
-	"strconv"
+	"math/big"
 	"strings"
 	"sync"
 	"testing"
@@ -17,20 +19,29 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bitutil"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/forkutil"
+	"github.com/prysmaticlabs/prysm/shared/interop"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/ssz"
 )
 
 func genesisState(validators uint64) (*pbp2p.BeaconState, error) {
 	genesisTime := time.Unix(0, 0).Unix()
+	keys, err := interop.DeterministicallyGenerateKeys(0, validators)
+	if err != nil {
+		return nil, err
+	}
+	depositInputs, err := interop.GenerateDepositInputs(keys)
+	if err != nil {
+		return nil, err
+	}
 	deposits := make([]*pbp2p.Deposit, validators)
 	for i := 0; i < len(deposits); i++ {
-		var pubKey [96]byte
-		copy(pubKey[:], []byte(strconv.Itoa(i)))
-		depositInput := &pbp2p.DepositInput{
-			Pubkey: pubKey[:],
-		}
 		depositData, err := helpers.EncodeDepositData(
-			depositInput,
+			depositInputs[i],
 			params.BeaconConfig().MaxDepositAmount,
 			genesisTime,
 		)
@@ -71,7 +82,7 @@ func TestNextEpochCommitteeAssignment_WrongPubkeyLength(t *testing.T) {
 		beaconDB: db,
 	}
 	req := &pb.ValidatorEpochAssignmentsRequest{
-		PublicKey:  []byte{},
+		PublicKeys: [][]byte{{}},
 		EpochStart: params.BeaconConfig().GenesisEpoch,
 	}
 	want := fmt.Sprintf("expected public key to have length %d", params.BeaconConfig().BLSPubkeyLength)
@@ -80,7 +91,7 @@ func TestNextEpochCommitteeAssignment_WrongPubkeyLength(t *testing.T) {
 	}
 }
 
-func TestNextEpochCommitteeAssignment_CantFindValidatorIdx(t *testing.T) {
+func TestNextEpochCommitteeAssignment_UnknownValidatorHasNoAssignment(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
 
@@ -93,12 +104,15 @@ func TestNextEpochCommitteeAssignment_CantFindValidatorIdx(t *testing.T) {
 
 	pubKey := make([]byte, 96)
 	req := &pb.ValidatorEpochAssignmentsRequest{
-		PublicKey:  pubKey,
+		PublicKeys: [][]byte{pubKey},
 		EpochStart: params.BeaconConfig().GenesisEpoch,
 	}
-	want := fmt.Sprintf("validator %#x does not exist", req.PublicKey)
-	if _, err := vs.CommitteeAssignment(context.Background(), req); !strings.Contains(err.Error(), want) {
-		t.Errorf("Expected %v, received %v", want, err)
+	res, err := vs.CommitteeAssignment(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Could not call epoch committee assignment %v", err)
+	}
+	if len(res.Assignment) != 0 {
+		t.Errorf("Expected no assignment for an unknown validator, received %v", res.Assignment)
 	}
 }
 
@@ -140,45 +154,351 @@ func TestCommitteeAssignment_OK(t *testing.T) {
 		beaconDB: db,
 	}
 
-	pubKeyBuf := make([]byte, params.BeaconConfig().BLSPubkeyLength)
-	binary.PutUvarint(pubKeyBuf, 0)
-	// Test the first validator in registry.
+	// Test the first and last validators in registry, along with a validator
+	// which is not in the registry, in a single request.
+	firstPubKey := make([]byte, params.BeaconConfig().BLSPubkeyLength)
+	binary.PutUvarint(firstPubKey, 0)
+	lastPubKey := make([]byte, params.BeaconConfig().BLSPubkeyLength)
+	binary.PutUvarint(lastPubKey, params.BeaconConfig().DepositsForChainStart-1)
+	unknownPubKey := make([]byte, params.BeaconConfig().BLSPubkeyLength)
+	binary.PutUvarint(unknownPubKey, params.BeaconConfig().DepositsForChainStart)
 	req := &pb.ValidatorEpochAssignmentsRequest{
-		PublicKey:  pubKeyBuf,
+		PublicKeys: [][]byte{firstPubKey, unknownPubKey, lastPubKey},
 		EpochStart: params.BeaconConfig().GenesisSlot,
 	}
 	res, err := vs.CommitteeAssignment(context.Background(), req)
 	if err != nil {
 		t.Fatalf("Could not call epoch committee assignment %v", err)
 	}
-	if res.Shard >= params.BeaconConfig().ShardCount {
-		t.Errorf("Assigned shard %d can't be higher than %d",
-			res.Shard, params.BeaconConfig().ShardCount)
+	if len(res.Assignment) != 2 {
+		t.Fatalf("Expected 2 assignments, received %d", len(res.Assignment))
+	}
+	for i, pubKey := range [][]byte{firstPubKey, lastPubKey} {
+		assignment := res.Assignment[i]
+		if !bytes.Equal(assignment.PublicKey, pubKey) {
+			t.Errorf("Assignment %d is for public key %#x, expected %#x", i, assignment.PublicKey, pubKey)
+		}
+		if assignment.Shard >= params.BeaconConfig().ShardCount {
+			t.Errorf("Assigned shard %d can't be higher than %d",
+				assignment.Shard, params.BeaconConfig().ShardCount)
+		}
+		if assignment.Slot > state.Slot+params.BeaconConfig().SlotsPerEpoch {
+			t.Errorf("Assigned slot %d can't be higher than %d",
+				assignment.Slot, state.Slot+params.BeaconConfig().SlotsPerEpoch)
+		}
+	}
+}
+
+func TestStreamDuties_WrongPubkeyLength(t *testing.T) {
+	vs := &ValidatorServer{
+		chainService: newMockChainService(),
 	}
-	if res.Slot > state.Slot+params.BeaconConfig().SlotsPerEpoch {
-		t.Errorf("Assigned slot %d can't be higher than %d",
-			res.Slot, state.Slot+params.BeaconConfig().SlotsPerEpoch)
+	req := &pb.DutiesRequest{
+		PublicKeys: [][]byte{{1}},
 	}
+	want := fmt.Sprintf("expected public key to have length %d", params.BeaconConfig().BLSPubkeyLength)
+	if err := vs.StreamDuties(req, nil); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %v, received %v", want, err)
+	}
+}
 
-	// Test the last validator in registry.
-	lastValidatorIndex := params.BeaconConfig().DepositsForChainStart - 1
-	pubKeyBuf = make([]byte, params.BeaconConfig().BLSPubkeyLength)
-	binary.PutUvarint(pubKeyBuf, lastValidatorIndex)
-	req = &pb.ValidatorEpochAssignmentsRequest{
-		PublicKey:  pubKeyBuf,
-		EpochStart: params.BeaconConfig().GenesisSlot,
+func TestStreamDuties_SendsDutiesWhenTheyChange(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	genesis := b.NewGenesisBlock([]byte{})
+	if err := db.SaveBlock(genesis); err != nil {
+		t.Fatalf("Could not save genesis block: %v", err)
 	}
-	res, err = vs.CommitteeAssignment(context.Background(), req)
+	beaconState, err := genesisState(params.BeaconConfig().DepositsForChainStart)
 	if err != nil {
-		t.Fatalf("Could not call epoch committee assignment %v", err)
+		t.Fatalf("Could not setup genesis state: %v", err)
+	}
+	if err := db.UpdateChainHead(genesis, beaconState); err != nil {
+		t.Fatalf("Could not save genesis state: %v", err)
+	}
+	pubKey := make([]byte, params.BeaconConfig().BLSPubkeyLength)
+	binary.PutUvarint(pubKey, 0)
+	if err := db.SaveValidatorIndex(pubKey, 0); err != nil {
+		t.Fatalf("Could not save validator index: %v", err)
+	}
+
+	chainService := newMockChainService()
+	vs := &ValidatorServer{
+		beaconDB:     db,
+		ctx:          context.Background(),
+		chainService: chainService,
+	}
+	req := &pb.DutiesRequest{
+		PublicKeys: [][]byte{pubKey},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sent := make(chan *pb.DutiesResponse, 2)
+	mockStream := internal.NewMockValidatorService_StreamDutiesServer(ctrl)
+	mockStream.EXPECT().Context().Return(ctx).AnyTimes()
+	// The duties are sent once when the stream opens and once more after the epoch
+	// transition, but not for a block which leaves them unchanged.
+	mockStream.EXPECT().Send(gomock.AssignableToTypeOf(&pb.DutiesResponse{})).Do(func(res *pb.DutiesResponse) {
+		sent <- res
+	}).Return(nil).Times(2)
+
+	exitRoutine := make(chan error)
+	go func() {
+		exitRoutine <- vs.StreamDuties(req, mockStream)
+	}()
+
+	res := <-sent
+	if res.CurrentEpoch != params.BeaconConfig().GenesisEpoch {
+		t.Errorf("Expected duties for epoch %d, received %d", params.BeaconConfig().GenesisEpoch, res.CurrentEpoch)
+	}
+	for _, duties := range []*pb.CommitteeAssignmentResponse{res.CurrentEpochDuties, res.NextEpochDuties} {
+		if len(duties.Assignment) != 1 {
+			t.Fatalf("Expected 1 assignment, received %d", len(duties.Assignment))
+		}
+		if duties.Assignment[0].ValidatorIndex != 0 || !bytes.Equal(duties.Assignment[0].PublicKey, pubKey) {
+			t.Errorf("Unexpected assignment %v", duties.Assignment[0])
+		}
+	}
+	chainService.canonicalBlockFeed.Send(&pbp2p.BeaconBlockAnnounce{})
+
+	beaconState.Slot += params.BeaconConfig().SlotsPerEpoch
+	if err := db.SaveState(beaconState); err != nil {
+		t.Fatalf("Could not save state: %v", err)
+	}
+	chainService.canonicalBlockFeed.Send(&pbp2p.BeaconBlockAnnounce{})
+	res = <-sent
+	if res.CurrentEpoch != params.BeaconConfig().GenesisEpoch+1 {
+		t.Errorf("Expected duties for epoch %d, received %d", params.BeaconConfig().GenesisEpoch+1, res.CurrentEpoch)
+	}
+
+	cancel()
+	if err := <-exitRoutine; err != nil {
+		t.Errorf("Expected the stream to close cleanly, received %v", err)
+	}
+}
+
+func TestValidatorPerformance_ReportsPreviousEpoch(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	beaconState, err := genesisState(2 * params.BeaconConfig().SlotsPerEpoch)
+	if err != nil {
+		t.Fatalf("Could not setup genesis state: %v", err)
+	}
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	beaconState.Slot = genesisSlot + params.BeaconConfig().SlotsPerEpoch + 5
+	// Every slot of the previous epoch has a block, except the fourth one.
+	missedSlot := genesisSlot + 3
+	for slot := genesisSlot; slot < beaconState.Slot; slot++ {
+		root := []byte{byte(slot - genesisSlot)}
+		if slot == missedSlot {
+			root = beaconState.LatestBlockRootHash32S[(slot-1)%params.BeaconConfig().LatestBlockRootsLength]
+		}
+		beaconState.LatestBlockRootHash32S[slot%params.BeaconConfig().LatestBlockRootsLength] = root
+	}
+	blockRoot := func(slot uint64) []byte {
+		return beaconState.LatestBlockRootHash32S[slot%params.BeaconConfig().LatestBlockRootsLength]
+	}
+
+	// The second member of a committee of the previous epoch attests, and its attestation
+	// is included two slots later, and again after that.
+	attSlot := genesisSlot + 2
+	committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, attSlot, false /* registryChange */)
+	if err != nil {
+		t.Fatal(err)
+	}
+	attester := committees[0].Committee[1]
+	data := &pbp2p.AttestationData{
+		Slot:                    attSlot,
+		Shard:                   committees[0].Shard,
+		BeaconBlockRootHash32:   blockRoot(attSlot),
+		EpochBoundaryRootHash32: blockRoot(genesisSlot),
+		JustifiedEpoch:          beaconState.PreviousJustifiedEpoch,
+	}
+	beaconState.LatestAttestations = []*pbp2p.PendingAttestation{
+		{Data: data, AggregationBitfield: bitutil.SetBitfield(1), InclusionSlot: attSlot + 4},
+		{Data: data, AggregationBitfield: bitutil.SetBitfield(1), InclusionSlot: attSlot + 2},
+	}
+	proposer, err := helpers.BeaconProposerIndex(beaconState, missedSlot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(beaconState); err != nil {
+		t.Fatalf("Could not save state: %v", err)
+	}
+	for _, idx := range []uint64{attester, proposer} {
+		if err := db.SaveValidatorIndex(beaconState.ValidatorRegistry[idx].Pubkey, int(idx)); err != nil {
+			t.Fatalf("Could not save validator index: %v", err)
+		}
+	}
+
+	vs := &ValidatorServer{
+		beaconDB: db,
+	}
+	unknownPubKey := make([]byte, params.BeaconConfig().BLSPubkeyLength)
+	res, err := vs.ValidatorPerformance(context.Background(), &pb.ValidatorPerformanceRequest{
+		PublicKeys: [][]byte{
+			beaconState.ValidatorRegistry[attester].Pubkey,
+			unknownPubKey,
+			beaconState.ValidatorRegistry[proposer].Pubkey,
+		},
+	})
+	if err != nil {
+		t.Fatalf("Could not get validator performance: %v", err)
+	}
+	if res.Epoch != params.BeaconConfig().GenesisEpoch {
+		t.Errorf("Expected the performance of epoch %d, received %d", params.BeaconConfig().GenesisEpoch, res.Epoch)
+	}
+	if len(res.Validators) != 2 {
+		t.Fatalf("Expected the performance of 2 validators, received %d", len(res.Validators))
+	}
+
+	attesterPerformance := res.Validators[0]
+	if attesterPerformance.Status != pb.ValidatorStatus_ACTIVE {
+		t.Errorf("Expected status %v, received %v", pb.ValidatorStatus_ACTIVE, attesterPerformance.Status)
+	}
+	if attesterPerformance.Balance != beaconState.ValidatorBalances[attester] {
+		t.Errorf("Expected balance %d, received %d", beaconState.ValidatorBalances[attester], attesterPerformance.Balance)
+	}
+	if attesterPerformance.EffectiveBalance != params.BeaconConfig().MaxDepositAmount {
+		t.Errorf("Expected effective balance %d, received %d", params.BeaconConfig().MaxDepositAmount, attesterPerformance.EffectiveBalance)
+	}
+	if !attesterPerformance.AttestationIncluded || attesterPerformance.InclusionDistance != 2 {
+		t.Errorf("Expected the attestation to be included after 2 slots, received %v", attesterPerformance)
+	}
+	if !attesterPerformance.CorrectSource || !attesterPerformance.CorrectTarget || !attesterPerformance.CorrectHead {
+		t.Errorf("Expected correct source, target and head votes, received %v", attesterPerformance)
+	}
+
+	proposerPerformance := res.Validators[1]
+	if proposerPerformance.MissedProposals != 1 || proposerPerformance.Proposals < 1 {
+		t.Errorf("Expected 1 missed proposal, received %d of %d", proposerPerformance.MissedProposals, proposerPerformance.Proposals)
+	}
+}
+
+func TestValidatorActivity_ReportsAttestationsAndBlocks(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	beaconState, err := genesisState(2 * params.BeaconConfig().SlotsPerEpoch)
+	if err != nil {
+		t.Fatalf("Could not setup genesis state: %v", err)
+	}
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	beaconState.Slot = genesisSlot + params.BeaconConfig().SlotsPerEpoch + 5
+	for slot := genesisSlot; slot < beaconState.Slot; slot++ {
+		beaconState.LatestBlockRootHash32S[slot%params.BeaconConfig().LatestBlockRootsLength] = []byte{byte(slot - genesisSlot)}
+	}
+	since := genesisSlot + params.BeaconConfig().SlotsPerEpoch
+
+	// A member of a committee attests after the slot of the request, and another one before.
+	attestation := func(slot uint64) (*pbp2p.PendingAttestation, uint64) {
+		committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, slot, false /* registryChange */)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data := &pbp2p.AttestationData{Slot: slot, Shard: committees[0].Shard}
+		return &pbp2p.PendingAttestation{Data: data, AggregationBitfield: bitutil.SetBitfield(0)}, committees[0].Committee[0]
+	}
+	att, attester := attestation(since + 1)
+	oldAtt, oldAttester := attestation(genesisSlot + 2)
+	beaconState.LatestAttestations = []*pbp2p.PendingAttestation{att, oldAtt}
+	proposer, err := helpers.BeaconProposerIndex(beaconState, since+2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(beaconState); err != nil {
+		t.Fatalf("Could not save state: %v", err)
+	}
+	for _, idx := range []uint64{attester, oldAttester, proposer} {
+		if err := db.SaveValidatorIndex(beaconState.ValidatorRegistry[idx].Pubkey, int(idx)); err != nil {
+			t.Fatalf("Could not save validator index: %v", err)
+		}
+	}
+
+	unknownPubKey := bytes.Repeat([]byte{'A'}, params.BeaconConfig().BLSPubkeyLength)
+	vs := &ValidatorServer{
+		beaconDB: db,
+	}
+	res, err := vs.ValidatorActivity(context.Background(), &pb.ValidatorActivityRequest{
+		PublicKeys: [][]byte{
+			beaconState.ValidatorRegistry[attester].Pubkey,
+			beaconState.ValidatorRegistry[oldAttester].Pubkey,
+			beaconState.ValidatorRegistry[proposer].Pubkey,
+			unknownPubKey,
+		},
+		SinceSlot: since,
+	})
+	if err != nil {
+		t.Fatalf("Could not get validator activity: %v", err)
+	}
+	activity := make(map[[48]byte]*pb.ValidatorActivity)
+	for _, a := range res.ActiveValidators {
+		activity[bytesutil.ToBytes48(a.PublicKey)] = a
+	}
+
+	if a := activity[bytesutil.ToBytes48(beaconState.ValidatorRegistry[attester].Pubkey)]; a == nil || a.AttestationSlot != since+1 {
+		t.Errorf("Expected the attestation at slot %d to be reported, received %v", since+1, a)
+	}
+	if a := activity[bytesutil.ToBytes48(beaconState.ValidatorRegistry[oldAttester].Pubkey)]; a != nil && a.AttestationSlot != 0 {
+		t.Errorf("Expected the attestation before the slot of the request not to be reported, received %v", a)
+	}
+	if a := activity[bytesutil.ToBytes48(beaconState.ValidatorRegistry[proposer].Pubkey)]; a == nil || a.BlockSlot < since+2 {
+		t.Errorf("Expected the block at slot %d to be reported, received %v", since+2, a)
+	}
+	if a := activity[bytesutil.ToBytes48(unknownPubKey)]; a != nil {
+		t.Errorf("Expected the validator not in the registry not to be reported, received %v", a)
+	}
+}
+
+func TestValidatorActivity_ReportsPendingAttestations(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	beaconState, err := genesisState(2 * params.BeaconConfig().SlotsPerEpoch)
+	if err != nil {
+		t.Fatalf("Could not setup genesis state: %v", err)
+	}
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	beaconState.Slot = genesisSlot + params.BeaconConfig().SlotsPerEpoch + 5
+	if err := db.SaveState(beaconState); err != nil {
+		t.Fatalf("Could not save state: %v", err)
+	}
+
+	// The attestation of the last slot is still in the pool, waiting for the minimum
+	// inclusion delay, and isn't in the head state.
+	slot := beaconState.Slot - 1
+	committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, slot, false /* registryChange */)
+	if err != nil {
+		t.Fatal(err)
+	}
+	attester := committees[0].Committee[0]
+	if err := db.SaveAttestation(&pbp2p.Attestation{
+		Data:                &pbp2p.AttestationData{Slot: slot, Shard: committees[0].Shard},
+		AggregationBitfield: bitutil.SetBitfield(0),
+	}); err != nil {
+		t.Fatalf("Could not save attestation: %v", err)
+	}
+	pubKey := beaconState.ValidatorRegistry[attester].Pubkey
+	if err := db.SaveValidatorIndex(pubKey, int(attester)); err != nil {
+		t.Fatalf("Could not save validator index: %v", err)
 	}
-	if res.Shard >= params.BeaconConfig().ShardCount {
-		t.Errorf("Assigned shard %d can't be higher than %d",
-			res.Shard, params.BeaconConfig().ShardCount)
+
+	vs := &ValidatorServer{
+		beaconDB: db,
 	}
-	if res.Slot > state.Slot+params.BeaconConfig().SlotsPerEpoch {
-		t.Errorf("Assigned slot %d can't be higher than %d",
-			res.Slot, state.Slot+params.BeaconConfig().SlotsPerEpoch)
+	res, err := vs.ValidatorActivity(context.Background(), &pb.ValidatorActivityRequest{
+		PublicKeys: [][]byte{pubKey},
+		SinceSlot:  genesisSlot + params.BeaconConfig().SlotsPerEpoch,
+	})
+	if err != nil {
+		t.Fatalf("Could not get validator activity: %v", err)
+	}
+	if len(res.ActiveValidators) != 1 || res.ActiveValidators[0].AttestationSlot != slot {
+		t.Errorf("Expected the pending attestation at slot %d to be reported, received %v", slot, res.ActiveValidators)
 	}
 }
 
@@ -436,6 +756,40 @@ func TestValidatorStatus_UnknownStatus(t *testing.T) {
 	}
 }
 
+func TestValidatorStatus_InvalidDeposit(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	if err := db.SaveState(&pbp2p.BeaconState{ValidatorRegistry: []*pbp2p.Validator{}}); err != nil {
+		t.Fatalf("could not save state: %v", err)
+	}
+	pubKey := []byte{'A'}
+	data, err := helpers.EncodeDepositData(
+		&pbp2p.DepositInput{Pubkey: pubKey},
+		params.BeaconConfig().MaxDepositAmount,
+		time.Now().Unix(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.InsertInvalidDeposit(ctx, &pbp2p.Deposit{DepositData: data}, big.NewInt(0), "invalid signature")
+
+	vs := &ValidatorServer{
+		beaconDB: db,
+	}
+	req := &pb.ValidatorIndexRequest{
+		PublicKey: pubKey,
+	}
+	resp, err := vs.ValidatorStatus(ctx, req)
+	if err != nil {
+		t.Fatalf("Could not get validator status %v", err)
+	}
+	if resp.Status != pb.ValidatorStatus_INVALID_DEPOSIT {
+		t.Errorf("Wanted %v, got %v", pb.ValidatorStatus_INVALID_DEPOSIT, resp.Status)
+	}
+}
+
 func TestWaitForActivation_ContextClosed(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
@@ -455,7 +809,7 @@ func TestWaitForActivation_ContextClosed(t *testing.T) {
 		canonicalStateChan: make(chan *pbp2p.BeaconState, 1),
 	}
 	req := &pb.ValidatorActivationRequest{
-		Pubkey: []byte("A"),
+		PublicKeys: [][]byte{[]byte("A")},
 	}
 
 	ctrl := gomock.NewController(t)
@@ -501,7 +855,7 @@ func TestWaitForActivation_ValidatorOriginallyExists(t *testing.T) {
 		canonicalStateChan: make(chan *pbp2p.BeaconState, 1),
 	}
 	req := &pb.ValidatorActivationRequest{
-		Pubkey: pubKey,
+		PublicKeys: [][]byte{[]byte("B"), pubKey},
 	}
 
 	ctrl := gomock.NewController(t)
@@ -509,7 +863,7 @@ func TestWaitForActivation_ValidatorOriginallyExists(t *testing.T) {
 	mockStream := internal.NewMockValidatorService_WaitForActivationServer(ctrl)
 	mockStream.EXPECT().Send(
 		&pb.ValidatorActivationResponse{
-			Validator: beaconState.ValidatorRegistry[0],
+			Validators: []*pbp2p.Validator{beaconState.ValidatorRegistry[0]},
 		},
 	).Return(nil)
 
@@ -517,3 +871,98 @@ func TestWaitForActivation_ValidatorOriginallyExists(t *testing.T) {
 		t.Fatalf("Could not setup wait for activation stream: %v", err)
 	}
 }
+
+// exitState returns a beacon state at genesis with a validator registry of the given
+// keys, along with a signing function producing valid exits for those validators.
+func exitState(t *testing.T, keys int) (*pbp2p.BeaconState, func(index uint64) *pbp2p.VoluntaryExit) {
+	privKeys := make([]*bls.SecretKey, keys)
+	registry := make([]*pbp2p.Validator, keys)
+	for i := range privKeys {
+		priv, err := bls.RandKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		privKeys[i] = priv
+		registry[i] = &pbp2p.Validator{
+			Pubkey:          priv.PublicKey().Marshal(),
+			ActivationEpoch: params.BeaconConfig().GenesisEpoch,
+			ExitEpoch:       params.BeaconConfig().FarFutureEpoch,
+		}
+	}
+	beaconState := &pbp2p.BeaconState{
+		Slot: params.BeaconConfig().GenesisSlot,
+		Fork: &pbp2p.Fork{
+			PreviousVersion: params.BeaconConfig().GenesisForkVersion,
+			CurrentVersion:  params.BeaconConfig().GenesisForkVersion,
+			Epoch:           params.BeaconConfig().GenesisEpoch,
+		},
+		ValidatorRegistry: registry,
+	}
+	sign := func(index uint64) *pbp2p.VoluntaryExit {
+		exit := &pbp2p.VoluntaryExit{
+			Epoch:          params.BeaconConfig().GenesisEpoch,
+			ValidatorIndex: index,
+		}
+		root, err := ssz.SigningRoot(exit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		domain := forkutil.DomainVersion(beaconState.Fork, exit.Epoch, params.BeaconConfig().DomainExit)
+		exit.Signature = privKeys[index].Sign(root[:], domain).Marshal()
+		return exit
+	}
+	return beaconState, sign
+}
+
+func TestProposeExit_OK(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	beaconState, sign := exitState(t, 1)
+	if err := db.SaveState(beaconState); err != nil {
+		t.Fatalf("Could not save state: %v", err)
+	}
+	p2p := &mockP2P{}
+	vs := &ValidatorServer{
+		beaconDB:         db,
+		operationService: &mockOperationService{},
+		p2p:              p2p,
+	}
+	exit := sign(0)
+	res, err := vs.ProposeExit(context.Background(), exit)
+	if err != nil {
+		t.Fatalf("Could not propose exit: %v", err)
+	}
+	if len(res.ExitHash) != 32 {
+		t.Errorf("Expected a 32 byte exit hash, received %#x", res.ExitHash)
+	}
+	if len(p2p.broadcasted) != 1 || p2p.broadcasted[0] != exit {
+		t.Errorf("Expected exit to be broadcast, broadcast %v", p2p.broadcasted)
+	}
+}
+
+func TestProposeExit_InvalidSignature(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	beaconState, sign := exitState(t, 2)
+	if err := db.SaveState(beaconState); err != nil {
+		t.Fatalf("Could not save state: %v", err)
+	}
+	p2p := &mockP2P{}
+	vs := &ValidatorServer{
+		beaconDB:         db,
+		operationService: &mockOperationService{},
+		p2p:              p2p,
+	}
+	// An exit of validator 1 signed by the key of validator 0.
+	exit := sign(0)
+	exit.ValidatorIndex = 1
+	want := "could not verify exit"
+	if _, err := vs.ProposeExit(context.Background(), exit); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+	if len(p2p.broadcasted) != 0 {
+		t.Errorf("Expected invalid exit not to be broadcast, broadcast %v", p2p.broadcasted)
+	}
+}
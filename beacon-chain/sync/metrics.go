@@ -76,6 +76,14 @@ var (
 		Name: "regsync_sent_exits",
 		Help: "The number of sent exits",
 	})
+	recAggregate = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "regsync_received_aggregates",
+		Help: "The number of received aggregate attestations",
+	})
+	sentAggregate = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "regsync_sent_aggregates",
+		Help: "The number of sent aggregate attestations",
+	})
 	chainHeadReq = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "regsync_chain_head_req",
 		Help: "The number of sent attestation requests",
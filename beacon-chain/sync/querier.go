@@ -4,10 +4,12 @@ import (
 	"context"
 	"time"
 
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/forkutil"
 	"github.com/prysmaticlabs/prysm/shared/p2p"
 	"github.com/sirupsen/logrus"
 )
@@ -160,6 +162,17 @@ func (q *Querier) run() {
 			q.RequestLatestHead()
 		case msg := <-q.responseBuf:
 			response := msg.Data.(*pb.ChainHeadResponse)
+			if !onScheduledFork(response.Slot, response.ForkVersion) {
+				queryLog.WithFields(logrus.Fields{
+					"peer":        msg.Peer,
+					"slot":        response.Slot,
+					"forkVersion": response.ForkVersion,
+				}).Warn("Disconnecting peer on a different fork")
+				if err := q.p2p.Disconnect(msg.Peer); err != nil {
+					queryLog.Errorf("Could not disconnect peer: %v", err)
+				}
+				continue
+			}
 			queryLog.Infof("Latest chain head is at slot: %d and hash %#x", response.Slot, response.Hash)
 			q.currentHeadSlot = response.Slot
 			q.currentHeadHash = response.Hash
@@ -200,3 +213,9 @@ func (q *Querier) IsSynced() (bool, error) {
 
 	return false, err
 }
+
+// onScheduledFork checks if a peer reporting the given fork version for its chain
+// head at the given slot follows the same fork schedule as this node.
+func onScheduledFork(slot uint64, forkVersion uint64) bool {
+	return forkutil.ScheduledForkVersion(helpers.SlotToEpoch(slot)) == forkVersion
+}
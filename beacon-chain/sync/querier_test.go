@@ -6,10 +6,12 @@ import (
 	"testing"
 	"time"
 
+	peer "github.com/libp2p/go-libp2p-peer"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/p2p"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
@@ -160,3 +162,47 @@ func TestQuerier_ChainReqResponse(t *testing.T) {
 
 	hook.Reset()
 }
+
+func TestQuerier_DisconnectsPeerOnDifferentFork(t *testing.T) {
+	hook := logTest.NewGlobal()
+	defer params.OverrideBeaconConfig(params.BeaconConfig())
+	c := *params.BeaconConfig()
+	c.ForkSchedule = map[uint64]uint64{1: 1}
+	params.OverrideBeaconConfig(&c)
+
+	mp := &mockP2P{}
+	cfg := &QuerierConfig{
+		P2P:                mp,
+		ResponseBufferSize: 100,
+		PowChain:           &afterGenesisPowChain{},
+	}
+	sq := NewQuerierService(context.Background(), cfg)
+
+	exitRoutine := make(chan bool)
+	go func() {
+		sq.run()
+		exitRoutine <- true
+	}()
+
+	response := &pb.ChainHeadResponse{
+		Slot:        c.GenesisSlot + c.SlotsPerEpoch,
+		Hash:        []byte{'a', 'b'},
+		ForkVersion: c.GenesisForkVersion,
+	}
+	sq.responseBuf <- p2p.Message{Data: response, Peer: peer.ID("forked")}
+	testutil.WaitForLog(t, hook, "Disconnecting peer on a different fork")
+	if sq.currentHeadSlot != 0 {
+		t.Errorf("Expected chain head of peer on a different fork to be ignored, head slot is %d", sq.currentHeadSlot)
+	}
+	disconnected := mp.disconnectedPeers()
+	if len(disconnected) != 1 || disconnected[0] != peer.ID("forked") {
+		t.Errorf("Expected peer on a different fork to be disconnected, disconnected peers are %v", disconnected)
+	}
+
+	response.ForkVersion = 1
+	sq.responseBuf <- p2p.Message{Data: response}
+	testutil.WaitForLog(t, hook, fmt.Sprintf("Latest chain head is at slot: %d and hash %#x", response.Slot, response.Hash))
+
+	<-exitRoutine
+	hook.Reset()
+}
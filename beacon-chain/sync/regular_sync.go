@@ -6,12 +6,16 @@ import (
 	"fmt"
 
 	"github.com/gogo/protobuf/proto"
+	peer "github.com/libp2p/go-libp2p-peer"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/forkutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/p2p"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -43,6 +47,7 @@ type p2pAPI interface {
 	p2p.Sender
 	Subscribe(msg proto.Message, channel chan p2p.Message) event.Subscription
 	Broadcast(msg proto.Message)
+	Disconnect(peerID peer.ID) error
 }
 
 // RegularSync is the gateway and the bridge between the p2p network and the local beacon chain.
@@ -76,6 +81,7 @@ type RegularSync struct {
 	attestationReqByHashBuf  chan p2p.Message
 	unseenAttestationsReqBuf chan p2p.Message
 	exitBuf                  chan p2p.Message
+	aggregateBuf             chan p2p.Message
 	canonicalBuf             chan *pb.BeaconBlockAnnounce
 	highestObservedSlot      uint64
 	blocksAwaitingProcessing map[[32]byte]*pb.BeaconBlock
@@ -93,6 +99,7 @@ type RegularSyncConfig struct {
 	AttestationReqHashBufSize    int
 	UnseenAttestationsReqBufSize int
 	ExitBufferSize               int
+	AggregateBufferSize          int
 	ChainHeadReqBufferSize       int
 	CanonicalBufferSize          int
 	ChainService                 chainService
@@ -115,6 +122,7 @@ func DefaultRegularSyncConfig() *RegularSyncConfig {
 		AttestationReqHashBufSize:    100,
 		UnseenAttestationsReqBufSize: 100,
 		ExitBufferSize:               100,
+		AggregateBufferSize:          100,
 		CanonicalBufferSize:          100,
 	}
 }
@@ -140,6 +148,7 @@ func NewRegularSyncService(ctx context.Context, cfg *RegularSyncConfig) *Regular
 		attestationReqByHashBuf:  make(chan p2p.Message, cfg.AttestationReqHashBufSize),
 		unseenAttestationsReqBuf: make(chan p2p.Message, cfg.UnseenAttestationsReqBufSize),
 		exitBuf:                  make(chan p2p.Message, cfg.ExitBufferSize),
+		aggregateBuf:             make(chan p2p.Message, cfg.AggregateBufferSize),
 		chainHeadReqBuf:          make(chan p2p.Message, cfg.ChainHeadReqBufferSize),
 		canonicalBuf:             make(chan *pb.BeaconBlockAnnounce, cfg.CanonicalBufferSize),
 		blocksAwaitingProcessing: make(map[[32]byte]*pb.BeaconBlock),
@@ -181,6 +190,7 @@ func (rs *RegularSync) run() {
 	attestationReqSub := rs.p2p.Subscribe(&pb.AttestationRequest{}, rs.attestationReqByHashBuf)
 	unseenAttestationsReqSub := rs.p2p.Subscribe(&pb.UnseenAttestationsRequest{}, rs.unseenAttestationsReqBuf)
 	exitSub := rs.p2p.Subscribe(&pb.VoluntaryExit{}, rs.exitBuf)
+	aggregateSub := rs.p2p.Subscribe(&pb.AggregateAndProof{}, rs.aggregateBuf)
 	chainHeadReqSub := rs.p2p.Subscribe(&pb.ChainHeadRequest{}, rs.chainHeadReqBuf)
 	canonicalBlockSub := rs.chainService.CanonicalBlockFeed().Subscribe(rs.canonicalBuf)
 
@@ -195,6 +205,7 @@ func (rs *RegularSync) run() {
 	defer attestationReqSub.Unsubscribe()
 	defer unseenAttestationsReqSub.Unsubscribe()
 	defer exitSub.Unsubscribe()
+	defer aggregateSub.Unsubscribe()
 	defer canonicalBlockSub.Unsubscribe()
 
 	for {
@@ -212,6 +223,8 @@ func (rs *RegularSync) run() {
 			safelyHandleMessage(rs.handleUnseenAttestationsRequest, msg)
 		case msg := <-rs.exitBuf:
 			safelyHandleMessage(rs.receiveExitRequest, msg)
+		case msg := <-rs.aggregateBuf:
+			safelyHandleMessage(rs.receiveAggregateAndProof, msg)
 		case msg := <-rs.blockBuf:
 			safelyHandleMessage(rs.receiveBlock, msg)
 		case msg := <-rs.blockRequestBySlot:
@@ -489,6 +502,7 @@ func (rs *RegularSync) handleChainHeadRequest(msg p2p.Message) {
 		Slot:                      block.Slot,
 		Hash:                      blockRoot[:],
 		FinalizedStateRootHash32S: finalizedRoot[:],
+		ForkVersion:               forkutil.ScheduledForkVersion(helpers.SlotToEpoch(block.Slot)),
 	}
 	ctx, ChainHead := trace.StartSpan(ctx, "sendChainHead")
 	defer ChainHead.End()
@@ -564,6 +578,44 @@ func (rs *RegularSync) receiveExitRequest(msg p2p.Message) {
 	sendExitReqSpan.End()
 }
 
+// receiveAggregateAndProof accepts a broadcasted aggregate from the p2p layer,
+// discard the aggregate if we have gotten it before or if it or its aggregator
+// does not verify, send the aggregate to the attestation pool if it does.
+func (rs *RegularSync) receiveAggregateAndProof(msg p2p.Message) {
+	ctx, span := trace.StartSpan(msg.Ctx, "beacon-chain.sync.receiveAggregateAndProof")
+	defer span.End()
+	recAggregate.Inc()
+	aggregateAndProof := msg.Data.(*pb.AggregateAndProof)
+	if aggregateAndProof.Aggregate == nil {
+		log.Debug("Received aggregate and proof without an aggregate")
+		return
+	}
+	h, err := hashutil.HashProto(aggregateAndProof.Aggregate)
+	if err != nil {
+		log.Errorf("Could not hash received aggregate: %v", err)
+		return
+	}
+
+	if rs.db.HasAttestation(h) {
+		log.Debugf("Received, skipping aggregate #%x", h)
+		return
+	}
+	beaconState, err := rs.db.State(ctx)
+	if err != nil {
+		log.Errorf("Failed to get beacon state: %v", err)
+		return
+	}
+	if err := attestations.VerifyAggregateAndProof(beaconState, aggregateAndProof); err != nil {
+		log.Debugf("Skipping aggregate #%x which does not verify: %v", h, err)
+		return
+	}
+	_, sendAggregateSpan := trace.StartSpan(ctx, "beacon-chain.sync.sendAggregate")
+	log.WithField("aggregateHash", fmt.Sprintf("%#x", h)).Debug("Sending newly received aggregate to subscribers")
+	rs.operationsService.IncomingAttFeed().Send(aggregateAndProof.Aggregate)
+	sentAggregate.Inc()
+	sendAggregateSpan.End()
+}
+
 func (rs *RegularSync) handleBlockRequestByHash(msg p2p.Message) {
 	ctx, span := trace.StartSpan(msg.Ctx, "beacon-chain.sync.handleBlockRequestByHash")
 	defer span.End()
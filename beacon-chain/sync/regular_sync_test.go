@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"strconv"
+	"sync"
 	"testing"
 	"time"
 
@@ -29,6 +30,8 @@ func init() {
 }
 
 type mockP2P struct {
+	lock         sync.Mutex
+	disconnected []peer.ID
 }
 
 func (mp *mockP2P) Subscribe(msg proto.Message, channel chan p2p.Message) event.Subscription {
@@ -41,6 +44,19 @@ func (mp *mockP2P) Send(ctx context.Context, msg proto.Message, peerID peer.ID)
 	return nil
 }
 
+func (mp *mockP2P) Disconnect(peerID peer.ID) error {
+	mp.lock.Lock()
+	defer mp.lock.Unlock()
+	mp.disconnected = append(mp.disconnected, peerID)
+	return nil
+}
+
+func (mp *mockP2P) disconnectedPeers() []peer.ID {
+	mp.lock.Lock()
+	defer mp.lock.Unlock()
+	return mp.disconnected
+}
+
 type mockChainService struct {
 	bFeed *event.Feed
 	sFeed *event.Feed
@@ -609,6 +625,55 @@ func TestReceiveExitReq_OK(t *testing.T) {
 	testutil.AssertLogsContain(t, hook, "Forwarding validator exit request to subscribed services")
 }
 
+func TestReceiveAggregateAndProof_SkipsInvalidAggregate(t *testing.T) {
+	hook := logTest.NewGlobal()
+	os := &mockOperationService{}
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	beaconState := &pb.BeaconState{
+		Slot: params.BeaconConfig().GenesisSlot,
+		Fork: &pb.Fork{},
+	}
+	if err := db.SaveState(beaconState); err != nil {
+		t.Fatalf("Could not save state: %v", err)
+	}
+	cfg := &RegularSyncConfig{
+		OperationService: os,
+		P2P:              &mockP2P{},
+		BeaconDB:         db,
+		ChainService:     &mockChainService{},
+	}
+	ss := NewRegularSyncService(context.Background(), cfg)
+
+	exitRoutine := make(chan bool)
+	go func() {
+		ss.run()
+		exitRoutine <- true
+	}()
+
+	request1 := &pb.AggregateAndProof{
+		AggregatorIndex: 1,
+		Aggregate: &pb.Attestation{
+			Data: &pb.AttestationData{
+				Slot: params.BeaconConfig().GenesisSlot,
+			},
+		},
+	}
+
+	msg1 := p2p.Message{
+		Ctx:  context.Background(),
+		Data: request1,
+		Peer: "",
+	}
+
+	ss.aggregateBuf <- msg1
+	ss.cancel()
+	<-exitRoutine
+	testutil.AssertLogsContain(t, hook, "which does not verify")
+	testutil.AssertLogsDoNotContain(t, hook, "Sending newly received aggregate to subscribers")
+}
+
 func TestHandleAttReq_HashNotFound(t *testing.T) {
 	hook := logTest.NewGlobal()
 	os := &mockOperationService{}
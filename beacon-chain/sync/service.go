@@ -91,6 +91,13 @@ func (ss *Service) Status() error {
 	return nil
 }
 
+// Syncing returns true if the node has not caught up with the chain head of its
+// peers, or could not tell whether it has.
+func (ss *Service) Syncing() bool {
+	synced, err := ss.Querier.IsSynced()
+	return err != nil || !synced
+}
+
 func (ss *Service) run() {
 	ss.Querier.Start()
 	synced, err := ss.Querier.IsSynced()
@@ -69,6 +69,10 @@ func (sim *simulatedP2P) Send(ctx context.Context, msg proto.Message, peerID pee
 	return nil
 }
 
+func (sim *simulatedP2P) Disconnect(peerID peer.ID) error {
+	return nil
+}
+
 func setupSimBackendAndDB(t *testing.T) (*backend.SimulatedBackend, *db.BeaconDB, []*bls.SecretKey) {
 	bd, err := backend.NewSimulatedBackend()
 	if err != nil {
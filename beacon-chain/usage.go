@@ -47,6 +47,8 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.RelayNode,
 			cmd.P2PPort,
 			cmd.DataDirFlag,
+			cmd.ForkScheduleFlag,
+			cmd.BLSBackendFlag,
 			cmd.VerbosityFlag,
 			cmd.EnableTracingFlag,
 			cmd.TracingEndpointFlag,
@@ -76,6 +78,8 @@ var appHelpFlagGroups = []flagGroup{
 			utils.CertFlag,
 			utils.KeyFlag,
 			utils.GenesisJSON,
+			utils.InteropNumValidatorsFlag,
+			utils.InteropGenesisTimeFlag,
 			utils.EnableDBCleanup,
 			utils.ChainStartDelay,
 		},
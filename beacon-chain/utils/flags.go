@@ -38,11 +38,24 @@ var (
 		Name:  "tls-key",
 		Usage: "Key for secure gRPC. Pass this and the tls-cert flag in order to use gRPC securely.",
 	}
-	// GenesisJSON defines a flag for bootstrapping validators from genesis JSON.
-	// If this flag is not specified, beacon node will bootstrap validators from code from state.go.
+	// GenesisJSON defines a flag for bootstrapping the beacon chain from a genesis file generated
+	// by the genesis-state-gen tool. If this flag is not specified, beacon node will wait for the
+	// ChainStart log of the deposit contract to generate the genesis state.
 	GenesisJSON = cli.StringFlag{
 		Name:  "genesis-json",
-		Usage: "Beacon node will bootstrap genesis state defined in genesis.json",
+		Usage: "Beacon node will bootstrap genesis state defined in genesis.json, without requiring a deposit contract",
+	}
+	// InteropNumValidatorsFlag starts the beacon chain from a genesis state of deterministically
+	// generated validator keys, without requiring a deposit contract.
+	InteropNumValidatorsFlag = cli.Uint64Flag{
+		Name:  "interop-num-validators",
+		Usage: "Number of deterministically generated genesis validators for interop testing",
+	}
+	// InteropGenesisTimeFlag sets the genesis time of an interop genesis state. Nodes on the same
+	// interop network must use the same value.
+	InteropGenesisTimeFlag = cli.Uint64Flag{
+		Name:  "interop-genesis-time",
+		Usage: "Unix genesis time of the interop genesis state, defaults to the current time",
 	}
 	// EnableDBCleanup tells the beacon node to automatically clean DB content such as block vote cache.
 	EnableDBCleanup = cli.BoolFlag{
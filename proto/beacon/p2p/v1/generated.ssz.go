@@ -0,0 +1,3089 @@
+// Code generated by sszgen. DO NOT EDIT.
+
+package ethereum_beacon_p2p_v1
+
+import "github.com/prysmaticlabs/prysm/shared/ssz/sszutil"
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *BeaconBlockAnnounce) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *BeaconBlockAnnounce) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	e.Bytes(m.Hash)
+	e.Uint64(m.SlotNumber)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *BeaconBlockAnnounce) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *BeaconBlockAnnounce) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.Hash = d.Bytes()
+	m.SlotNumber = d.Uint64()
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *BeaconBlockAnnounce) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Bytes(m.Hash)
+	h.Uint64(m.SlotNumber)
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *BeaconBlockRequest) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *BeaconBlockRequest) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	e.Bytes(m.Hash)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *BeaconBlockRequest) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *BeaconBlockRequest) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.Hash = d.Bytes()
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *BeaconBlockRequest) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Bytes(m.Hash)
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *BeaconBlockRequestBySlotNumber) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *BeaconBlockRequestBySlotNumber) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	e.Uint64(m.SlotNumber)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *BeaconBlockRequestBySlotNumber) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *BeaconBlockRequestBySlotNumber) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.SlotNumber = d.Uint64()
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *BeaconBlockRequestBySlotNumber) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Uint64(m.SlotNumber)
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *BeaconBlockResponse) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *BeaconBlockResponse) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	m.Block.MarshalSSZTo(e)
+	m.Attestation.MarshalSSZTo(e)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *BeaconBlockResponse) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *BeaconBlockResponse) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.Block = nil
+	if !d.IsNil() {
+		m.Block = &BeaconBlock{}
+		m.Block.UnmarshalSSZFrom(d)
+	}
+	m.Attestation = nil
+	if !d.IsNil() {
+		m.Attestation = &Attestation{}
+		m.Attestation.UnmarshalSSZFrom(d)
+	}
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *BeaconBlockResponse) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Root(m.Block.HashTreeRoot())
+	h.Root(m.Attestation.HashTreeRoot())
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *BatchedBeaconBlockRequest) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *BatchedBeaconBlockRequest) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	e.Uint64(m.StartSlot)
+	e.Uint64(m.EndSlot)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *BatchedBeaconBlockRequest) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *BatchedBeaconBlockRequest) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.StartSlot = d.Uint64()
+	m.EndSlot = d.Uint64()
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *BatchedBeaconBlockRequest) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Uint64(m.StartSlot)
+	h.Uint64(m.EndSlot)
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *BatchedBeaconBlockResponse) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *BatchedBeaconBlockResponse) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	{
+		offset1 := e.Begin()
+		for _, v1 := range m.BatchedBlocks {
+			v1.MarshalSSZTo(e)
+		}
+		e.End(offset1)
+	}
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *BatchedBeaconBlockResponse) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *BatchedBeaconBlockResponse) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.BatchedBlocks = nil
+	{
+		end1 := d.Begin()
+		for d.More(end1) {
+			var v1 *BeaconBlock
+			if !d.IsNil() {
+				v1 = &BeaconBlock{}
+				v1.UnmarshalSSZFrom(d)
+			}
+			m.BatchedBlocks = append(m.BatchedBlocks, v1)
+		}
+		d.End(end1)
+	}
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *BatchedBeaconBlockResponse) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	{
+		l1 := &sszutil.Hasher{}
+		for _, v1 := range m.BatchedBlocks {
+			l1.Root(v1.HashTreeRoot())
+		}
+		h.List(l1)
+	}
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *ChainHeadRequest) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *ChainHeadRequest) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *ChainHeadRequest) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *ChainHeadRequest) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *ChainHeadRequest) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *ChainHeadResponse) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *ChainHeadResponse) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	e.Bytes(m.Hash)
+	e.Uint64(m.Slot)
+	e.Bytes(m.FinalizedStateRootHash32S)
+	e.Uint64(m.ForkVersion)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *ChainHeadResponse) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *ChainHeadResponse) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.Hash = d.Bytes()
+	m.Slot = d.Uint64()
+	m.FinalizedStateRootHash32S = d.Bytes()
+	m.ForkVersion = d.Uint64()
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *ChainHeadResponse) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Bytes(m.Hash)
+	h.Uint64(m.Slot)
+	h.Bytes(m.FinalizedStateRootHash32S)
+	h.Uint64(m.ForkVersion)
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *BeaconStateHashAnnounce) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *BeaconStateHashAnnounce) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	e.Bytes(m.Hash)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *BeaconStateHashAnnounce) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *BeaconStateHashAnnounce) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.Hash = d.Bytes()
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *BeaconStateHashAnnounce) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Bytes(m.Hash)
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *BeaconStateRequest) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *BeaconStateRequest) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	e.Bytes(m.FinalizedStateRootHash32S)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *BeaconStateRequest) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *BeaconStateRequest) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.FinalizedStateRootHash32S = d.Bytes()
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *BeaconStateRequest) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Bytes(m.FinalizedStateRootHash32S)
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *BeaconStateResponse) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *BeaconStateResponse) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	m.BeaconState.MarshalSSZTo(e)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *BeaconStateResponse) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *BeaconStateResponse) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.BeaconState = nil
+	if !d.IsNil() {
+		m.BeaconState = &BeaconState{}
+		m.BeaconState.UnmarshalSSZFrom(d)
+	}
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *BeaconStateResponse) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Root(m.BeaconState.HashTreeRoot())
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *AttestationAnnounce) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *AttestationAnnounce) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	e.Bytes(m.Hash)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *AttestationAnnounce) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *AttestationAnnounce) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.Hash = d.Bytes()
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *AttestationAnnounce) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Bytes(m.Hash)
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *AttestationRequest) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *AttestationRequest) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	e.Bytes(m.Hash)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *AttestationRequest) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *AttestationRequest) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.Hash = d.Bytes()
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *AttestationRequest) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Bytes(m.Hash)
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *AttestationResponse) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *AttestationResponse) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	e.Bytes(m.Hash)
+	m.Attestation.MarshalSSZTo(e)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *AttestationResponse) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *AttestationResponse) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.Hash = d.Bytes()
+	m.Attestation = nil
+	if !d.IsNil() {
+		m.Attestation = &Attestation{}
+		m.Attestation.UnmarshalSSZFrom(d)
+	}
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *AttestationResponse) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Bytes(m.Hash)
+	h.Root(m.Attestation.HashTreeRoot())
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *UnseenAttestationsRequest) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *UnseenAttestationsRequest) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *UnseenAttestationsRequest) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *UnseenAttestationsRequest) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *UnseenAttestationsRequest) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *UnseenAttestationResponse) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *UnseenAttestationResponse) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	{
+		offset1 := e.Begin()
+		for _, v1 := range m.Attestations {
+			v1.MarshalSSZTo(e)
+		}
+		e.End(offset1)
+	}
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *UnseenAttestationResponse) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *UnseenAttestationResponse) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.Attestations = nil
+	{
+		end1 := d.Begin()
+		for d.More(end1) {
+			var v1 *Attestation
+			if !d.IsNil() {
+				v1 = &Attestation{}
+				v1.UnmarshalSSZFrom(d)
+			}
+			m.Attestations = append(m.Attestations, v1)
+		}
+		d.End(end1)
+	}
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *UnseenAttestationResponse) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	{
+		l1 := &sszutil.Hasher{}
+		for _, v1 := range m.Attestations {
+			l1.Root(v1.HashTreeRoot())
+		}
+		h.List(l1)
+	}
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *ProposerSlashingAnnounce) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *ProposerSlashingAnnounce) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	e.Bytes(m.Hash)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *ProposerSlashingAnnounce) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *ProposerSlashingAnnounce) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.Hash = d.Bytes()
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *ProposerSlashingAnnounce) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Bytes(m.Hash)
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *ProposerSlashingRequest) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *ProposerSlashingRequest) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	e.Bytes(m.Hash)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *ProposerSlashingRequest) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *ProposerSlashingRequest) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.Hash = d.Bytes()
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *ProposerSlashingRequest) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Bytes(m.Hash)
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *ProposerSlashingResponse) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *ProposerSlashingResponse) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	e.Bytes(m.Hash)
+	m.ProposerSlashing.MarshalSSZTo(e)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *ProposerSlashingResponse) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *ProposerSlashingResponse) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.Hash = d.Bytes()
+	m.ProposerSlashing = nil
+	if !d.IsNil() {
+		m.ProposerSlashing = &ProposerSlashing{}
+		m.ProposerSlashing.UnmarshalSSZFrom(d)
+	}
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *ProposerSlashingResponse) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Bytes(m.Hash)
+	h.Root(m.ProposerSlashing.HashTreeRoot())
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *AttesterSlashingAnnounce) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *AttesterSlashingAnnounce) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	e.Bytes(m.Hash)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *AttesterSlashingAnnounce) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *AttesterSlashingAnnounce) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.Hash = d.Bytes()
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *AttesterSlashingAnnounce) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Bytes(m.Hash)
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *AttesterSlashingRequest) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *AttesterSlashingRequest) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	e.Bytes(m.Hash)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *AttesterSlashingRequest) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *AttesterSlashingRequest) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.Hash = d.Bytes()
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *AttesterSlashingRequest) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Bytes(m.Hash)
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *AttesterSlashingResponse) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *AttesterSlashingResponse) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	e.Bytes(m.Hash)
+	m.AttesterSlashing.MarshalSSZTo(e)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *AttesterSlashingResponse) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *AttesterSlashingResponse) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.Hash = d.Bytes()
+	m.AttesterSlashing = nil
+	if !d.IsNil() {
+		m.AttesterSlashing = &AttesterSlashing{}
+		m.AttesterSlashing.UnmarshalSSZFrom(d)
+	}
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *AttesterSlashingResponse) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Bytes(m.Hash)
+	h.Root(m.AttesterSlashing.HashTreeRoot())
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *DepositAnnounce) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *DepositAnnounce) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	e.Bytes(m.Hash)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *DepositAnnounce) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *DepositAnnounce) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.Hash = d.Bytes()
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *DepositAnnounce) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Bytes(m.Hash)
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *DepositRequest) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *DepositRequest) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	e.Bytes(m.Hash)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *DepositRequest) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *DepositRequest) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.Hash = d.Bytes()
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *DepositRequest) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Bytes(m.Hash)
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *DepositResponse) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *DepositResponse) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	e.Bytes(m.Hash)
+	m.Deposit.MarshalSSZTo(e)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *DepositResponse) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *DepositResponse) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.Hash = d.Bytes()
+	m.Deposit = nil
+	if !d.IsNil() {
+		m.Deposit = &Deposit{}
+		m.Deposit.UnmarshalSSZFrom(d)
+	}
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *DepositResponse) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Bytes(m.Hash)
+	h.Root(m.Deposit.HashTreeRoot())
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *ExitAnnounce) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *ExitAnnounce) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	e.Bytes(m.Hash)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *ExitAnnounce) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *ExitAnnounce) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.Hash = d.Bytes()
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *ExitAnnounce) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Bytes(m.Hash)
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *ExitRequest) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *ExitRequest) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	e.Bytes(m.Hash)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *ExitRequest) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *ExitRequest) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.Hash = d.Bytes()
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *ExitRequest) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Bytes(m.Hash)
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *ExitResponse) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *ExitResponse) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	e.Bytes(m.Hash)
+	m.VoluntaryExit.MarshalSSZTo(e)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *ExitResponse) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *ExitResponse) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.Hash = d.Bytes()
+	m.VoluntaryExit = nil
+	if !d.IsNil() {
+		m.VoluntaryExit = &VoluntaryExit{}
+		m.VoluntaryExit.UnmarshalSSZFrom(d)
+	}
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *ExitResponse) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Bytes(m.Hash)
+	h.Root(m.VoluntaryExit.HashTreeRoot())
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *BeaconState) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *BeaconState) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	{
+		offset1 := e.Begin()
+		for _, v1 := range m.ValidatorRegistry {
+			v1.MarshalSSZTo(e)
+		}
+		e.End(offset1)
+	}
+	e.Uint64(m.ValidatorRegistryUpdateEpoch)
+	{
+		offset1 := e.Begin()
+		for _, v1 := range m.ValidatorBalances {
+			e.Uint64(v1)
+		}
+		e.End(offset1)
+	}
+	{
+		offset1 := e.Begin()
+		for _, v1 := range m.LatestRandaoMixes {
+			e.Bytes(v1)
+		}
+		e.End(offset1)
+	}
+	e.Uint64(m.PreviousShufflingStartShard)
+	e.Uint64(m.CurrentShufflingStartShard)
+	e.Uint64(m.PreviousShufflingEpoch)
+	e.Uint64(m.CurrentShufflingEpoch)
+	e.Bytes(m.PreviousShufflingSeedHash32)
+	e.Bytes(m.CurrentShufflingSeedHash32)
+	e.Uint64(m.PreviousJustifiedEpoch)
+	e.Uint64(m.JustifiedEpoch)
+	e.Uint64(m.JustificationBitfield)
+	e.Uint64(m.FinalizedEpoch)
+	{
+		offset1 := e.Begin()
+		for _, v1 := range m.LatestCrosslinks {
+			v1.MarshalSSZTo(e)
+		}
+		e.End(offset1)
+	}
+	{
+		offset1 := e.Begin()
+		for _, v1 := range m.LatestBlockRootHash32S {
+			e.Bytes(v1)
+		}
+		e.End(offset1)
+	}
+	{
+		offset1 := e.Begin()
+		for _, v1 := range m.BatchedBlockRootHash32S {
+			e.Bytes(v1)
+		}
+		e.End(offset1)
+	}
+	{
+		offset1 := e.Begin()
+		for _, v1 := range m.LatestSlashedBalances {
+			e.Uint64(v1)
+		}
+		e.End(offset1)
+	}
+	{
+		offset1 := e.Begin()
+		for _, v1 := range m.LatestAttestations {
+			v1.MarshalSSZTo(e)
+		}
+		e.End(offset1)
+	}
+	{
+		offset1 := e.Begin()
+		for _, v1 := range m.LatestIndexRootHash32S {
+			e.Bytes(v1)
+		}
+		e.End(offset1)
+	}
+	m.LatestEth1Data.MarshalSSZTo(e)
+	{
+		offset1 := e.Begin()
+		for _, v1 := range m.Eth1DataVotes {
+			v1.MarshalSSZTo(e)
+		}
+		e.End(offset1)
+	}
+	e.Uint64(m.GenesisTime)
+	m.Fork.MarshalSSZTo(e)
+	e.Uint64(m.Slot)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *BeaconState) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *BeaconState) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.ValidatorRegistry = nil
+	{
+		end1 := d.Begin()
+		for d.More(end1) {
+			var v1 *Validator
+			if !d.IsNil() {
+				v1 = &Validator{}
+				v1.UnmarshalSSZFrom(d)
+			}
+			m.ValidatorRegistry = append(m.ValidatorRegistry, v1)
+		}
+		d.End(end1)
+	}
+	m.ValidatorRegistryUpdateEpoch = d.Uint64()
+	m.ValidatorBalances = nil
+	{
+		end1 := d.Begin()
+		for d.More(end1) {
+			m.ValidatorBalances = append(m.ValidatorBalances, d.Uint64())
+		}
+		d.End(end1)
+	}
+	m.LatestRandaoMixes = nil
+	{
+		end1 := d.Begin()
+		for d.More(end1) {
+			m.LatestRandaoMixes = append(m.LatestRandaoMixes, d.Bytes())
+		}
+		d.End(end1)
+	}
+	m.PreviousShufflingStartShard = d.Uint64()
+	m.CurrentShufflingStartShard = d.Uint64()
+	m.PreviousShufflingEpoch = d.Uint64()
+	m.CurrentShufflingEpoch = d.Uint64()
+	m.PreviousShufflingSeedHash32 = d.Bytes()
+	m.CurrentShufflingSeedHash32 = d.Bytes()
+	m.PreviousJustifiedEpoch = d.Uint64()
+	m.JustifiedEpoch = d.Uint64()
+	m.JustificationBitfield = d.Uint64()
+	m.FinalizedEpoch = d.Uint64()
+	m.LatestCrosslinks = nil
+	{
+		end1 := d.Begin()
+		for d.More(end1) {
+			var v1 *Crosslink
+			if !d.IsNil() {
+				v1 = &Crosslink{}
+				v1.UnmarshalSSZFrom(d)
+			}
+			m.LatestCrosslinks = append(m.LatestCrosslinks, v1)
+		}
+		d.End(end1)
+	}
+	m.LatestBlockRootHash32S = nil
+	{
+		end1 := d.Begin()
+		for d.More(end1) {
+			m.LatestBlockRootHash32S = append(m.LatestBlockRootHash32S, d.Bytes())
+		}
+		d.End(end1)
+	}
+	m.BatchedBlockRootHash32S = nil
+	{
+		end1 := d.Begin()
+		for d.More(end1) {
+			m.BatchedBlockRootHash32S = append(m.BatchedBlockRootHash32S, d.Bytes())
+		}
+		d.End(end1)
+	}
+	m.LatestSlashedBalances = nil
+	{
+		end1 := d.Begin()
+		for d.More(end1) {
+			m.LatestSlashedBalances = append(m.LatestSlashedBalances, d.Uint64())
+		}
+		d.End(end1)
+	}
+	m.LatestAttestations = nil
+	{
+		end1 := d.Begin()
+		for d.More(end1) {
+			var v1 *PendingAttestation
+			if !d.IsNil() {
+				v1 = &PendingAttestation{}
+				v1.UnmarshalSSZFrom(d)
+			}
+			m.LatestAttestations = append(m.LatestAttestations, v1)
+		}
+		d.End(end1)
+	}
+	m.LatestIndexRootHash32S = nil
+	{
+		end1 := d.Begin()
+		for d.More(end1) {
+			m.LatestIndexRootHash32S = append(m.LatestIndexRootHash32S, d.Bytes())
+		}
+		d.End(end1)
+	}
+	m.LatestEth1Data = nil
+	if !d.IsNil() {
+		m.LatestEth1Data = &Eth1Data{}
+		m.LatestEth1Data.UnmarshalSSZFrom(d)
+	}
+	m.Eth1DataVotes = nil
+	{
+		end1 := d.Begin()
+		for d.More(end1) {
+			var v1 *Eth1DataVote
+			if !d.IsNil() {
+				v1 = &Eth1DataVote{}
+				v1.UnmarshalSSZFrom(d)
+			}
+			m.Eth1DataVotes = append(m.Eth1DataVotes, v1)
+		}
+		d.End(end1)
+	}
+	m.GenesisTime = d.Uint64()
+	m.Fork = nil
+	if !d.IsNil() {
+		m.Fork = &Fork{}
+		m.Fork.UnmarshalSSZFrom(d)
+	}
+	m.Slot = d.Uint64()
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *BeaconState) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	{
+		l1 := &sszutil.Hasher{}
+		for _, v1 := range m.ValidatorRegistry {
+			l1.Root(v1.HashTreeRoot())
+		}
+		h.List(l1)
+	}
+	h.Uint64(m.ValidatorRegistryUpdateEpoch)
+	{
+		l1 := &sszutil.Hasher{}
+		for _, v1 := range m.ValidatorBalances {
+			l1.Uint64(v1)
+		}
+		h.List(l1)
+	}
+	{
+		l1 := &sszutil.Hasher{}
+		for _, v1 := range m.LatestRandaoMixes {
+			l1.Bytes(v1)
+		}
+		h.List(l1)
+	}
+	h.Uint64(m.PreviousShufflingStartShard)
+	h.Uint64(m.CurrentShufflingStartShard)
+	h.Uint64(m.PreviousShufflingEpoch)
+	h.Uint64(m.CurrentShufflingEpoch)
+	h.Bytes(m.PreviousShufflingSeedHash32)
+	h.Bytes(m.CurrentShufflingSeedHash32)
+	h.Uint64(m.PreviousJustifiedEpoch)
+	h.Uint64(m.JustifiedEpoch)
+	h.Uint64(m.JustificationBitfield)
+	h.Uint64(m.FinalizedEpoch)
+	{
+		l1 := &sszutil.Hasher{}
+		for _, v1 := range m.LatestCrosslinks {
+			l1.Root(v1.HashTreeRoot())
+		}
+		h.List(l1)
+	}
+	{
+		l1 := &sszutil.Hasher{}
+		for _, v1 := range m.LatestBlockRootHash32S {
+			l1.Bytes(v1)
+		}
+		h.List(l1)
+	}
+	{
+		l1 := &sszutil.Hasher{}
+		for _, v1 := range m.BatchedBlockRootHash32S {
+			l1.Bytes(v1)
+		}
+		h.List(l1)
+	}
+	{
+		l1 := &sszutil.Hasher{}
+		for _, v1 := range m.LatestSlashedBalances {
+			l1.Uint64(v1)
+		}
+		h.List(l1)
+	}
+	{
+		l1 := &sszutil.Hasher{}
+		for _, v1 := range m.LatestAttestations {
+			l1.Root(v1.HashTreeRoot())
+		}
+		h.List(l1)
+	}
+	{
+		l1 := &sszutil.Hasher{}
+		for _, v1 := range m.LatestIndexRootHash32S {
+			l1.Bytes(v1)
+		}
+		h.List(l1)
+	}
+	h.Root(m.LatestEth1Data.HashTreeRoot())
+	{
+		l1 := &sszutil.Hasher{}
+		for _, v1 := range m.Eth1DataVotes {
+			l1.Root(v1.HashTreeRoot())
+		}
+		h.List(l1)
+	}
+	h.Uint64(m.GenesisTime)
+	h.Root(m.Fork.HashTreeRoot())
+	h.Uint64(m.Slot)
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *Fork) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *Fork) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	e.Uint64(m.PreviousVersion)
+	e.Uint64(m.CurrentVersion)
+	e.Uint64(m.Epoch)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *Fork) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *Fork) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.PreviousVersion = d.Uint64()
+	m.CurrentVersion = d.Uint64()
+	m.Epoch = d.Uint64()
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *Fork) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Uint64(m.PreviousVersion)
+	h.Uint64(m.CurrentVersion)
+	h.Uint64(m.Epoch)
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *PendingAttestation) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *PendingAttestation) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	m.Data.MarshalSSZTo(e)
+	e.Bytes(m.AggregationBitfield)
+	e.Bytes(m.CustodyBitfield)
+	e.Uint64(m.InclusionSlot)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *PendingAttestation) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *PendingAttestation) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.Data = nil
+	if !d.IsNil() {
+		m.Data = &AttestationData{}
+		m.Data.UnmarshalSSZFrom(d)
+	}
+	m.AggregationBitfield = d.Bytes()
+	m.CustodyBitfield = d.Bytes()
+	m.InclusionSlot = d.Uint64()
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *PendingAttestation) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Root(m.Data.HashTreeRoot())
+	h.Bytes(m.AggregationBitfield)
+	h.Bytes(m.CustodyBitfield)
+	h.Uint64(m.InclusionSlot)
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *Attestation) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *Attestation) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	m.Data.MarshalSSZTo(e)
+	e.Bytes(m.AggregationBitfield)
+	e.Bytes(m.CustodyBitfield)
+	e.Bytes(m.AggregateSignature)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *Attestation) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *Attestation) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.Data = nil
+	if !d.IsNil() {
+		m.Data = &AttestationData{}
+		m.Data.UnmarshalSSZFrom(d)
+	}
+	m.AggregationBitfield = d.Bytes()
+	m.CustodyBitfield = d.Bytes()
+	m.AggregateSignature = d.Bytes()
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *Attestation) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Root(m.Data.HashTreeRoot())
+	h.Bytes(m.AggregationBitfield)
+	h.Bytes(m.CustodyBitfield)
+	h.Bytes(m.AggregateSignature)
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *AttestationData) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *AttestationData) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	e.Uint64(m.Slot)
+	e.Uint64(m.Shard)
+	e.Bytes(m.BeaconBlockRootHash32)
+	e.Bytes(m.EpochBoundaryRootHash32)
+	e.Bytes(m.CrosslinkDataRootHash32)
+	m.LatestCrosslink.MarshalSSZTo(e)
+	e.Uint64(m.JustifiedEpoch)
+	e.Bytes(m.JustifiedBlockRootHash32)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *AttestationData) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *AttestationData) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.Slot = d.Uint64()
+	m.Shard = d.Uint64()
+	m.BeaconBlockRootHash32 = d.Bytes()
+	m.EpochBoundaryRootHash32 = d.Bytes()
+	m.CrosslinkDataRootHash32 = d.Bytes()
+	m.LatestCrosslink = nil
+	if !d.IsNil() {
+		m.LatestCrosslink = &Crosslink{}
+		m.LatestCrosslink.UnmarshalSSZFrom(d)
+	}
+	m.JustifiedEpoch = d.Uint64()
+	m.JustifiedBlockRootHash32 = d.Bytes()
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *AttestationData) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Uint64(m.Slot)
+	h.Uint64(m.Shard)
+	h.Bytes(m.BeaconBlockRootHash32)
+	h.Bytes(m.EpochBoundaryRootHash32)
+	h.Bytes(m.CrosslinkDataRootHash32)
+	h.Root(m.LatestCrosslink.HashTreeRoot())
+	h.Uint64(m.JustifiedEpoch)
+	h.Bytes(m.JustifiedBlockRootHash32)
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *AttestationDataAndCustodyBit) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *AttestationDataAndCustodyBit) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	m.Data.MarshalSSZTo(e)
+	e.Bool(m.CustodyBit)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *AttestationDataAndCustodyBit) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *AttestationDataAndCustodyBit) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.Data = nil
+	if !d.IsNil() {
+		m.Data = &AttestationData{}
+		m.Data.UnmarshalSSZFrom(d)
+	}
+	m.CustodyBit = d.Bool()
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *AttestationDataAndCustodyBit) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Root(m.Data.HashTreeRoot())
+	h.Bool(m.CustodyBit)
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *Validator) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *Validator) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	e.Bytes(m.Pubkey)
+	e.Bytes(m.WithdrawalCredentialsHash32)
+	e.Uint64(m.ActivationEpoch)
+	e.Uint64(m.ExitEpoch)
+	e.Uint64(m.WithdrawalEpoch)
+	e.Uint64(m.SlashedEpoch)
+	e.Uint32(uint32(m.StatusFlags))
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *Validator) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *Validator) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.Pubkey = d.Bytes()
+	m.WithdrawalCredentialsHash32 = d.Bytes()
+	m.ActivationEpoch = d.Uint64()
+	m.ExitEpoch = d.Uint64()
+	m.WithdrawalEpoch = d.Uint64()
+	m.SlashedEpoch = d.Uint64()
+	m.StatusFlags = Validator_StatusFlags(d.Uint32())
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *Validator) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Bytes(m.Pubkey)
+	h.Bytes(m.WithdrawalCredentialsHash32)
+	h.Uint64(m.ActivationEpoch)
+	h.Uint64(m.ExitEpoch)
+	h.Uint64(m.WithdrawalEpoch)
+	h.Uint64(m.SlashedEpoch)
+	h.Uint32(uint32(m.StatusFlags))
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *ShardReassignmentRecord) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *ShardReassignmentRecord) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	e.Uint64(m.ValidatorIndex)
+	e.Uint64(m.Shard)
+	e.Uint64(m.Slot)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *ShardReassignmentRecord) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *ShardReassignmentRecord) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.ValidatorIndex = d.Uint64()
+	m.Shard = d.Uint64()
+	m.Slot = d.Uint64()
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *ShardReassignmentRecord) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Uint64(m.ValidatorIndex)
+	h.Uint64(m.Shard)
+	h.Uint64(m.Slot)
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *Crosslink) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *Crosslink) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	e.Uint64(m.Epoch)
+	e.Bytes(m.CrosslinkDataRootHash32)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *Crosslink) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *Crosslink) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.Epoch = d.Uint64()
+	m.CrosslinkDataRootHash32 = d.Bytes()
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *Crosslink) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Uint64(m.Epoch)
+	h.Bytes(m.CrosslinkDataRootHash32)
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *BeaconBlock) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *BeaconBlock) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	e.Uint64(m.Slot)
+	e.Bytes(m.ParentRootHash32)
+	e.Bytes(m.StateRootHash32)
+	e.Bytes(m.RandaoReveal)
+	m.Eth1Data.MarshalSSZTo(e)
+	m.Body.MarshalSSZTo(e)
+	e.Bytes(m.Signature)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *BeaconBlock) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *BeaconBlock) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.Slot = d.Uint64()
+	m.ParentRootHash32 = d.Bytes()
+	m.StateRootHash32 = d.Bytes()
+	m.RandaoReveal = d.Bytes()
+	m.Eth1Data = nil
+	if !d.IsNil() {
+		m.Eth1Data = &Eth1Data{}
+		m.Eth1Data.UnmarshalSSZFrom(d)
+	}
+	m.Body = nil
+	if !d.IsNil() {
+		m.Body = &BeaconBlockBody{}
+		m.Body.UnmarshalSSZFrom(d)
+	}
+	m.Signature = d.Bytes()
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *BeaconBlock) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Uint64(m.Slot)
+	h.Bytes(m.ParentRootHash32)
+	h.Bytes(m.StateRootHash32)
+	h.Bytes(m.RandaoReveal)
+	h.Root(m.Eth1Data.HashTreeRoot())
+	h.Root(m.Body.HashTreeRoot())
+	h.Bytes(m.Signature)
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *BeaconBlockBody) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *BeaconBlockBody) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	{
+		offset1 := e.Begin()
+		for _, v1 := range m.Attestations {
+			v1.MarshalSSZTo(e)
+		}
+		e.End(offset1)
+	}
+	{
+		offset1 := e.Begin()
+		for _, v1 := range m.ProposerSlashings {
+			v1.MarshalSSZTo(e)
+		}
+		e.End(offset1)
+	}
+	{
+		offset1 := e.Begin()
+		for _, v1 := range m.AttesterSlashings {
+			v1.MarshalSSZTo(e)
+		}
+		e.End(offset1)
+	}
+	{
+		offset1 := e.Begin()
+		for _, v1 := range m.Deposits {
+			v1.MarshalSSZTo(e)
+		}
+		e.End(offset1)
+	}
+	{
+		offset1 := e.Begin()
+		for _, v1 := range m.VoluntaryExits {
+			v1.MarshalSSZTo(e)
+		}
+		e.End(offset1)
+	}
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *BeaconBlockBody) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *BeaconBlockBody) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.Attestations = nil
+	{
+		end1 := d.Begin()
+		for d.More(end1) {
+			var v1 *Attestation
+			if !d.IsNil() {
+				v1 = &Attestation{}
+				v1.UnmarshalSSZFrom(d)
+			}
+			m.Attestations = append(m.Attestations, v1)
+		}
+		d.End(end1)
+	}
+	m.ProposerSlashings = nil
+	{
+		end1 := d.Begin()
+		for d.More(end1) {
+			var v1 *ProposerSlashing
+			if !d.IsNil() {
+				v1 = &ProposerSlashing{}
+				v1.UnmarshalSSZFrom(d)
+			}
+			m.ProposerSlashings = append(m.ProposerSlashings, v1)
+		}
+		d.End(end1)
+	}
+	m.AttesterSlashings = nil
+	{
+		end1 := d.Begin()
+		for d.More(end1) {
+			var v1 *AttesterSlashing
+			if !d.IsNil() {
+				v1 = &AttesterSlashing{}
+				v1.UnmarshalSSZFrom(d)
+			}
+			m.AttesterSlashings = append(m.AttesterSlashings, v1)
+		}
+		d.End(end1)
+	}
+	m.Deposits = nil
+	{
+		end1 := d.Begin()
+		for d.More(end1) {
+			var v1 *Deposit
+			if !d.IsNil() {
+				v1 = &Deposit{}
+				v1.UnmarshalSSZFrom(d)
+			}
+			m.Deposits = append(m.Deposits, v1)
+		}
+		d.End(end1)
+	}
+	m.VoluntaryExits = nil
+	{
+		end1 := d.Begin()
+		for d.More(end1) {
+			var v1 *VoluntaryExit
+			if !d.IsNil() {
+				v1 = &VoluntaryExit{}
+				v1.UnmarshalSSZFrom(d)
+			}
+			m.VoluntaryExits = append(m.VoluntaryExits, v1)
+		}
+		d.End(end1)
+	}
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *BeaconBlockBody) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	{
+		l1 := &sszutil.Hasher{}
+		for _, v1 := range m.Attestations {
+			l1.Root(v1.HashTreeRoot())
+		}
+		h.List(l1)
+	}
+	{
+		l1 := &sszutil.Hasher{}
+		for _, v1 := range m.ProposerSlashings {
+			l1.Root(v1.HashTreeRoot())
+		}
+		h.List(l1)
+	}
+	{
+		l1 := &sszutil.Hasher{}
+		for _, v1 := range m.AttesterSlashings {
+			l1.Root(v1.HashTreeRoot())
+		}
+		h.List(l1)
+	}
+	{
+		l1 := &sszutil.Hasher{}
+		for _, v1 := range m.Deposits {
+			l1.Root(v1.HashTreeRoot())
+		}
+		h.List(l1)
+	}
+	{
+		l1 := &sszutil.Hasher{}
+		for _, v1 := range m.VoluntaryExits {
+			l1.Root(v1.HashTreeRoot())
+		}
+		h.List(l1)
+	}
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *DepositInput) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *DepositInput) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	e.Bytes(m.Pubkey)
+	e.Bytes(m.WithdrawalCredentialsHash32)
+	e.Bytes(m.ProofOfPossession)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *DepositInput) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *DepositInput) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.Pubkey = d.Bytes()
+	m.WithdrawalCredentialsHash32 = d.Bytes()
+	m.ProofOfPossession = d.Bytes()
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *DepositInput) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Bytes(m.Pubkey)
+	h.Bytes(m.WithdrawalCredentialsHash32)
+	h.Bytes(m.ProofOfPossession)
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *ProposalSignedData) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *ProposalSignedData) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	e.Uint64(m.Slot)
+	e.Uint64(m.Shard)
+	e.Bytes(m.BlockRootHash32)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *ProposalSignedData) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *ProposalSignedData) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.Slot = d.Uint64()
+	m.Shard = d.Uint64()
+	m.BlockRootHash32 = d.Bytes()
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *ProposalSignedData) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Uint64(m.Slot)
+	h.Uint64(m.Shard)
+	h.Bytes(m.BlockRootHash32)
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *SlashableAttestation) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *SlashableAttestation) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	{
+		offset1 := e.Begin()
+		for _, v1 := range m.ValidatorIndices {
+			e.Uint64(v1)
+		}
+		e.End(offset1)
+	}
+	e.Bytes(m.CustodyBitfield)
+	m.Data.MarshalSSZTo(e)
+	e.Bytes(m.AggregateSignature)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *SlashableAttestation) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *SlashableAttestation) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.ValidatorIndices = nil
+	{
+		end1 := d.Begin()
+		for d.More(end1) {
+			m.ValidatorIndices = append(m.ValidatorIndices, d.Uint64())
+		}
+		d.End(end1)
+	}
+	m.CustodyBitfield = d.Bytes()
+	m.Data = nil
+	if !d.IsNil() {
+		m.Data = &AttestationData{}
+		m.Data.UnmarshalSSZFrom(d)
+	}
+	m.AggregateSignature = d.Bytes()
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *SlashableAttestation) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	{
+		l1 := &sszutil.Hasher{}
+		for _, v1 := range m.ValidatorIndices {
+			l1.Uint64(v1)
+		}
+		h.List(l1)
+	}
+	h.Bytes(m.CustodyBitfield)
+	h.Root(m.Data.HashTreeRoot())
+	h.Bytes(m.AggregateSignature)
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *DepositData) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *DepositData) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	m.DepositInput.MarshalSSZTo(e)
+	e.Uint64(m.Amount)
+	e.Uint64(m.Timestamp)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *DepositData) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *DepositData) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.DepositInput = nil
+	if !d.IsNil() {
+		m.DepositInput = &DepositInput{}
+		m.DepositInput.UnmarshalSSZFrom(d)
+	}
+	m.Amount = d.Uint64()
+	m.Timestamp = d.Uint64()
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *DepositData) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Root(m.DepositInput.HashTreeRoot())
+	h.Uint64(m.Amount)
+	h.Uint64(m.Timestamp)
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *ProposerSlashing) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *ProposerSlashing) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	e.Uint64(m.ProposerIndex)
+	m.ProposalData_1.MarshalSSZTo(e)
+	e.Bytes(m.ProposalSignature_1)
+	m.ProposalData_2.MarshalSSZTo(e)
+	e.Bytes(m.ProposalSignature_2)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *ProposerSlashing) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *ProposerSlashing) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.ProposerIndex = d.Uint64()
+	m.ProposalData_1 = nil
+	if !d.IsNil() {
+		m.ProposalData_1 = &ProposalSignedData{}
+		m.ProposalData_1.UnmarshalSSZFrom(d)
+	}
+	m.ProposalSignature_1 = d.Bytes()
+	m.ProposalData_2 = nil
+	if !d.IsNil() {
+		m.ProposalData_2 = &ProposalSignedData{}
+		m.ProposalData_2.UnmarshalSSZFrom(d)
+	}
+	m.ProposalSignature_2 = d.Bytes()
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *ProposerSlashing) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Uint64(m.ProposerIndex)
+	h.Root(m.ProposalData_1.HashTreeRoot())
+	h.Bytes(m.ProposalSignature_1)
+	h.Root(m.ProposalData_2.HashTreeRoot())
+	h.Bytes(m.ProposalSignature_2)
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *AttesterSlashing) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *AttesterSlashing) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	m.SlashableAttestation_1.MarshalSSZTo(e)
+	m.SlashableAttestation_2.MarshalSSZTo(e)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *AttesterSlashing) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *AttesterSlashing) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.SlashableAttestation_1 = nil
+	if !d.IsNil() {
+		m.SlashableAttestation_1 = &SlashableAttestation{}
+		m.SlashableAttestation_1.UnmarshalSSZFrom(d)
+	}
+	m.SlashableAttestation_2 = nil
+	if !d.IsNil() {
+		m.SlashableAttestation_2 = &SlashableAttestation{}
+		m.SlashableAttestation_2.UnmarshalSSZFrom(d)
+	}
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *AttesterSlashing) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Root(m.SlashableAttestation_1.HashTreeRoot())
+	h.Root(m.SlashableAttestation_2.HashTreeRoot())
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *Deposit) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *Deposit) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	{
+		offset1 := e.Begin()
+		for _, v1 := range m.MerkleBranchHash32S {
+			e.Bytes(v1)
+		}
+		e.End(offset1)
+	}
+	e.Uint64(m.MerkleTreeIndex)
+	e.Bytes(m.DepositData)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *Deposit) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *Deposit) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.MerkleBranchHash32S = nil
+	{
+		end1 := d.Begin()
+		for d.More(end1) {
+			m.MerkleBranchHash32S = append(m.MerkleBranchHash32S, d.Bytes())
+		}
+		d.End(end1)
+	}
+	m.MerkleTreeIndex = d.Uint64()
+	m.DepositData = d.Bytes()
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *Deposit) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	{
+		l1 := &sszutil.Hasher{}
+		for _, v1 := range m.MerkleBranchHash32S {
+			l1.Bytes(v1)
+		}
+		h.List(l1)
+	}
+	h.Uint64(m.MerkleTreeIndex)
+	h.Bytes(m.DepositData)
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *VoluntaryExit) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *VoluntaryExit) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	e.Uint64(m.Epoch)
+	e.Uint64(m.ValidatorIndex)
+	e.Bytes(m.Signature)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *VoluntaryExit) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *VoluntaryExit) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.Epoch = d.Uint64()
+	m.ValidatorIndex = d.Uint64()
+	m.Signature = d.Bytes()
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *VoluntaryExit) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Uint64(m.Epoch)
+	h.Uint64(m.ValidatorIndex)
+	h.Bytes(m.Signature)
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *AggregateAndProof) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *AggregateAndProof) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	e.Uint64(m.AggregatorIndex)
+	m.Aggregate.MarshalSSZTo(e)
+	e.Bytes(m.SelectionProof)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *AggregateAndProof) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *AggregateAndProof) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.AggregatorIndex = d.Uint64()
+	m.Aggregate = nil
+	if !d.IsNil() {
+		m.Aggregate = &Attestation{}
+		m.Aggregate.UnmarshalSSZFrom(d)
+	}
+	m.SelectionProof = d.Bytes()
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *AggregateAndProof) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Uint64(m.AggregatorIndex)
+	h.Root(m.Aggregate.HashTreeRoot())
+	h.Bytes(m.SelectionProof)
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *Eth1Data) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *Eth1Data) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	e.Bytes(m.DepositRootHash32)
+	e.Bytes(m.BlockHash32)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *Eth1Data) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *Eth1Data) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.DepositRootHash32 = d.Bytes()
+	m.BlockHash32 = d.Bytes()
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *Eth1Data) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Bytes(m.DepositRootHash32)
+	h.Bytes(m.BlockHash32)
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *Eth1DataVote) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *Eth1DataVote) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	m.Eth1Data.MarshalSSZTo(e)
+	e.Uint64(m.VoteCount)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *Eth1DataVote) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *Eth1DataVote) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.Eth1Data = nil
+	if !d.IsNil() {
+		m.Eth1Data = &Eth1Data{}
+		m.Eth1Data.UnmarshalSSZFrom(d)
+	}
+	m.VoteCount = d.Uint64()
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *Eth1DataVote) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Root(m.Eth1Data.HashTreeRoot())
+	h.Uint64(m.VoteCount)
+	return h.Sum()
+}
+
+// MarshalSSZ returns the SSZ encoding of m.
+func (m *GenesisState) MarshalSSZ() ([]byte, error) {
+	e := &sszutil.Encoder{}
+	m.MarshalSSZTo(e)
+	return e.Result()
+}
+
+// MarshalSSZTo appends the SSZ encoding of m to e.
+func (m *GenesisState) MarshalSSZTo(e *sszutil.Encoder) {
+	if m == nil {
+		e.Nil()
+		return
+	}
+	offset := e.Begin()
+	m.State.MarshalSSZTo(e)
+	e.Bytes(m.GenesisBlockRootHash32)
+	e.End(offset)
+}
+
+// UnmarshalSSZ decodes m from its SSZ encoding.
+func (m *GenesisState) UnmarshalSSZ(b []byte) error {
+	d := sszutil.NewDecoder(b)
+	m.UnmarshalSSZFrom(d)
+	return d.Finish()
+}
+
+// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.
+func (m *GenesisState) UnmarshalSSZFrom(d *sszutil.Decoder) {
+	end := d.Begin()
+	if !d.More(end) {
+		return
+	}
+	m.State = nil
+	if !d.IsNil() {
+		m.State = &BeaconState{}
+		m.State.UnmarshalSSZFrom(d)
+	}
+	m.GenesisBlockRootHash32 = d.Bytes()
+	d.End(end)
+}
+
+// HashTreeRoot returns the SSZ tree-hash of m.
+func (m *GenesisState) HashTreeRoot() ([32]byte, error) {
+	if m == nil {
+		return sszutil.NilRoot, nil
+	}
+	h := &sszutil.Hasher{}
+	h.Root(m.State.HashTreeRoot())
+	h.Bytes(m.GenesisBlockRootHash32)
+	return h.Sum()
+}
@@ -40,6 +40,8 @@ const (
 	Topic_ATTESTATION_ANNOUNCE                Topic = 12
 	Topic_ATTESTATION_REQUEST                 Topic = 13
 	Topic_ATTESTATION_RESPONSE                Topic = 14
+	Topic_VOLUNTARY_EXIT                      Topic = 15
+	Topic_AGGREGATE_AND_PROOF                 Topic = 16
 )
 
 var Topic_name = map[int32]string{
@@ -58,6 +60,8 @@ var Topic_name = map[int32]string{
 	12: "ATTESTATION_ANNOUNCE",
 	13: "ATTESTATION_REQUEST",
 	14: "ATTESTATION_RESPONSE",
+	15: "VOLUNTARY_EXIT",
+	16: "AGGREGATE_AND_PROOF",
 }
 
 var Topic_value = map[string]int32{
@@ -76,6 +80,8 @@ var Topic_value = map[string]int32{
 	"ATTESTATION_ANNOUNCE":                12,
 	"ATTESTATION_REQUEST":                 13,
 	"ATTESTATION_RESPONSE":                14,
+	"VOLUNTARY_EXIT":                      15,
+	"AGGREGATE_AND_PROOF":                 16,
 }
 
 func (x Topic) String() string {
@@ -435,6 +441,7 @@ type ChainHeadResponse struct {
 	Hash                      []byte   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 	Slot                      uint64   `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
 	FinalizedStateRootHash32S []byte   `protobuf:"bytes,3,opt,name=finalized_state_root_hash32s,json=finalizedStateRootHash32s,proto3" json:"finalized_state_root_hash32s,omitempty"`
+	ForkVersion               uint64   `protobuf:"varint,4,opt,name=fork_version,json=forkVersion,proto3" json:"fork_version,omitempty"`
 	XXX_NoUnkeyedLiteral      struct{} `json:"-"`
 	XXX_unrecognized          []byte   `json:"-"`
 	XXX_sizecache             int32    `json:"-"`
@@ -494,6 +501,13 @@ func (m *ChainHeadResponse) GetFinalizedStateRootHash32S() []byte {
 	return nil
 }
 
+func (m *ChainHeadResponse) GetForkVersion() uint64 {
+	if m != nil {
+		return m.ForkVersion
+	}
+	return 0
+}
+
 type BeaconStateHashAnnounce struct {
 	Hash                 []byte   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1501,61 +1515,64 @@ func init() {
 func init() { proto.RegisterFile("proto/beacon/p2p/v1/messages.proto", fileDescriptor_a1d590cda035b632) }
 
 var fileDescriptor_a1d590cda035b632 = []byte{
-	// 851 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x5f, 0x93, 0xda, 0x54,
-	0x14, 0x37, 0x85, 0x2d, 0xed, 0x81, 0xc5, 0xf4, 0xae, 0x76, 0x61, 0x6d, 0x59, 0x9a, 0xda, 0x11,
-	0x9d, 0x29, 0x4c, 0xe9, 0x53, 0x9f, 0x9c, 0x84, 0x8d, 0xd2, 0x76, 0x0d, 0x35, 0x7f, 0x74, 0x7c,
-	0x70, 0xae, 0x01, 0xae, 0x85, 0x91, 0xcd, 0x8d, 0xb9, 0x17, 0x66, 0xd7, 0xf1, 0xd5, 0xcf, 0xe0,
-	0x27, 0xf0, 0xbb, 0xf8, 0xe8, 0x47, 0x70, 0xf6, 0x93, 0x38, 0x49, 0x6e, 0x20, 0x40, 0x08, 0x3c,
-	0xf8, 0x96, 0x9c, 0xf3, 0xfb, 0xfd, 0xce, 0xf9, 0xfd, 0x38, 0x99, 0x01, 0x14, 0x3f, 0xa0, 0x9c,
-	0x76, 0x86, 0xc4, 0x1d, 0x51, 0xaf, 0xe3, 0x77, 0xfd, 0xce, 0xe2, 0x45, 0xe7, 0x8a, 0x30, 0xe6,
-	0xbe, 0x27, 0xac, 0x1d, 0x35, 0xd1, 0x43, 0xc2, 0x27, 0x24, 0x20, 0xf3, 0xab, 0x76, 0x0c, 0x6b,
-	0xfb, 0x5d, 0xbf, 0xbd, 0x78, 0x71, 0x76, 0x9e, 0xc5, 0xe5, 0x37, 0x7e, 0x42, 0x54, 0xde, 0xc0,
-	0x89, 0x16, 0x35, 0xb5, 0x19, 0x1d, 0xfd, 0xa2, 0x7a, 0x1e, 0x9d, 0x7b, 0x23, 0x82, 0x10, 0x14,
-	0x27, 0x2e, 0x9b, 0xd4, 0xa4, 0xa6, 0xd4, 0xaa, 0x98, 0xd1, 0x33, 0x3a, 0x87, 0x32, 0x9b, 0x51,
-	0x8e, 0xbd, 0xf9, 0xd5, 0x90, 0x04, 0xb5, 0x3b, 0x4d, 0xa9, 0x55, 0x34, 0x21, 0x2c, 0x19, 0x51,
-	0x45, 0x69, 0x01, 0x4a, 0x69, 0x99, 0xe4, 0xd7, 0x39, 0x61, 0x3c, 0x4b, 0x4a, 0x51, 0xa1, 0xb1,
-	0x8d, 0xd4, 0x6e, 0xac, 0xa5, 0xd6, 0xe6, 0x30, 0x69, 0x6b, 0xd8, 0x9f, 0xd2, 0xda, 0xe6, 0x26,
-	0x61, 0x3e, 0xf5, 0x18, 0x41, 0xaf, 0xe0, 0x68, 0x18, 0x16, 0x22, 0x4a, 0xb9, 0xfb, 0xb4, 0x9d,
-	0x9d, 0x4c, 0x3b, 0xcd, 0x8d, 0x19, 0x48, 0x87, 0xb2, 0xcb, 0x39, 0x61, 0xdc, 0xe5, 0x53, 0xea,
-	0x45, 0x06, 0x73, 0x04, 0xd4, 0x15, 0xd4, 0x4c, 0xf3, 0x14, 0x07, 0xea, 0x9a, 0xcb, 0x47, 0x13,
-	0x32, 0xce, 0x48, 0xe3, 0x31, 0x00, 0xe3, 0x6e, 0xc0, 0x71, 0x68, 0x45, 0xd8, 0xba, 0x1f, 0x55,
-	0x42, 0xf3, 0xa8, 0x0e, 0xf7, 0x88, 0x37, 0x8e, 0x9b, 0x71, 0xc0, 0x25, 0xe2, 0x8d, 0xc3, 0x96,
-	0x32, 0x81, 0xb3, 0x2c, 0x59, 0x61, 0xfb, 0x0d, 0x54, 0x87, 0x71, 0x17, 0x47, 0x66, 0x58, 0x4d,
-	0x6a, 0x16, 0x0e, 0xf5, 0x7f, 0x2c, 0xa8, 0xd1, 0x1b, 0x53, 0x10, 0xc8, 0xbd, 0x89, 0x3b, 0xf5,
-	0xfa, 0xc4, 0x1d, 0x8b, 0xbd, 0x95, 0xdf, 0xe1, 0x41, 0xaa, 0x26, 0x86, 0x66, 0x5d, 0x09, 0x82,
-	0x62, 0x6a, 0xfb, 0xe8, 0x19, 0x7d, 0x09, 0x8f, 0x7e, 0x9e, 0x7a, 0xee, 0x6c, 0xfa, 0x1b, 0x19,
-	0xe3, 0x30, 0x26, 0x82, 0x03, 0x4a, 0x39, 0x0e, 0x09, 0x2f, 0xbb, 0xac, 0x56, 0x88, 0xf8, 0xf5,
-	0x25, 0xc6, 0x0a, 0x21, 0x26, 0xa5, 0xbc, 0x1f, 0x03, 0x94, 0xe7, 0x70, 0x1a, 0xef, 0x1b, 0x75,
-	0xc2, 0x6a, 0xde, 0xa5, 0x2a, 0x4e, 0x72, 0x88, 0xb1, 0x90, 0x88, 0x7e, 0xdf, 0x16, 0xd2, 0xbe,
-	0x2d, 0x7e, 0x4c, 0x2e, 0x4e, 0xc8, 0x8a, 0x14, 0xbe, 0x82, 0x4a, 0x1c, 0x6d, 0x2c, 0x7a, 0xd8,
-	0xe1, 0xc5, 0x12, 0xe5, 0xe1, 0xea, 0x45, 0xf9, 0x1c, 0x4e, 0x52, 0x37, 0x95, 0x6b, 0xb0, 0x05,
-	0x28, 0x7d, 0x7e, 0x39, 0x5f, 0x9a, 0xbf, 0x26, 0x9a, 0xfb, 0xcb, 0xfd, 0x4f, 0xe7, 0xff, 0x09,
-	0xd4, 0x1d, 0x8f, 0x11, 0xe2, 0xa5, 0x10, 0x2c, 0x39, 0xa3, 0x71, 0x46, 0x73, 0xb9, 0xd4, 0xd7,
-	0x50, 0x49, 0x09, 0xed, 0xbd, 0xe0, 0xb4, 0xc4, 0x1a, 0x51, 0x69, 0x43, 0xed, 0x5d, 0x40, 0x7d,
-	0xca, 0x48, 0x60, 0xcd, 0x5c, 0x36, 0x99, 0x7a, 0xef, 0x73, 0xe3, 0x7c, 0x0e, 0xa7, 0x9b, 0xf8,
-	0xbc, 0x4c, 0xff, 0x90, 0xb6, 0xf5, 0x73, 0x93, 0x75, 0xe0, 0x81, 0x2f, 0xf0, 0x98, 0x09, 0x82,
-	0xc8, 0xb7, 0xb5, 0xcb, 0xdd, 0xd6, 0x00, 0xd9, 0xdf, 0xa8, 0x84, 0x36, 0xe3, 0x0c, 0x0e, 0xb7,
-	0xb9, 0x89, 0xdf, 0x67, 0x73, 0x1b, 0x9f, 0x6f, 0x33, 0xc1, 0x1f, 0x6c, 0x73, 0x6b, 0x80, 0xbc,
-	0x59, 0x51, 0x9e, 0xc1, 0x87, 0x17, 0xc4, 0xa7, 0x6c, 0xca, 0x73, 0xdd, 0x7d, 0x0a, 0x55, 0x01,
-	0xcb, 0x33, 0xf5, 0xd3, 0x52, 0x2c, 0xd7, 0xca, 0x2b, 0x28, 0x8d, 0x63, 0x98, 0x30, 0x70, 0xbe,
-	0xcb, 0x40, 0xa2, 0x96, 0xe0, 0x15, 0x05, 0x2a, 0xfa, 0xf5, 0x9e, 0x5d, 0x9f, 0x40, 0x39, 0xc4,
-	0xe4, 0x7f, 0xb8, 0x95, 0x18, 0x92, 0xb3, 0xe5, 0x25, 0x54, 0x17, 0x74, 0x36, 0xf7, 0xb8, 0x1b,
-	0xdc, 0x60, 0x72, 0xbd, 0x5c, 0xf6, 0xd9, 0xae, 0x65, 0xbf, 0x4b, 0xd0, 0x91, 0xf4, 0xf1, 0x22,
-	0xfd, 0xfa, 0xc5, 0x5f, 0x05, 0x38, 0xb2, 0xa9, 0x3f, 0x1d, 0xa1, 0x32, 0x94, 0x1c, 0xe3, 0xad,
-	0x31, 0xf8, 0xde, 0x90, 0x3f, 0x40, 0x75, 0xf8, 0x58, 0xd3, 0xd5, 0xde, 0xc0, 0xc0, 0xda, 0xe5,
-	0xa0, 0xf7, 0x16, 0xab, 0x86, 0x31, 0x70, 0x8c, 0x9e, 0x2e, 0x4b, 0xa8, 0x06, 0x1f, 0xad, 0xb5,
-	0x4c, 0xfd, 0x5b, 0x47, 0xb7, 0x6c, 0xf9, 0x0e, 0xfa, 0x0c, 0x9e, 0x66, 0x75, 0xb0, 0xf6, 0x03,
-	0xb6, 0x2e, 0x07, 0x36, 0x36, 0x9c, 0x6f, 0x34, 0xdd, 0x94, 0x0b, 0x5b, 0xea, 0xa6, 0x6e, 0xbd,
-	0x1b, 0x18, 0x96, 0x2e, 0x17, 0x51, 0x13, 0x1e, 0x69, 0xaa, 0xdd, 0xeb, 0xeb, 0x17, 0x38, 0x73,
-	0xca, 0x11, 0x7a, 0x02, 0x8f, 0x77, 0x20, 0x84, 0xc8, 0x5d, 0xf4, 0x10, 0x50, 0xaf, 0xaf, 0xbe,
-	0x36, 0x70, 0x5f, 0x57, 0x2f, 0x96, 0xd4, 0x12, 0x3a, 0x85, 0x93, 0xb5, 0xba, 0x20, 0xdc, 0x43,
-	0x0d, 0x38, 0x13, 0x5a, 0x96, 0xad, 0xda, 0x3a, 0xee, 0xab, 0x56, 0x7f, 0xe5, 0xf9, 0x7e, 0xca,
-	0x73, 0xdc, 0x4f, 0x24, 0x21, 0x65, 0x25, 0xe9, 0x08, 0xd1, 0x72, 0x48, 0x52, 0x6d, 0x5b, 0x0f,
-	0xeb, 0xaf, 0x07, 0xc6, 0x4a, 0xae, 0x12, 0xee, 0x91, 0xee, 0x24, 0x6a, 0xc7, 0x9b, 0x94, 0xa5,
-	0x58, 0x55, 0xab, 0xfc, 0x7d, 0xdb, 0x90, 0xfe, 0xb9, 0x6d, 0x48, 0xff, 0xde, 0x36, 0xa4, 0xe1,
-	0xdd, 0xe8, 0x7f, 0xdc, 0xcb, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x6b, 0xc9, 0x5f, 0xcd, 0x26,
-	0x0a, 0x00, 0x00,
+	// 903 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x73, 0xda, 0x56,
+	0x14, 0xad, 0x6c, 0x1c, 0x27, 0x17, 0x4c, 0x94, 0xe7, 0x36, 0x06, 0x37, 0xc1, 0xb6, 0xd2, 0x4c,
+	0x69, 0x67, 0x82, 0x27, 0xce, 0x2a, 0xab, 0x8e, 0x84, 0x15, 0xe3, 0xc4, 0x95, 0x5c, 0x7d, 0xb8,
+	0xcd, 0xa2, 0xf3, 0x2a, 0xe0, 0x25, 0x30, 0xc1, 0x7a, 0xaa, 0x9e, 0x60, 0xec, 0xee, 0xfb, 0x1b,
+	0xba, 0xec, 0xdf, 0xe9, 0xb2, 0xd3, 0x5f, 0xd0, 0xf1, 0x2f, 0xe9, 0x3c, 0xe9, 0x09, 0x0b, 0x90,
+	0x05, 0x8b, 0xee, 0xd0, 0xbd, 0xe7, 0x9c, 0x7b, 0xce, 0xd5, 0xd5, 0x0c, 0xa0, 0x04, 0x21, 0x8d,
+	0xe8, 0x61, 0x97, 0x78, 0x3d, 0xea, 0x1f, 0x06, 0x47, 0xc1, 0xe1, 0xe4, 0xe5, 0xe1, 0x25, 0x61,
+	0xcc, 0xfb, 0x48, 0x58, 0x2b, 0x6e, 0xa2, 0xc7, 0x24, 0x1a, 0x90, 0x90, 0x8c, 0x2f, 0x5b, 0x09,
+	0xac, 0x15, 0x1c, 0x05, 0xad, 0xc9, 0xcb, 0xdd, 0xbd, 0x3c, 0x6e, 0x74, 0x1d, 0xa4, 0x44, 0xe5,
+	0x2d, 0x6c, 0x6b, 0x71, 0x53, 0x1b, 0xd1, 0xde, 0x27, 0xd5, 0xf7, 0xe9, 0xd8, 0xef, 0x11, 0x84,
+	0xa0, 0x34, 0xf0, 0xd8, 0xa0, 0x26, 0xed, 0x4b, 0xcd, 0x8a, 0x15, 0xff, 0x46, 0x7b, 0x50, 0x66,
+	0x23, 0x1a, 0x61, 0x7f, 0x7c, 0xd9, 0x25, 0x61, 0x6d, 0x6d, 0x5f, 0x6a, 0x96, 0x2c, 0xe0, 0x25,
+	0x23, 0xae, 0x28, 0x4d, 0x40, 0x19, 0x2d, 0x8b, 0xfc, 0x3a, 0x26, 0x2c, 0xca, 0x93, 0x52, 0x54,
+	0x68, 0x2c, 0x22, 0xb5, 0x6b, 0x7b, 0xaa, 0x35, 0x3f, 0x4c, 0x5a, 0x18, 0xf6, 0x87, 0x34, 0xe3,
+	0xdc, 0x22, 0x2c, 0xa0, 0x3e, 0x23, 0xe8, 0x35, 0x6c, 0x74, 0x79, 0x21, 0xa6, 0x94, 0x8f, 0x9e,
+	0xb5, 0xf2, 0x37, 0xd3, 0xca, 0x72, 0x13, 0x06, 0xd2, 0xa1, 0xec, 0x45, 0x11, 0x61, 0x91, 0x17,
+	0x0d, 0xa9, 0x5f, 0x5b, 0x2b, 0x16, 0x50, 0x6f, 0xa1, 0x56, 0x96, 0xa7, 0xb8, 0x50, 0xd7, 0xbc,
+	0xa8, 0x37, 0x20, 0xfd, 0x9c, 0x6d, 0x3c, 0x05, 0x60, 0x91, 0x17, 0x46, 0x98, 0x47, 0x11, 0xb1,
+	0x1e, 0xc4, 0x15, 0x1e, 0x1e, 0xd5, 0xe1, 0x3e, 0xf1, 0xfb, 0x49, 0x33, 0x59, 0xf0, 0x26, 0xf1,
+	0xfb, 0xbc, 0xa5, 0x0c, 0x60, 0x37, 0x4f, 0x56, 0xc4, 0x7e, 0x0b, 0xd5, 0x6e, 0xd2, 0xc5, 0x71,
+	0x18, 0x56, 0x93, 0xf6, 0xd7, 0x57, 0xcd, 0xbf, 0x25, 0xa8, 0xf1, 0x13, 0x53, 0x10, 0xc8, 0xed,
+	0x81, 0x37, 0xf4, 0x3b, 0xc4, 0xeb, 0x0b, 0xdf, 0xca, 0x9f, 0x12, 0x3c, 0xca, 0x14, 0xc5, 0xd4,
+	0xbc, 0x33, 0x41, 0x50, 0xca, 0xd8, 0x8f, 0x7f, 0xa3, 0xef, 0xe0, 0xc9, 0x87, 0xa1, 0xef, 0x8d,
+	0x86, 0xbf, 0x91, 0x3e, 0xe6, 0x7b, 0x22, 0x38, 0xa4, 0x34, 0xc2, 0x9c, 0xf0, 0xea, 0x88, 0xd5,
+	0xd6, 0x63, 0x7e, 0x7d, 0x8a, 0xb1, 0x39, 0xc4, 0xa2, 0x34, 0xea, 0x24, 0x00, 0x74, 0x00, 0x95,
+	0x0f, 0x34, 0xfc, 0x84, 0x27, 0x24, 0x64, 0xfc, 0xdd, 0x94, 0x62, 0xf1, 0x32, 0xaf, 0x5d, 0x24,
+	0x25, 0xe5, 0x05, 0xec, 0x24, 0x99, 0x62, 0x32, 0x27, 0x16, 0x5d, 0xb3, 0xe2, 0x02, 0xca, 0xc0,
+	0xd3, 0xd7, 0xb3, 0xcc, 0xa8, 0xb4, 0xc4, 0xa8, 0xf2, 0x73, 0x7a, 0x95, 0x42, 0x56, 0x2c, 0xea,
+	0x0d, 0x54, 0x92, 0xf5, 0x27, 0xa2, 0xab, 0x1d, 0x67, 0x22, 0x51, 0xee, 0xde, 0x3e, 0x28, 0xdf,
+	0xc0, 0x76, 0xe6, 0xee, 0x0a, 0x03, 0x36, 0x01, 0x65, 0x4f, 0xb4, 0xe0, 0x6b, 0x0c, 0x66, 0x44,
+	0x0b, 0x5f, 0xee, 0xff, 0xf4, 0x89, 0x7c, 0x09, 0x75, 0xd7, 0x67, 0x84, 0xf8, 0x19, 0x04, 0x4b,
+	0x4f, 0xad, 0x9f, 0xd3, 0x9c, 0x9a, 0x3a, 0x81, 0x4a, 0x46, 0x68, 0xe9, 0x95, 0x67, 0x25, 0x66,
+	0x88, 0x4a, 0x0b, 0x6a, 0xe7, 0x21, 0x0d, 0x28, 0x23, 0xa1, 0x3d, 0xf2, 0xd8, 0x60, 0xe8, 0x7f,
+	0x2c, 0x5c, 0xe7, 0x0b, 0xd8, 0x99, 0xc7, 0x17, 0xed, 0xf4, 0x77, 0x69, 0x51, 0xbf, 0x70, 0xb3,
+	0x2e, 0x3c, 0x0a, 0x04, 0x1e, 0x33, 0x41, 0x10, 0xfb, 0x6d, 0xde, 0x95, 0x6e, 0x61, 0x80, 0x1c,
+	0xcc, 0x55, 0x78, 0xcc, 0x64, 0x07, 0xab, 0xc7, 0x9c, 0xc7, 0x2f, 0x8b, 0xb9, 0x88, 0x2f, 0x8e,
+	0x99, 0xe2, 0x57, 0x8e, 0xb9, 0x30, 0x40, 0x9e, 0xaf, 0x28, 0xcf, 0xe1, 0xe1, 0x31, 0x09, 0x28,
+	0x1b, 0x46, 0x85, 0xe9, 0xbe, 0x82, 0xaa, 0x80, 0x15, 0x85, 0xfa, 0x65, 0x2a, 0x56, 0x18, 0xe5,
+	0x35, 0x6c, 0xf6, 0x13, 0x98, 0x08, 0xb0, 0x77, 0x57, 0x80, 0x54, 0x2d, 0xc5, 0x2b, 0x0a, 0x54,
+	0xf4, 0xab, 0x25, 0x5e, 0x0f, 0xa0, 0xac, 0x5f, 0x15, 0x1b, 0x0d, 0x12, 0x99, 0x42, 0x97, 0x67,
+	0x50, 0x9d, 0xd0, 0xd1, 0xd8, 0x8f, 0xbc, 0xf0, 0x1a, 0x93, 0xab, 0xa9, 0xd9, 0xe7, 0x77, 0x99,
+	0xbd, 0x48, 0xd1, 0xb1, 0xf4, 0xd6, 0x24, 0xfb, 0xf8, 0xed, 0x3f, 0xeb, 0xb0, 0xe1, 0xd0, 0x60,
+	0xd8, 0x43, 0x65, 0xd8, 0x74, 0x8d, 0x77, 0x86, 0xf9, 0xa3, 0x21, 0x7f, 0x86, 0xea, 0xf0, 0x85,
+	0xa6, 0xab, 0x6d, 0xd3, 0xc0, 0xda, 0x99, 0xd9, 0x7e, 0x87, 0x55, 0xc3, 0x30, 0x5d, 0xa3, 0xad,
+	0xcb, 0x12, 0xaa, 0xc1, 0xe7, 0x33, 0x2d, 0x4b, 0xff, 0xc1, 0xd5, 0x6d, 0x47, 0x5e, 0x43, 0x5f,
+	0xc3, 0xb3, 0xbc, 0x0e, 0xd6, 0xde, 0x63, 0xfb, 0xcc, 0x74, 0xb0, 0xe1, 0x7e, 0xaf, 0xe9, 0x96,
+	0xbc, 0xbe, 0xa0, 0x6e, 0xe9, 0xf6, 0xb9, 0x69, 0xd8, 0xba, 0x5c, 0x42, 0xfb, 0xf0, 0x44, 0x53,
+	0x9d, 0x76, 0x47, 0x3f, 0xc6, 0xb9, 0x53, 0x36, 0xd0, 0x01, 0x3c, 0xbd, 0x03, 0x21, 0x44, 0xee,
+	0xa1, 0xc7, 0x80, 0xda, 0x1d, 0xf5, 0xd4, 0xc0, 0x1d, 0x5d, 0x3d, 0x9e, 0x52, 0x37, 0xd1, 0x0e,
+	0x6c, 0xcf, 0xd4, 0x05, 0xe1, 0x3e, 0x6a, 0xc0, 0xae, 0xd0, 0xb2, 0x1d, 0xd5, 0xd1, 0x71, 0x47,
+	0xb5, 0x3b, 0xb7, 0x99, 0x1f, 0x64, 0x32, 0x27, 0xfd, 0x54, 0x12, 0x32, 0x51, 0xd2, 0x8e, 0x10,
+	0x2d, 0x73, 0x92, 0xea, 0x38, 0x3a, 0xaf, 0x9f, 0x9a, 0xc6, 0xad, 0x5c, 0x85, 0xfb, 0xc8, 0x76,
+	0x52, 0xb5, 0xad, 0x79, 0xca, 0x54, 0xac, 0x8a, 0x10, 0x54, 0x2f, 0xcc, 0x33, 0xd7, 0x70, 0x54,
+	0xeb, 0x3d, 0xd6, 0x7f, 0x3a, 0x75, 0xe4, 0x87, 0xb1, 0xcc, 0xc9, 0x89, 0xa5, 0x9f, 0xf0, 0xc1,
+	0xaa, 0x71, 0x8c, 0xcf, 0x2d, 0xd3, 0x7c, 0x23, 0xcb, 0x5a, 0xe5, 0xaf, 0x9b, 0x86, 0xf4, 0xf7,
+	0x4d, 0x43, 0xfa, 0xf7, 0xa6, 0x21, 0x75, 0xef, 0xc5, 0x7f, 0x0c, 0x5f, 0xfd, 0x37, 0x00, 0xa1,
+	0x35, 0x52, 0x6a, 0x77, 0x0a, 0x00, 0x00,
 }
 
 func (m *BeaconBlockAnnounce) Marshal() (dAtA []byte, err error) {
@@ -1801,6 +1818,11 @@ func (m *ChainHeadResponse) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintMessages(dAtA, i, uint64(len(m.FinalizedStateRootHash32S)))
 		i += copy(dAtA[i:], m.FinalizedStateRootHash32S)
 	}
+	if m.ForkVersion != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.ForkVersion))
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -2545,6 +2567,9 @@ func (m *ChainHeadResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMessages(uint64(l))
 	}
+	if m.ForkVersion != 0 {
+		n += 1 + sovMessages(uint64(m.ForkVersion))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3646,6 +3671,25 @@ func (m *ChainHeadResponse) Unmarshal(dAtA []byte) error {
 				m.FinalizedStateRootHash32S = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkVersion", wireType)
+			}
+			m.ForkVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForkVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
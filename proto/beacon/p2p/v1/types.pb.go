@@ -872,8 +872,8 @@ type BeaconBlock struct {
 	StateRootHash32      []byte           `protobuf:"bytes,3,opt,name=state_root_hash32,json=stateRootHash32,proto3" json:"state_root_hash32,omitempty"`
 	RandaoReveal         []byte           `protobuf:"bytes,4,opt,name=randao_reveal,json=randaoReveal,proto3" json:"randao_reveal,omitempty"`
 	Eth1Data             *Eth1Data        `protobuf:"bytes,5,opt,name=eth1_data,json=eth1Data,proto3" json:"eth1_data,omitempty"`
-	Signature            []byte           `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
 	Body                 *BeaconBlockBody `protobuf:"bytes,7,opt,name=body,proto3" json:"body,omitempty"`
+	Signature            []byte           `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -947,16 +947,16 @@ func (m *BeaconBlock) GetEth1Data() *Eth1Data {
 	return nil
 }
 
-func (m *BeaconBlock) GetSignature() []byte {
+func (m *BeaconBlock) GetBody() *BeaconBlockBody {
 	if m != nil {
-		return m.Signature
+		return m.Body
 	}
 	return nil
 }
 
-func (m *BeaconBlock) GetBody() *BeaconBlockBody {
+func (m *BeaconBlock) GetSignature() []byte {
 	if m != nil {
-		return m.Body
+		return m.Signature
 	}
 	return nil
 }
@@ -1042,8 +1042,8 @@ func (m *BeaconBlockBody) GetVoluntaryExits() []*VoluntaryExit {
 
 type DepositInput struct {
 	Pubkey                      []byte   `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
-	ProofOfPossession           []byte   `protobuf:"bytes,2,opt,name=proof_of_possession,json=proofOfPossession,proto3" json:"proof_of_possession,omitempty"`
 	WithdrawalCredentialsHash32 []byte   `protobuf:"bytes,3,opt,name=withdrawal_credentials_hash32,json=withdrawalCredentialsHash32,proto3" json:"withdrawal_credentials_hash32,omitempty"`
+	ProofOfPossession           []byte   `protobuf:"bytes,2,opt,name=proof_of_possession,json=proofOfPossession,proto3" json:"proof_of_possession,omitempty"`
 	XXX_NoUnkeyedLiteral        struct{} `json:"-"`
 	XXX_unrecognized            []byte   `json:"-"`
 	XXX_sizecache               int32    `json:"-"`
@@ -1089,16 +1089,16 @@ func (m *DepositInput) GetPubkey() []byte {
 	return nil
 }
 
-func (m *DepositInput) GetProofOfPossession() []byte {
+func (m *DepositInput) GetWithdrawalCredentialsHash32() []byte {
 	if m != nil {
-		return m.ProofOfPossession
+		return m.WithdrawalCredentialsHash32
 	}
 	return nil
 }
 
-func (m *DepositInput) GetWithdrawalCredentialsHash32() []byte {
+func (m *DepositInput) GetProofOfPossession() []byte {
 	if m != nil {
-		return m.WithdrawalCredentialsHash32
+		return m.ProofOfPossession
 	}
 	return nil
 }
@@ -1560,6 +1560,69 @@ func (m *VoluntaryExit) GetSignature() []byte {
 	return nil
 }
 
+type AggregateAndProof struct {
+	AggregatorIndex      uint64       `protobuf:"varint,1,opt,name=aggregator_index,json=aggregatorIndex,proto3" json:"aggregator_index,omitempty"`
+	Aggregate            *Attestation `protobuf:"bytes,2,opt,name=aggregate,proto3" json:"aggregate,omitempty"`
+	SelectionProof       []byte       `protobuf:"bytes,3,opt,name=selection_proof,json=selectionProof,proto3" json:"selection_proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *AggregateAndProof) Reset()         { *m = AggregateAndProof{} }
+func (m *AggregateAndProof) String() string { return proto.CompactTextString(m) }
+func (*AggregateAndProof) ProtoMessage()    {}
+func (*AggregateAndProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e719e7d82cfa7b0d, []int{19}
+}
+func (m *AggregateAndProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregateAndProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregateAndProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AggregateAndProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregateAndProof.Merge(m, src)
+}
+func (m *AggregateAndProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregateAndProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregateAndProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregateAndProof proto.InternalMessageInfo
+
+func (m *AggregateAndProof) GetAggregatorIndex() uint64 {
+	if m != nil {
+		return m.AggregatorIndex
+	}
+	return 0
+}
+
+func (m *AggregateAndProof) GetAggregate() *Attestation {
+	if m != nil {
+		return m.Aggregate
+	}
+	return nil
+}
+
+func (m *AggregateAndProof) GetSelectionProof() []byte {
+	if m != nil {
+		return m.SelectionProof
+	}
+	return nil
+}
+
 type Eth1Data struct {
 	DepositRootHash32    []byte   `protobuf:"bytes,1,opt,name=deposit_root_hash32,json=depositRootHash32,proto3" json:"deposit_root_hash32,omitempty"`
 	BlockHash32          []byte   `protobuf:"bytes,2,opt,name=block_hash32,json=blockHash32,proto3" json:"block_hash32,omitempty"`
@@ -1572,7 +1635,7 @@ func (m *Eth1Data) Reset()         { *m = Eth1Data{} }
 func (m *Eth1Data) String() string { return proto.CompactTextString(m) }
 func (*Eth1Data) ProtoMessage()    {}
 func (*Eth1Data) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e719e7d82cfa7b0d, []int{19}
+	return fileDescriptor_e719e7d82cfa7b0d, []int{20}
 }
 func (m *Eth1Data) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1627,7 +1690,7 @@ func (m *Eth1DataVote) Reset()         { *m = Eth1DataVote{} }
 func (m *Eth1DataVote) String() string { return proto.CompactTextString(m) }
 func (*Eth1DataVote) ProtoMessage()    {}
 func (*Eth1DataVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e719e7d82cfa7b0d, []int{20}
+	return fileDescriptor_e719e7d82cfa7b0d, []int{21}
 }
 func (m *Eth1DataVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1670,6 +1733,61 @@ func (m *Eth1DataVote) GetVoteCount() uint64 {
 	return 0
 }
 
+type GenesisState struct {
+	State                  *BeaconState `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	GenesisBlockRootHash32 []byte       `protobuf:"bytes,2,opt,name=genesis_block_root_hash32,json=genesisBlockRootHash32,proto3" json:"genesis_block_root_hash32,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}     `json:"-"`
+	XXX_unrecognized       []byte       `json:"-"`
+	XXX_sizecache          int32        `json:"-"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e719e7d82cfa7b0d, []int{22}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetState() *BeaconState {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGenesisBlockRootHash32() []byte {
+	if m != nil {
+		return m.GenesisBlockRootHash32
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("ethereum.beacon.p2p.v1.Validator_StatusFlags", Validator_StatusFlags_name, Validator_StatusFlags_value)
 	proto.RegisterType((*BeaconState)(nil), "ethereum.beacon.p2p.v1.BeaconState")
@@ -1691,136 +1809,143 @@ func init() {
 	proto.RegisterType((*AttesterSlashing)(nil), "ethereum.beacon.p2p.v1.AttesterSlashing")
 	proto.RegisterType((*Deposit)(nil), "ethereum.beacon.p2p.v1.Deposit")
 	proto.RegisterType((*VoluntaryExit)(nil), "ethereum.beacon.p2p.v1.VoluntaryExit")
+	proto.RegisterType((*AggregateAndProof)(nil), "ethereum.beacon.p2p.v1.AggregateAndProof")
 	proto.RegisterType((*Eth1Data)(nil), "ethereum.beacon.p2p.v1.Eth1Data")
 	proto.RegisterType((*Eth1DataVote)(nil), "ethereum.beacon.p2p.v1.Eth1DataVote")
+	proto.RegisterType((*GenesisState)(nil), "ethereum.beacon.p2p.v1.GenesisState")
 }
 
 func init() { proto.RegisterFile("proto/beacon/p2p/v1/types.proto", fileDescriptor_e719e7d82cfa7b0d) }
 
 var fileDescriptor_e719e7d82cfa7b0d = []byte{
-	// 1939 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcb, 0x6f, 0x23, 0x49,
-	0x19, 0xa7, 0xed, 0x3c, 0x3f, 0x3b, 0xb1, 0x53, 0x99, 0xc4, 0xcd, 0xbc, 0x92, 0xe9, 0xd9, 0xd5,
-	0x64, 0x86, 0x5d, 0x07, 0x7b, 0x25, 0x46, 0x30, 0xac, 0x44, 0x3c, 0xc9, 0xb2, 0x81, 0xd9, 0xdd,
-	0x51, 0x3b, 0xcc, 0x70, 0x00, 0x5a, 0x65, 0x77, 0xd9, 0xee, 0x49, 0xbb, 0xab, 0xd5, 0x55, 0xf6,
-	0x26, 0x88, 0x7f, 0x80, 0x87, 0xb8, 0x71, 0x80, 0x1b, 0x88, 0x7f, 0x82, 0xf7, 0x09, 0x89, 0x23,
-	0x2f, 0x21, 0x21, 0x21, 0x84, 0xe6, 0xcc, 0xfb, 0xca, 0x05, 0xd5, 0xa3, 0x1f, 0x7e, 0x74, 0x32,
-	0xc3, 0x72, 0xd9, 0x93, 0xd5, 0xdf, 0xf7, 0xfb, 0x7d, 0x55, 0xf5, 0xd5, 0xf7, 0x2a, 0xc3, 0x4e,
-	0x18, 0x51, 0x4e, 0xf7, 0x3b, 0x04, 0x77, 0x69, 0xb0, 0x1f, 0x36, 0xc3, 0xfd, 0x71, 0x63, 0x9f,
-	0x9f, 0x87, 0x84, 0xd5, 0xa5, 0x06, 0x6d, 0x13, 0x3e, 0x20, 0x11, 0x19, 0x0d, 0xeb, 0x0a, 0x53,
-	0x0f, 0x9b, 0x61, 0x7d, 0xdc, 0xb8, 0x7a, 0x4d, 0x11, 0xbb, 0x74, 0x38, 0xa4, 0xc1, 0xfe, 0x90,
-	0x30, 0x86, 0xfb, 0x31, 0xc9, 0xfa, 0x4f, 0x09, 0x4a, 0x2d, 0x09, 0x6f, 0x73, 0xcc, 0x09, 0x7a,
-	0x0c, 0x68, 0x8c, 0x7d, 0xcf, 0xc5, 0x9c, 0x46, 0x4e, 0x44, 0xfa, 0x1e, 0xe3, 0xd1, 0xb9, 0x69,
-	0xec, 0x16, 0xf7, 0x4a, 0xcd, 0x5b, 0xf5, 0xf9, 0x2b, 0xd4, 0x9f, 0xc4, 0x0c, 0x7b, 0x23, 0x21,
-	0xdb, 0x9a, 0x8b, 0x8e, 0x60, 0x67, 0xd6, 0xa2, 0x33, 0x0a, 0x5d, 0xcc, 0x89, 0x43, 0x42, 0xda,
-	0x1d, 0x98, 0x85, 0x5d, 0x63, 0x6f, 0xc1, 0xbe, 0x3e, 0xc3, 0xfd, 0x82, 0x04, 0x1d, 0x09, 0x0c,
-	0x7a, 0x3d, 0xbb, 0xb1, 0x0e, 0xf6, 0x71, 0xd0, 0x25, 0xcc, 0x2c, 0xee, 0x16, 0xf7, 0x16, 0x32,
-	0xab, 0xb6, 0xb4, 0x02, 0xed, 0xc3, 0xa6, 0x8f, 0x39, 0x61, 0xdc, 0x89, 0x70, 0xe0, 0x62, 0xea,
-	0x0c, 0xbd, 0x33, 0xc2, 0xcc, 0xbf, 0x2e, 0xef, 0x16, 0xf7, 0xca, 0xf6, 0x86, 0xd2, 0xd9, 0x52,
-	0xf5, 0x8e, 0xd0, 0xa0, 0x43, 0xb8, 0x19, 0x46, 0x64, 0xec, 0xd1, 0x11, 0x73, 0xd8, 0x60, 0xd4,
-	0xeb, 0xf9, 0x5e, 0xd0, 0x77, 0x18, 0xc7, 0x11, 0x77, 0xd8, 0x00, 0x47, 0xae, 0xf9, 0xb7, 0x65,
-	0xb9, 0xcd, 0x6b, 0x31, 0xac, 0x1d, 0xa3, 0xda, 0x02, 0xd4, 0x16, 0x18, 0xd4, 0x82, 0x1b, 0xdd,
-	0x51, 0x14, 0x91, 0x80, 0xe7, 0x18, 0xf9, 0xbb, 0x32, 0x72, 0x55, 0xa3, 0xe6, 0xd9, 0xf8, 0x24,
-	0x98, 0x73, 0x76, 0xa2, 0x3c, 0xf5, 0x0f, 0x45, 0xdf, 0x9e, 0xd9, 0x83, 0x72, 0xd2, 0x7d, 0xa8,
-	0xcd, 0x2e, 0xaf, 0x98, 0xff, 0x54, 0xcc, 0xad, 0xe9, 0x85, 0x15, 0x31, 0xe7, 0xf4, 0x84, 0xb8,
-	0xce, 0x00, 0xb3, 0xc1, 0x1b, 0x4d, 0xf3, 0x5f, 0x82, 0x5f, 0x9e, 0x77, 0x7a, 0x42, 0xdc, 0xb7,
-	0x25, 0x26, 0xe7, 0xf4, 0x19, 0x23, 0xff, 0x56, 0x46, 0x66, 0x4f, 0x9f, 0xda, 0xc8, 0x9e, 0xfe,
-	0xd9, 0x88, 0x71, 0xaf, 0xe7, 0x11, 0x57, 0x9f, 0xe1, 0x37, 0x95, 0xc9, 0xd3, 0x7f, 0x2e, 0xd6,
-	0xab, 0x43, 0xec, 0x41, 0x65, 0x9a, 0xf1, 0x5b, 0xc5, 0x58, 0x7f, 0x36, 0x89, 0xfc, 0x04, 0x6c,
-	0x6b, 0x49, 0x17, 0x73, 0x8f, 0x06, 0x4e, 0xc7, 0xe3, 0x3d, 0x8f, 0xf8, 0xae, 0xf9, 0x3b, 0x45,
-	0xd8, 0x9a, 0x50, 0xb7, 0xb4, 0x56, 0xac, 0xd0, 0xf3, 0x02, 0xec, 0x7b, 0x5f, 0x4d, 0x56, 0xf8,
-	0xbd, 0x5e, 0x21, 0x91, 0xab, 0x15, 0xde, 0x03, 0x1d, 0x63, 0x4e, 0x37, 0xa2, 0x8c, 0xf9, 0x5e,
-	0x70, 0xca, 0xcc, 0x1f, 0xd5, 0x2e, 0xce, 0xa3, 0x87, 0x31, 0xd4, 0xae, 0x2a, 0x72, 0x22, 0x60,
-	0xe8, 0x53, 0xf0, 0x51, 0x6d, 0xb0, 0xe3, 0xd3, 0xee, 0xa9, 0x13, 0x51, 0xca, 0xb5, 0x5b, 0x99,
-	0xf9, 0x93, 0x9a, 0x0c, 0xeb, 0x6d, 0x85, 0x68, 0x09, 0x80, 0x4d, 0x29, 0x57, 0x2e, 0x65, 0xe8,
-	0xd3, 0x70, 0xb5, 0x83, 0x79, 0x77, 0x40, 0xdc, 0x79, 0xe4, 0x9f, 0x2a, 0x72, 0x4d, 0x43, 0x66,
-	0xd8, 0xf7, 0xa1, 0xa6, 0x57, 0x66, 0x3e, 0x66, 0xd2, 0x48, 0x9c, 0x7e, 0x3f, 0xab, 0xc9, 0xfc,
-	0xdb, 0x52, 0xfa, 0xb6, 0x52, 0x27, 0x39, 0xf8, 0xa5, 0x24, 0x07, 0x31, 0x17, 0x3f, 0xd2, 0x97,
-	0xcc, 0xfc, 0xb9, 0xf2, 0xc2, 0xbd, 0x3c, 0x2f, 0x3c, 0x26, 0x81, 0xeb, 0x05, 0xfd, 0x83, 0x94,
-	0x63, 0x23, 0x65, 0x27, 0x23, 0xca, 0x3a, 0xc4, 0x0b, 0x5c, 0x72, 0x36, 0x79, 0xa6, 0x5f, 0x4c,
-	0x38, 0xe4, 0x58, 0x00, 0xb2, 0x47, 0xfa, 0x3c, 0x68, 0x07, 0x3b, 0x84, 0x0f, 0x1a, 0x8e, 0x8b,
-	0x39, 0x36, 0xbf, 0xbf, 0xb3, 0x6b, 0xec, 0x95, 0x9a, 0xbb, 0x79, 0xdb, 0x3a, 0xe2, 0x83, 0xc6,
-	0x21, 0xe6, 0xd8, 0x5e, 0x57, 0xd4, 0xf8, 0x1b, 0xbd, 0x03, 0x95, 0xc4, 0x8a, 0x33, 0xa6, 0x9c,
-	0x30, 0xf3, 0x07, 0x3b, 0xf2, 0x88, 0xaf, 0x5c, 0x66, 0xeb, 0x09, 0xe5, 0xc4, 0x5e, 0x23, 0x99,
-	0x2f, 0x86, 0x2c, 0x28, 0xf7, 0x49, 0x40, 0x98, 0xc7, 0x1c, 0xee, 0x0d, 0x89, 0xf9, 0xf5, 0x3b,
-	0x32, 0xc0, 0x4a, 0x5a, 0x78, 0xe2, 0x0d, 0x09, 0x6a, 0xc0, 0x42, 0x8f, 0x46, 0xa7, 0xe6, 0x37,
-	0xee, 0xc8, 0x3d, 0x5f, 0xcf, 0x5b, 0xe7, 0x2d, 0x1a, 0x9d, 0xda, 0x12, 0x8a, 0x36, 0x61, 0x81,
-	0xf9, 0x94, 0x9b, 0xdf, 0x54, 0xe6, 0xe4, 0x87, 0x15, 0xc2, 0x82, 0x80, 0xa0, 0xbb, 0x50, 0x4d,
-	0x92, 0x6e, 0x4c, 0x22, 0xe6, 0xd1, 0xc0, 0x34, 0x24, 0xae, 0x12, 0xcb, 0x9f, 0x28, 0x31, 0xba,
-	0x03, 0x95, 0x38, 0xc7, 0x63, 0xa4, 0x2a, 0xdf, 0xeb, 0x5a, 0x1c, 0x03, 0xaf, 0xc0, 0xa2, 0xca,
-	0x90, 0xa2, 0x54, 0xab, 0x0f, 0xeb, 0x0f, 0x06, 0xa0, 0xd9, 0x0b, 0x46, 0x0f, 0x60, 0x41, 0x5e,
-	0x82, 0x21, 0xcf, 0x73, 0x27, 0xef, 0x3c, 0x19, 0x8a, 0xbc, 0x0a, 0x49, 0x42, 0x0d, 0xb8, 0x82,
-	0xfb, 0xfd, 0x88, 0xf4, 0xa7, 0x72, 0xb9, 0x20, 0x8b, 0xcd, 0x66, 0x46, 0x97, 0x24, 0xf2, 0x5d,
-	0xa8, 0x76, 0x47, 0x8c, 0x53, 0xf7, 0x3c, 0x85, 0x17, 0x25, 0xbc, 0xa2, 0xe5, 0x09, 0xf4, 0x55,
-	0x58, 0xf7, 0x82, 0xae, 0x3f, 0x12, 0x87, 0x72, 0xa4, 0x0b, 0x17, 0xe4, 0x81, 0xd6, 0x12, 0x69,
-	0x5b, 0xb8, 0xf2, 0x8f, 0x06, 0x94, 0x3e, 0x24, 0x27, 0xda, 0x87, 0xc4, 0x02, 0x71, 0x98, 0xd7,
-	0x0f, 0x30, 0x1f, 0x45, 0x44, 0x1e, 0xab, 0x6c, 0xa3, 0x44, 0xd5, 0x8e, 0x35, 0xd6, 0x0f, 0x8b,
-	0x50, 0x99, 0xda, 0x28, 0x42, 0x3a, 0x9e, 0x8c, 0x34, 0x9c, 0xc4, 0x95, 0xab, 0x2e, 0xa7, 0x22,
-	0x42, 0x7d, 0xa0, 0xfb, 0x60, 0xaa, 0x33, 0xcf, 0x16, 0x1f, 0xbd, 0xc3, 0x2d, 0xa5, 0x9f, 0xaa,
-	0x3c, 0xe8, 0x01, 0x5c, 0x95, 0x41, 0xe3, 0x74, 0xe8, 0x28, 0x70, 0x71, 0x74, 0x3e, 0x41, 0x55,
-	0xdb, 0xad, 0x49, 0x44, 0x4b, 0x03, 0x26, 0xc9, 0x49, 0xe5, 0x55, 0xa9, 0x99, 0x25, 0x2f, 0x2a,
-	0x72, 0x82, 0x90, 0xbe, 0x4f, 0xc9, 0x8f, 0x92, 0xfa, 0x90, 0x20, 0xcc, 0x25, 0x79, 0x91, 0x2f,
-	0x50, 0xbb, 0x2b, 0x53, 0xb5, 0x5b, 0xa4, 0xcc, 0x74, 0x5f, 0x5a, 0x9e, 0xdb, 0x96, 0xde, 0x84,
-	0x6b, 0x29, 0x70, 0xd6, 0x59, 0x2b, 0x72, 0xd3, 0x66, 0x02, 0x99, 0xf2, 0x97, 0xf5, 0x35, 0xb8,
-	0x3e, 0x75, 0x4b, 0x07, 0x81, 0xfb, 0x30, 0xb9, 0xfc, 0x0f, 0x16, 0x92, 0x3b, 0x50, 0xca, 0xc4,
-	0x97, 0xbc, 0xe1, 0x15, 0x1b, 0xd2, 0xd0, 0xb2, 0xbe, 0x53, 0x84, 0xd5, 0x64, 0x10, 0x44, 0xdb,
-	0xb0, 0x14, 0x8e, 0x3a, 0xa7, 0xe4, 0x5c, 0xae, 0x56, 0xb6, 0xf5, 0x97, 0x18, 0x11, 0xde, 0xf7,
-	0xf8, 0xc0, 0x8d, 0xf0, 0xfb, 0xd8, 0x77, 0xba, 0x11, 0x71, 0x49, 0xc0, 0x3d, 0xec, 0xb3, 0xf8,
-	0x90, 0x2a, 0xc4, 0xaf, 0xa5, 0xa0, 0x87, 0x29, 0x46, 0xdf, 0xce, 0x5d, 0xa8, 0xe2, 0x2e, 0xf7,
-	0xc6, 0x2a, 0x39, 0x94, 0x43, 0x17, 0x55, 0xb5, 0x4a, 0xe5, 0xca, 0xa3, 0x37, 0x00, 0xc8, 0x99,
-	0xc7, 0x35, 0x68, 0x49, 0x82, 0x56, 0x85, 0x44, 0xa9, 0xef, 0x42, 0x35, 0xb3, 0x9b, 0xec, 0xd5,
-	0x54, 0x52, 0xb9, 0x82, 0xde, 0x86, 0xb5, 0xb8, 0xfd, 0x29, 0xdc, 0x8a, 0xc4, 0x95, 0xb5, 0x50,
-	0x81, 0x1e, 0x43, 0x59, 0x78, 0x6e, 0xc4, 0x9c, 0x9e, 0x8f, 0xfb, 0xcc, 0x5c, 0xdd, 0x35, 0xf6,
-	0xd6, 0x9b, 0xaf, 0x5f, 0x3a, 0x37, 0xd7, 0xdb, 0x92, 0xf5, 0x96, 0x20, 0xd9, 0x25, 0x96, 0x7e,
-	0x58, 0x9f, 0x81, 0x52, 0x46, 0x87, 0x4a, 0xb0, 0x7c, 0xfc, 0xee, 0xf1, 0xc9, 0xf1, 0xc1, 0xa3,
-	0xea, 0x47, 0x10, 0x82, 0x75, 0xf5, 0x71, 0x72, 0x74, 0xe8, 0x1c, 0x7d, 0xf1, 0xf8, 0xa4, 0x6a,
-	0xa0, 0x2a, 0x94, 0x9f, 0x1e, 0x9f, 0xbc, 0x7d, 0x68, 0x1f, 0x3c, 0x3d, 0x68, 0x3d, 0x3a, 0xaa,
-	0x16, 0x2c, 0x1f, 0x6a, 0x72, 0xae, 0xb4, 0x09, 0x66, 0x22, 0xd9, 0x87, 0x24, 0xe0, 0x36, 0xe9,
-	0xd2, 0xc8, 0x15, 0x81, 0x99, 0xce, 0xd4, 0xb2, 0x8b, 0xea, 0x74, 0x5e, 0x4f, 0xc4, 0xb2, 0x75,
-	0xe6, 0x24, 0x76, 0x5c, 0x02, 0x8a, 0x99, 0x8e, 0xf2, 0x15, 0x58, 0x4d, 0x03, 0x3f, 0x69, 0x01,
-	0x46, 0xa6, 0x05, 0x5c, 0x92, 0x99, 0x85, 0x0b, 0x33, 0xd3, 0xfa, 0x71, 0x21, 0x7e, 0xaf, 0xc8,
-	0xe8, 0x9f, 0x5b, 0x86, 0x5e, 0x03, 0x14, 0x62, 0xd9, 0xa1, 0x66, 0x0d, 0x57, 0x95, 0x26, 0x93,
-	0xeb, 0xf7, 0x60, 0x43, 0x38, 0x9c, 0xcc, 0xa9, 0x4b, 0x15, 0xa9, 0xc8, 0x60, 0x6f, 0xc3, 0x9a,
-	0x7e, 0x4e, 0x44, 0x64, 0x4c, 0xb0, 0xaf, 0x8b, 0x50, 0x59, 0x09, 0x6d, 0x29, 0x43, 0x6f, 0xc2,
-	0x6a, 0x3a, 0x55, 0x2c, 0xbe, 0xe0, 0x50, 0xb1, 0x12, 0x0f, 0x01, 0xe8, 0x3a, 0xac, 0xa6, 0x35,
-	0x79, 0x49, 0xda, 0x4f, 0x05, 0x22, 0x87, 0x3b, 0xd4, 0x3d, 0x97, 0x51, 0x7a, 0x41, 0x0e, 0x67,
-	0x5c, 0xd4, 0xa2, 0xee, 0xb9, 0x2d, 0x49, 0xd6, 0x77, 0x8b, 0x50, 0x99, 0xd2, 0xa0, 0xcf, 0x42,
-	0x79, 0x62, 0x3a, 0x53, 0x4f, 0xbd, 0xdb, 0x2f, 0x50, 0x1c, 0xec, 0x09, 0x22, 0x7a, 0x0a, 0x28,
-	0x8c, 0x68, 0x48, 0x19, 0x89, 0xd4, 0xa0, 0xe8, 0x05, 0x7d, 0x66, 0x16, 0xa4, 0xb9, 0xbd, 0xdc,
-	0x59, 0x4f, 0x33, 0xda, 0x9a, 0x60, 0x6f, 0x84, 0x53, 0x12, 0x69, 0x58, 0x2d, 0x34, 0x61, 0xb8,
-	0x78, 0xb1, 0xe1, 0x03, 0xcd, 0x48, 0x0d, 0xe3, 0x29, 0x09, 0x43, 0x0f, 0x60, 0xc5, 0x25, 0x21,
-	0x65, 0x1e, 0x67, 0xe6, 0x82, 0x34, 0xb7, 0x93, 0x67, 0xee, 0x50, 0xe1, 0xec, 0x84, 0x80, 0xde,
-	0x85, 0xca, 0x98, 0xfa, 0xa3, 0x80, 0x8b, 0xbe, 0x24, 0x2a, 0x0a, 0x33, 0x17, 0xa5, 0x8d, 0x57,
-	0x73, 0xb3, 0x3d, 0x86, 0x1f, 0x9d, 0x79, 0xdc, 0x5e, 0x1f, 0x67, 0x3f, 0x99, 0xf5, 0x3d, 0x03,
-	0xca, 0x7a, 0x95, 0xe3, 0x20, 0x1c, 0xf1, 0xdc, 0x0a, 0x5a, 0x87, 0xcd, 0x30, 0xa2, 0xb4, 0xe7,
-	0xd0, 0x9e, 0x13, 0x52, 0xc6, 0x08, 0x4b, 0x86, 0xb0, 0xb2, 0x74, 0x1f, 0xed, 0xbd, 0xd7, 0x7b,
-	0x9c, 0x28, 0x2e, 0xaf, 0xb8, 0xc5, 0x4b, 0x2b, 0xae, 0xf5, 0x0c, 0x90, 0xba, 0x29, 0xec, 0x8b,
-	0xa9, 0x80, 0xb8, 0x2f, 0x39, 0x02, 0xdc, 0x83, 0x8d, 0xbc, 0xde, 0x5f, 0xe9, 0x4c, 0x75, 0xb1,
-	0x3f, 0x19, 0x70, 0x45, 0xde, 0x11, 0xee, 0xf8, 0x24, 0x3b, 0x51, 0x7d, 0x0c, 0x36, 0x26, 0xaa,
-	0x95, 0x27, 0x5e, 0x20, 0x86, 0x7c, 0x80, 0x54, 0xb3, 0xf5, 0x4a, 0xc8, 0xe7, 0x8e, 0x43, 0x85,
-	0xf9, 0xe3, 0x50, 0xdc, 0x16, 0x8b, 0xff, 0x4b, 0x5b, 0x7c, 0xe9, 0x59, 0xea, 0xdb, 0x06, 0x94,
-	0xf4, 0x3d, 0x4b, 0x27, 0x1e, 0xc3, 0x9a, 0x8e, 0x29, 0xc7, 0x13, 0xf7, 0xae, 0xbb, 0xf3, 0x2b,
-	0x97, 0x44, 0xa2, 0x8c, 0x11, 0xbb, 0xec, 0x4e, 0x45, 0x0c, 0x1e, 0xd2, 0x51, 0xc0, 0xb5, 0xf3,
-	0xf5, 0x97, 0xa8, 0x28, 0xe2, 0x25, 0xc1, 0x38, 0x1e, 0x86, 0xba, 0x58, 0xa7, 0x02, 0xeb, 0x97,
-	0x05, 0xa8, 0x4e, 0xa7, 0xa1, 0x18, 0x7a, 0x93, 0x64, 0xce, 0x36, 0x86, 0xb5, 0x58, 0xaa, 0xfa,
-	0x82, 0x0d, 0x95, 0x50, 0xc7, 0x85, 0xaa, 0xe4, 0x0d, 0xb9, 0xf4, 0x45, 0x8f, 0xbb, 0x99, 0x30,
-	0x8a, 0x6d, 0x62, 0x5f, 0x7c, 0x35, 0xd0, 0xc7, 0xe1, 0x4a, 0x62, 0x33, 0x71, 0xa8, 0xd3, 0xd0,
-	0xe1, 0x82, 0xc2, 0x8c, 0x01, 0xa9, 0x6a, 0xcc, 0xee, 0x42, 0x0d, 0x87, 0x1f, 0x60, 0x17, 0xcd,
-	0x9c, 0x5d, 0xc4, 0x83, 0xe3, 0xec, 0x2e, 0x9a, 0xd6, 0x9f, 0x0d, 0xa8, 0x4e, 0x57, 0x1d, 0xe4,
-	0x42, 0x8d, 0xc5, 0xb1, 0x9c, 0x7d, 0x05, 0x3b, 0x0d, 0x7d, 0xcf, 0xaf, 0xe5, 0x6d, 0x71, 0x5e,
-	0x0a, 0xd8, 0x5b, 0x6c, 0x8e, 0xb4, 0x91, 0xbf, 0x4a, 0x53, 0x5f, 0xc7, 0xff, 0x61, 0x95, 0xa6,
-	0xf5, 0x2d, 0x03, 0x96, 0x75, 0xf4, 0xa1, 0x26, 0x6c, 0x0d, 0x49, 0x74, 0xea, 0x13, 0xa7, 0x13,
-	0xe1, 0xa0, 0x3b, 0x48, 0x1e, 0xde, 0x86, 0x7c, 0x77, 0x6f, 0x2a, 0x65, 0x4b, 0xea, 0xe2, 0x47,
-	0xf7, 0x3d, 0xd8, 0xd0, 0x1c, 0x1e, 0x11, 0xa2, 0xc3, 0x4a, 0x45, 0x6a, 0x45, 0x29, 0x4e, 0x22,
-	0x42, 0x54, 0x60, 0xdd, 0x82, 0x38, 0xb4, 0x9d, 0x24, 0x37, 0xcb, 0x76, 0xc9, 0x4d, 0x13, 0xc7,
-	0xf2, 0x61, 0x6d, 0xa2, 0xa2, 0xe6, 0x4c, 0x1b, 0x73, 0x66, 0x9c, 0xc2, 0xdc, 0x19, 0x67, 0xa2,
-	0xef, 0x16, 0xa7, 0xfa, 0xae, 0xf5, 0x65, 0x58, 0x49, 0x1e, 0xfc, 0x75, 0xd8, 0x8c, 0x37, 0x97,
-	0xad, 0x67, 0xaa, 0x4c, 0x6f, 0x68, 0x55, 0x66, 0x6a, 0xb8, 0x05, 0x65, 0x55, 0xfd, 0x26, 0x26,
-	0x91, 0x92, 0x94, 0xe9, 0xa2, 0xe7, 0x43, 0x39, 0xfb, 0x9f, 0xc0, 0xe4, 0x0c, 0x61, 0xbc, 0xf4,
-	0x0c, 0x71, 0x03, 0x60, 0x4c, 0x39, 0x71, 0xba, 0x99, 0x6a, 0xb0, 0x2a, 0x24, 0x0f, 0x85, 0xa0,
-	0x55, 0xfe, 0xd5, 0xf3, 0x9b, 0xc6, 0xaf, 0x9f, 0xdf, 0x34, 0xfe, 0xf2, 0xfc, 0xa6, 0xd1, 0x59,
-	0x92, 0xff, 0x04, 0xbf, 0xf1, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x85, 0x9b, 0xdf, 0xdc, 0x61,
-	0x16, 0x00, 0x00,
+	// 2029 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4b, 0x6f, 0x23, 0x59,
+	0x15, 0xa6, 0xec, 0x3c, 0x8f, 0x9d, 0xd8, 0xb9, 0xe9, 0x24, 0x35, 0xfd, 0x4a, 0xba, 0x7a, 0x46,
+	0x9d, 0x6e, 0x66, 0x12, 0xec, 0x91, 0x68, 0x35, 0xcd, 0x48, 0xc4, 0x9d, 0xcc, 0x4c, 0xa0, 0x67,
+	0x26, 0xaa, 0x84, 0x6e, 0x16, 0x40, 0xe9, 0xba, 0xea, 0xda, 0xae, 0x4e, 0xb9, 0x6e, 0xa9, 0xee,
+	0xb5, 0xa7, 0x83, 0x58, 0xb2, 0xe1, 0x21, 0x76, 0x2c, 0x60, 0x07, 0x62, 0xc5, 0x3f, 0xe0, 0xbd,
+	0x42, 0x62, 0xc9, 0x4b, 0x48, 0x48, 0x08, 0xa1, 0x5e, 0xf3, 0xde, 0xb2, 0x41, 0xf7, 0x51, 0x0f,
+	0x97, 0xed, 0xa4, 0x7b, 0x86, 0xcd, 0xac, 0xac, 0x7b, 0xce, 0x77, 0xce, 0x3d, 0xe7, 0xdc, 0xf3,
+	0x2a, 0xc3, 0x66, 0x14, 0x53, 0x4e, 0x77, 0xdb, 0x04, 0xbb, 0x34, 0xdc, 0x8d, 0x9a, 0xd1, 0xee,
+	0xb0, 0xb1, 0xcb, 0xcf, 0x22, 0xc2, 0x76, 0x24, 0x07, 0xad, 0x13, 0xde, 0x23, 0x31, 0x19, 0xf4,
+	0x77, 0x14, 0x66, 0x27, 0x6a, 0x46, 0x3b, 0xc3, 0xc6, 0xe5, 0x2b, 0x4a, 0xd0, 0xa5, 0xfd, 0x3e,
+	0x0d, 0x77, 0xfb, 0x84, 0x31, 0xdc, 0x4d, 0x84, 0xac, 0xff, 0x56, 0xa0, 0xd2, 0x92, 0xf0, 0x63,
+	0x8e, 0x39, 0x41, 0x47, 0x80, 0x86, 0x38, 0xf0, 0x3d, 0xcc, 0x69, 0xec, 0xc4, 0xa4, 0xeb, 0x33,
+	0x1e, 0x9f, 0x99, 0xc6, 0x56, 0x79, 0xbb, 0xd2, 0xbc, 0xb1, 0x33, 0xf9, 0x86, 0x9d, 0x47, 0x89,
+	0x84, 0xbd, 0x92, 0x0a, 0xdb, 0x5a, 0x16, 0x1d, 0xc0, 0xe6, 0xb8, 0x46, 0x67, 0x10, 0x79, 0x98,
+	0x13, 0x87, 0x44, 0xd4, 0xed, 0x99, 0xa5, 0x2d, 0x63, 0x7b, 0xc6, 0xbe, 0x3a, 0x26, 0xfb, 0x79,
+	0x09, 0x3a, 0x10, 0x18, 0xf4, 0x5a, 0xde, 0xb0, 0x36, 0x0e, 0x70, 0xe8, 0x12, 0x66, 0x96, 0xb7,
+	0xca, 0xdb, 0x33, 0xb9, 0x5b, 0x5b, 0x9a, 0x81, 0x76, 0x61, 0x35, 0xc0, 0x9c, 0x30, 0xee, 0xc4,
+	0x38, 0xf4, 0x30, 0x75, 0xfa, 0xfe, 0x53, 0xc2, 0xcc, 0xbf, 0xcd, 0x6f, 0x95, 0xb7, 0xab, 0xf6,
+	0x8a, 0xe2, 0xd9, 0x92, 0xf5, 0x8e, 0xe0, 0xa0, 0x7d, 0xb8, 0x1e, 0xc5, 0x64, 0xe8, 0xd3, 0x01,
+	0x73, 0x58, 0x6f, 0xd0, 0xe9, 0x04, 0x7e, 0xd8, 0x75, 0x18, 0xc7, 0x31, 0x77, 0x58, 0x0f, 0xc7,
+	0x9e, 0xf9, 0xf7, 0x79, 0x69, 0xe6, 0x95, 0x04, 0x76, 0x9c, 0xa0, 0x8e, 0x05, 0xe8, 0x58, 0x60,
+	0x50, 0x0b, 0xae, 0xb9, 0x83, 0x38, 0x26, 0x21, 0x9f, 0xa2, 0xe4, 0x1f, 0x4a, 0xc9, 0x65, 0x8d,
+	0x9a, 0xa4, 0xe3, 0x1e, 0x98, 0x13, 0x2c, 0x51, 0x91, 0xfa, 0xa7, 0x12, 0x5f, 0x1f, 0xb3, 0x41,
+	0x05, 0xe9, 0x2e, 0x6c, 0x8c, 0x5f, 0xaf, 0x24, 0xff, 0xa5, 0x24, 0xd7, 0x8a, 0x17, 0x2b, 0xc1,
+	0x29, 0xde, 0x13, 0xe2, 0x39, 0x3d, 0xcc, 0x7a, 0xaf, 0x37, 0xcd, 0x7f, 0x0b, 0xf9, 0xea, 0x24,
+	0xef, 0x09, 0xf1, 0xde, 0x96, 0x98, 0x29, 0xde, 0xe7, 0x94, 0xfc, 0x47, 0x29, 0x19, 0xf7, 0x3e,
+	0xd3, 0x91, 0xf7, 0xfe, 0xc9, 0x80, 0x71, 0xbf, 0xe3, 0x13, 0x4f, 0xfb, 0xf0, 0xdb, 0xda, 0xa8,
+	0xf7, 0x9f, 0x4d, 0xf8, 0xca, 0x89, 0x6d, 0xa8, 0x15, 0x25, 0x7e, 0xa7, 0x24, 0x96, 0x9f, 0x8c,
+	0x22, 0x3f, 0x09, 0xeb, 0x9a, 0xe2, 0x62, 0xee, 0xd3, 0xd0, 0x69, 0xfb, 0xbc, 0xe3, 0x93, 0xc0,
+	0x33, 0x7f, 0xaf, 0x04, 0xd6, 0x46, 0xd8, 0x2d, 0xcd, 0x15, 0x37, 0x74, 0xfc, 0x10, 0x07, 0xfe,
+	0x57, 0xd2, 0x1b, 0xfe, 0xa0, 0x6f, 0x48, 0xe9, 0xea, 0x86, 0xf7, 0x40, 0xe7, 0x98, 0xe3, 0xc6,
+	0x94, 0xb1, 0xc0, 0x0f, 0x4f, 0x99, 0xf9, 0xe3, 0x8d, 0xf3, 0xeb, 0xe8, 0x41, 0x02, 0xb5, 0xeb,
+	0x4a, 0x38, 0x25, 0x30, 0xf4, 0x29, 0x78, 0x49, 0x2b, 0x6c, 0x07, 0xd4, 0x3d, 0x75, 0x62, 0x4a,
+	0xb9, 0x0e, 0x2b, 0x33, 0x7f, 0xba, 0x21, 0xd3, 0x7a, 0x5d, 0x21, 0x5a, 0x02, 0x60, 0x53, 0xca,
+	0x55, 0x48, 0x19, 0xfa, 0x34, 0x5c, 0x6e, 0x63, 0xee, 0xf6, 0x88, 0x37, 0x49, 0xf8, 0x67, 0x4a,
+	0x78, 0x43, 0x43, 0xc6, 0xa4, 0xef, 0xc2, 0x86, 0xbe, 0x99, 0x05, 0x98, 0x49, 0x25, 0x49, 0xf9,
+	0xfd, 0x7c, 0x43, 0xd6, 0xdf, 0x9a, 0xe2, 0x1f, 0x2b, 0x76, 0x5a, 0x83, 0x5f, 0x4c, 0x6b, 0x10,
+	0x73, 0xf1, 0x23, 0x63, 0xc9, 0xcc, 0x5f, 0xa8, 0x28, 0xdc, 0x99, 0x16, 0x85, 0x23, 0x12, 0x7a,
+	0x7e, 0xd8, 0xdd, 0xcb, 0x64, 0x6c, 0xa4, 0xf4, 0xe4, 0x48, 0xf9, 0x80, 0xf8, 0xa1, 0x47, 0x9e,
+	0x8e, 0xfa, 0xf4, 0xcb, 0x91, 0x80, 0x1c, 0x0a, 0x40, 0xde, 0xa5, 0xcf, 0x81, 0x0e, 0xb0, 0x43,
+	0x78, 0xaf, 0xe1, 0x78, 0x98, 0x63, 0xf3, 0xfb, 0x9b, 0x5b, 0xc6, 0x76, 0xa5, 0xb9, 0x35, 0xcd,
+	0xac, 0x03, 0xde, 0x6b, 0xec, 0x63, 0x8e, 0xed, 0x65, 0x25, 0x9a, 0x9c, 0xd1, 0x3b, 0x50, 0x4b,
+	0xb5, 0x38, 0x43, 0xca, 0x09, 0x33, 0x7f, 0xb0, 0x29, 0x5d, 0x7c, 0xf9, 0x22, 0x5d, 0x8f, 0x28,
+	0x27, 0xf6, 0x12, 0xc9, 0x9d, 0x18, 0xb2, 0xa0, 0xda, 0x25, 0x21, 0x61, 0x3e, 0x73, 0xb8, 0xdf,
+	0x27, 0xe6, 0xd7, 0x6f, 0xc9, 0x04, 0xab, 0x68, 0xe2, 0x89, 0xdf, 0x27, 0xa8, 0x01, 0x33, 0x1d,
+	0x1a, 0x9f, 0x9a, 0xdf, 0xb8, 0x25, 0x6d, 0xbe, 0x3a, 0xed, 0x9e, 0x37, 0x69, 0x7c, 0x6a, 0x4b,
+	0x28, 0x5a, 0x85, 0x19, 0x16, 0x50, 0x6e, 0x7e, 0x53, 0xa9, 0x93, 0x07, 0x2b, 0x82, 0x19, 0x01,
+	0x41, 0xb7, 0xa1, 0x9e, 0x16, 0xdd, 0x90, 0xc4, 0xcc, 0xa7, 0xa1, 0x69, 0x48, 0x5c, 0x2d, 0xa1,
+	0x3f, 0x52, 0x64, 0x74, 0x0b, 0x6a, 0x49, 0x8d, 0x27, 0x48, 0xd5, 0xbe, 0x97, 0x35, 0x39, 0x01,
+	0x5e, 0x82, 0x59, 0x55, 0x21, 0x65, 0xc9, 0x56, 0x07, 0xeb, 0x8f, 0x06, 0xa0, 0xf1, 0x07, 0x46,
+	0xf7, 0x61, 0x46, 0x3e, 0x82, 0x21, 0xfd, 0xb9, 0x35, 0xcd, 0x9f, 0x9c, 0x88, 0x7c, 0x0a, 0x29,
+	0x84, 0x1a, 0x70, 0x09, 0x77, 0xbb, 0x31, 0xe9, 0x16, 0x6a, 0xb9, 0x24, 0x9b, 0xcd, 0x6a, 0x8e,
+	0x97, 0x16, 0xf2, 0x6d, 0xa8, 0xbb, 0x03, 0xc6, 0xa9, 0x77, 0x96, 0xc1, 0xcb, 0x12, 0x5e, 0xd3,
+	0xf4, 0x14, 0xfa, 0x0a, 0x2c, 0xfb, 0xa1, 0x1b, 0x0c, 0x84, 0x53, 0x8e, 0x0c, 0xe1, 0x8c, 0x74,
+	0x68, 0x29, 0xa5, 0x1e, 0x8b, 0x50, 0xfe, 0xc9, 0x80, 0xca, 0x47, 0xc4, 0xa3, 0x5d, 0x48, 0x35,
+	0x10, 0x87, 0xf9, 0xdd, 0x10, 0xf3, 0x41, 0x4c, 0xa4, 0x5b, 0x55, 0x1b, 0xa5, 0xac, 0xe3, 0x84,
+	0x63, 0xfd, 0xb0, 0x0c, 0xb5, 0x82, 0xa1, 0x08, 0xe9, 0x7c, 0x32, 0xb2, 0x74, 0x12, 0x4f, 0xae,
+	0xa6, 0x9c, 0xca, 0x08, 0x75, 0x40, 0x77, 0xc1, 0x54, 0x3e, 0x8f, 0x37, 0x1f, 0x6d, 0xe1, 0x9a,
+	0xe2, 0x17, 0x3a, 0x0f, 0xba, 0x0f, 0x97, 0x65, 0xd2, 0x38, 0x6d, 0x3a, 0x08, 0x3d, 0x1c, 0x9f,
+	0x8d, 0x88, 0x2a, 0x73, 0x37, 0x24, 0xa2, 0xa5, 0x01, 0xa3, 0xc2, 0x69, 0xe7, 0x55, 0xa5, 0x99,
+	0x17, 0x9e, 0x55, 0xc2, 0x29, 0x42, 0xc6, 0x3e, 0x13, 0x7e, 0x98, 0xf6, 0x87, 0x14, 0x61, 0xce,
+	0x6d, 0x19, 0xcf, 0xd7, 0xbb, 0x6b, 0x85, 0xde, 0x2d, 0x4a, 0xa6, 0x38, 0x97, 0xe6, 0x27, 0x8e,
+	0xa5, 0x37, 0xe0, 0x4a, 0x06, 0x1c, 0x0f, 0xd6, 0x82, 0x34, 0xda, 0x4c, 0x21, 0x85, 0x78, 0x59,
+	0x5f, 0x85, 0xab, 0x85, 0x57, 0xda, 0x0b, 0xbd, 0x07, 0xe9, 0xe3, 0x7f, 0xb8, 0x94, 0xdc, 0x84,
+	0x4a, 0x2e, 0xbf, 0xe4, 0x0b, 0x2f, 0xd8, 0x90, 0xa5, 0x96, 0xf5, 0x9d, 0x32, 0x2c, 0xa6, 0x8b,
+	0x20, 0x5a, 0x87, 0xb9, 0x68, 0xd0, 0x3e, 0x25, 0x67, 0xf2, 0xb6, 0xaa, 0xad, 0x4f, 0x62, 0x45,
+	0x78, 0xdf, 0xe7, 0x3d, 0x2f, 0xc6, 0xef, 0xe3, 0xc0, 0x71, 0x63, 0xe2, 0x91, 0x90, 0xfb, 0x38,
+	0x60, 0x89, 0x93, 0x2a, 0xc5, 0xaf, 0x64, 0xa0, 0x07, 0x19, 0x46, 0xbf, 0xce, 0x6d, 0xa8, 0x63,
+	0x97, 0xfb, 0x43, 0x55, 0x1c, 0x2a, 0xa0, 0xb3, 0xaa, 0x5b, 0x65, 0x74, 0x15, 0xd1, 0x6b, 0x00,
+	0xe4, 0xa9, 0xcf, 0x35, 0x68, 0x4e, 0x82, 0x16, 0x05, 0x45, 0xb1, 0x6f, 0x43, 0x3d, 0x67, 0x4d,
+	0xfe, 0x69, 0x6a, 0x19, 0x5d, 0x41, 0x6f, 0xc2, 0x52, 0x32, 0xfe, 0x14, 0x6e, 0x41, 0xe2, 0xaa,
+	0x9a, 0xa8, 0x40, 0x47, 0x50, 0x15, 0x91, 0x1b, 0x30, 0xa7, 0x13, 0xe0, 0x2e, 0x33, 0x17, 0xb7,
+	0x8c, 0xed, 0xe5, 0xe6, 0x6b, 0x17, 0xee, 0xcd, 0x3b, 0xc7, 0x52, 0xea, 0x4d, 0x21, 0x64, 0x57,
+	0x58, 0x76, 0xb0, 0x3e, 0x03, 0x95, 0x1c, 0x0f, 0x55, 0x60, 0xfe, 0xf0, 0xdd, 0xc3, 0x93, 0xc3,
+	0xbd, 0x87, 0xf5, 0x8f, 0x21, 0x04, 0xcb, 0xea, 0x70, 0x72, 0xb0, 0xef, 0x1c, 0x7c, 0xe1, 0xf0,
+	0xa4, 0x6e, 0xa0, 0x3a, 0x54, 0x1f, 0x1f, 0x9e, 0xbc, 0xbd, 0x6f, 0xef, 0x3d, 0xde, 0x6b, 0x3d,
+	0x3c, 0xa8, 0x97, 0xac, 0x00, 0x36, 0xe4, 0x5e, 0x69, 0x13, 0xcc, 0x44, 0xb1, 0xf7, 0x49, 0xc8,
+	0x6d, 0xe2, 0xd2, 0xd8, 0x13, 0x89, 0x99, 0xed, 0xd4, 0x72, 0x8a, 0xea, 0x72, 0x5e, 0x4e, 0xc9,
+	0x72, 0x74, 0x4e, 0x29, 0xec, 0xa4, 0x05, 0x94, 0x73, 0x13, 0xe5, 0xcb, 0xb0, 0x98, 0x25, 0x7e,
+	0x3a, 0x02, 0x8c, 0xdc, 0x08, 0xb8, 0xa0, 0x32, 0x4b, 0xe7, 0x56, 0xa6, 0xf5, 0x93, 0x52, 0xf2,
+	0xbd, 0x22, 0xb3, 0x7f, 0x62, 0x1b, 0x7a, 0x15, 0x50, 0x84, 0xe5, 0x84, 0x1a, 0x57, 0x5c, 0x57,
+	0x9c, 0x5c, 0xad, 0xdf, 0x81, 0x15, 0x11, 0x70, 0x32, 0xa1, 0x2f, 0xd5, 0x24, 0x23, 0x87, 0xbd,
+	0x09, 0x4b, 0xfa, 0x73, 0x22, 0x26, 0x43, 0x82, 0x03, 0xdd, 0x84, 0xaa, 0x8a, 0x68, 0x4b, 0x1a,
+	0x7a, 0x03, 0x16, 0xb3, 0xad, 0x62, 0xf6, 0x39, 0x97, 0x8a, 0x85, 0x64, 0x09, 0x10, 0x55, 0xda,
+	0xa6, 0xde, 0x99, 0x39, 0x7f, 0x7e, 0x95, 0xe6, 0x82, 0xd0, 0xa2, 0xde, 0x99, 0x2d, 0x85, 0xd0,
+	0x55, 0x58, 0xcc, 0x1a, 0xfa, 0x9c, 0x34, 0x2e, 0x23, 0x58, 0xdf, 0x2d, 0x43, 0xad, 0x20, 0x87,
+	0xde, 0x82, 0xea, 0xc8, 0x76, 0xa6, 0x3e, 0xf5, 0x6e, 0x3e, 0x47, 0x73, 0xb0, 0x47, 0x04, 0xd1,
+	0x63, 0x40, 0x51, 0x4c, 0x23, 0xca, 0x48, 0xac, 0x16, 0x45, 0x3f, 0xec, 0x32, 0xb3, 0x24, 0xd5,
+	0x6d, 0x4f, 0xdd, 0xf5, 0xb4, 0xc4, 0xb1, 0x16, 0xb0, 0x57, 0xa2, 0x02, 0x45, 0x2a, 0x56, 0x17,
+	0x8d, 0x28, 0x2e, 0x9f, 0xaf, 0x78, 0x4f, 0x4b, 0x64, 0x8a, 0x71, 0x81, 0xc2, 0xd0, 0x7d, 0x58,
+	0xf0, 0x48, 0x44, 0x99, 0xcf, 0x99, 0x39, 0x23, 0xd5, 0x6d, 0x4e, 0x53, 0xb7, 0xaf, 0x70, 0x76,
+	0x2a, 0x80, 0xde, 0x85, 0xda, 0x90, 0x06, 0x83, 0x90, 0x8b, 0xb9, 0x24, 0x3a, 0x0a, 0x33, 0x67,
+	0xa5, 0x8e, 0x57, 0xa6, 0x56, 0x7b, 0x02, 0x3f, 0x78, 0xea, 0x73, 0x7b, 0x79, 0x98, 0x3f, 0x32,
+	0xeb, 0x7b, 0x06, 0x54, 0xf5, 0x2d, 0x87, 0x61, 0x34, 0xe0, 0x1f, 0xbc, 0x83, 0x96, 0x2f, 0xee,
+	0xa0, 0x3b, 0xb0, 0x1a, 0xc5, 0x94, 0x76, 0x1c, 0xda, 0x71, 0x22, 0xca, 0x18, 0x61, 0xe9, 0x22,
+	0x57, 0x95, 0x4f, 0x40, 0x3b, 0xef, 0x75, 0x8e, 0x52, 0x86, 0xf5, 0x04, 0x90, 0x7a, 0x29, 0x1c,
+	0x88, 0xad, 0x80, 0x78, 0x2f, 0xb8, 0x02, 0xdc, 0x81, 0x95, 0x69, 0xb3, 0xbf, 0xd6, 0x2e, 0x4c,
+	0xb1, 0x3f, 0x1b, 0x70, 0x49, 0xbe, 0x11, 0x6e, 0x07, 0x24, 0xbf, 0x51, 0x7d, 0x1c, 0x56, 0x46,
+	0xba, 0x95, 0xef, 0x12, 0x95, 0xae, 0x33, 0x76, 0x3d, 0xdf, 0xaf, 0x04, 0x7d, 0xe2, 0x3a, 0x54,
+	0x9a, 0xbc, 0x0e, 0x25, 0x63, 0xb1, 0xfc, 0x41, 0xc6, 0xe2, 0x0b, 0xef, 0x52, 0xdf, 0x36, 0xa0,
+	0xa2, 0xdf, 0x59, 0x06, 0xf1, 0x10, 0x96, 0x74, 0x4e, 0x39, 0xbe, 0x78, 0x77, 0x3d, 0x9d, 0x5f,
+	0xbe, 0x20, 0x13, 0x65, 0x8e, 0xd8, 0x55, 0xaf, 0x90, 0x31, 0xb8, 0x4f, 0x07, 0x21, 0xd7, 0xc1,
+	0xd7, 0x27, 0xd1, 0x14, 0xc4, 0x97, 0x04, 0xe3, 0xb8, 0x1f, 0xe9, 0x66, 0x9d, 0x11, 0xac, 0x5f,
+	0x95, 0xa0, 0x5e, 0x2c, 0x43, 0xb1, 0xf4, 0xa6, 0xc5, 0x9c, 0x1f, 0x0c, 0x4b, 0x09, 0x55, 0xcd,
+	0x05, 0x1b, 0x6a, 0x91, 0xce, 0x0b, 0xd5, 0xc9, 0x1b, 0xf2, 0xea, 0xf3, 0x3e, 0xee, 0xc6, 0xd2,
+	0x28, 0xd1, 0x89, 0x03, 0x71, 0x6a, 0xa0, 0x4f, 0xc0, 0xa5, 0x54, 0x67, 0x1a, 0x50, 0xa7, 0xa1,
+	0xd3, 0x05, 0x45, 0x39, 0x05, 0x92, 0xd5, 0x18, 0xb7, 0x42, 0x2d, 0x87, 0x1f, 0xc2, 0x8a, 0xe6,
+	0x14, 0x2b, 0x92, 0xc5, 0x71, 0xdc, 0x8a, 0xa6, 0xf5, 0x17, 0x03, 0xea, 0xc5, 0xae, 0x83, 0x3c,
+	0xd8, 0x60, 0x49, 0x2e, 0xe7, 0xbf, 0x82, 0x9d, 0x86, 0x7e, 0xe7, 0x57, 0xa7, 0x99, 0x38, 0xa9,
+	0x04, 0xec, 0x35, 0x36, 0x81, 0xda, 0x98, 0x7e, 0x4b, 0xd3, 0x2c, 0xfd, 0xbf, 0x6e, 0x69, 0x5a,
+	0xdf, 0x32, 0x60, 0x5e, 0x67, 0x1f, 0x6a, 0xc2, 0x5a, 0x9f, 0xc4, 0xa7, 0x01, 0x71, 0xda, 0x31,
+	0x0e, 0xdd, 0x5e, 0xfa, 0xe1, 0x6d, 0xc8, 0xef, 0xee, 0x55, 0xc5, 0x6c, 0x49, 0x5e, 0xf2, 0xd1,
+	0x7d, 0x07, 0x56, 0xb4, 0x0c, 0x8f, 0x09, 0xd1, 0x69, 0xa5, 0x32, 0xb5, 0xa6, 0x18, 0x27, 0x31,
+	0x21, 0x2a, 0xb1, 0x6e, 0x40, 0x92, 0xda, 0x4e, 0x5a, 0x9b, 0x55, 0xbb, 0xe2, 0x65, 0x85, 0x63,
+	0x05, 0xb0, 0x34, 0xd2, 0x51, 0xa7, 0x6c, 0x1b, 0x13, 0x76, 0x9c, 0xd2, 0xc4, 0x1d, 0x67, 0x64,
+	0x74, 0x96, 0x8b, 0xa3, 0xf3, 0x47, 0x06, 0xac, 0xec, 0x25, 0xd5, 0xbc, 0x17, 0x7a, 0x47, 0xa2,
+	0x47, 0xca, 0x4d, 0x54, 0x13, 0x0b, 0x1b, 0x54, 0x2d, 0xa3, 0x2b, 0xf5, 0x7b, 0xb0, 0x98, 0x90,
+	0x88, 0x7e, 0x95, 0xe7, 0x1a, 0xb2, 0x99, 0x94, 0x70, 0x85, 0x91, 0x80, 0xb8, 0xf2, 0x69, 0x65,
+	0x93, 0xd6, 0x76, 0x2e, 0xa7, 0x64, 0x69, 0x96, 0xf5, 0x25, 0x58, 0x48, 0xff, 0x9d, 0xd8, 0x81,
+	0xd5, 0x24, 0x92, 0xf9, 0xe6, 0xab, 0x66, 0xca, 0x8a, 0x66, 0xe5, 0x56, 0x9c, 0x1b, 0x50, 0x55,
+	0xad, 0x7a, 0x64, 0x6d, 0xaa, 0x48, 0x9a, 0xee, 0xd0, 0x01, 0x54, 0xf3, 0x7f, 0x60, 0x8c, 0x2e,
+	0x3c, 0xc6, 0x0b, 0x2f, 0x3c, 0xd7, 0x00, 0xc4, 0xbf, 0x26, 0x8e, 0x9b, 0x6b, 0x5d, 0x8b, 0x82,
+	0xf2, 0x40, 0x10, 0xac, 0xaf, 0x19, 0x50, 0x7d, 0x4b, 0xfd, 0xf7, 0xa1, 0xfe, 0xa2, 0xbe, 0x07,
+	0xb3, 0x72, 0x2f, 0x33, 0x8d, 0xf3, 0xa3, 0x98, 0xfb, 0x5b, 0xdb, 0x56, 0x12, 0xe8, 0x1e, 0xbc,
+	0x94, 0xfc, 0xb7, 0x32, 0x3e, 0x8f, 0x94, 0xa7, 0xeb, 0x1a, 0x50, 0xf8, 0xb8, 0x6a, 0x55, 0x7f,
+	0xfd, 0xec, 0xba, 0xf1, 0x9b, 0x67, 0xd7, 0x8d, 0xbf, 0x3e, 0xbb, 0x6e, 0xb4, 0xe7, 0xe4, 0xbf,
+	0xe7, 0xaf, 0xff, 0x6f, 0x00, 0xe4, 0x87, 0x55, 0xa3, 0x95, 0x17, 0x00, 0x00,
 }
 
 func (m *BeaconState) Marshal() (dAtA []byte, err error) {
@@ -2985,6 +3110,48 @@ func (m *VoluntaryExit) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *AggregateAndProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregateAndProof) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.AggregatorIndex != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.AggregatorIndex))
+	}
+	if m.Aggregate != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Aggregate.Size()))
+		n21, err := m.Aggregate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
+	}
+	if len(m.SelectionProof) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SelectionProof)))
+		i += copy(dAtA[i:], m.SelectionProof)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *Eth1Data) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3037,11 +3204,11 @@ func (m *Eth1DataVote) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(m.Eth1Data.Size()))
-		n21, err := m.Eth1Data.MarshalTo(dAtA[i:])
+		n22, err := m.Eth1Data.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
+		i += n22
 	}
 	if m.VoteCount != 0 {
 		dAtA[i] = 0x10
@@ -3054,6 +3221,43 @@ func (m *Eth1DataVote) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.State != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.State.Size()))
+		n23, err := m.State.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
+	}
+	if len(m.GenesisBlockRootHash32) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.GenesisBlockRootHash32)))
+		i += copy(dAtA[i:], m.GenesisBlockRootHash32)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -3675,6 +3879,29 @@ func (m *VoluntaryExit) Size() (n int) {
 	return n
 }
 
+func (m *AggregateAndProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AggregatorIndex != 0 {
+		n += 1 + sovTypes(uint64(m.AggregatorIndex))
+	}
+	if m.Aggregate != nil {
+		l = m.Aggregate.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.SelectionProof)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *Eth1Data) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3714,6 +3941,26 @@ func (m *Eth1DataVote) Size() (n int) {
 	return n
 }
 
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.GenesisBlockRootHash32)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	for {
 		n++
@@ -7607,6 +7854,149 @@ func (m *VoluntaryExit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AggregateAndProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateAndProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateAndProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatorIndex", wireType)
+			}
+			m.AggregatorIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AggregatorIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Aggregate == nil {
+				m.Aggregate = &Attestation{}
+			}
+			if err := m.Aggregate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectionProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SelectionProof = append(m.SelectionProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.SelectionProof == nil {
+				m.SelectionProof = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Eth1Data) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7838,6 +8228,130 @@ func (m *Eth1DataVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.State == nil {
+				m.State = &BeaconState{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisBlockRootHash32", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisBlockRootHash32 = append(m.GenesisBlockRootHash32[:0], dAtA[iNdEx:postIndex]...)
+			if m.GenesisBlockRootHash32 == nil {
+				m.GenesisBlockRootHash32 = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -67,6 +67,45 @@ func FillBitfield(count int) []byte {
 	return bitfield
 }
 
+// Or returns the bitfield of the bits set in either of the bitfields. The result is
+// as long as the longer of the two.
+func Or(a []byte, b []byte) []byte {
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+	result := make([]byte, len(a))
+	copy(result, a)
+	for i := range b {
+		result[i] |= b[i]
+	}
+	return result
+}
+
+// Overlaps returns true if a bit is set in both of the bitfields.
+func Overlaps(a []byte, b []byte) bool {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i]&b[i] != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Covers returns true if every bit set in the inner bitfield is also set in the
+// outer one.
+func Covers(outer []byte, inner []byte) bool {
+	for i := range inner {
+		var o byte
+		if i < len(outer) {
+			o = outer[i]
+		}
+		if inner[i]&^o != 0 {
+			return false
+		}
+	}
+	return true
+}
+
 func fillNBits(numBits uint64) byte {
 	result := byte(0)
 	for i := uint64(0); i < numBits; i++ {
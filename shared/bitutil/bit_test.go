@@ -85,3 +85,31 @@ func TestBitSet(t *testing.T) {
 		}
 	}
 }
+
+func TestOrOverlapsCovers(t *testing.T) {
+	tests := []struct {
+		a        []byte
+		b        []byte
+		or       []byte
+		overlaps bool
+		covers   bool
+	}{
+		{a: []byte{128}, b: []byte{64}, or: []byte{192}, overlaps: false, covers: false},
+		{a: []byte{192}, b: []byte{64}, or: []byte{192}, overlaps: true, covers: true},
+		{a: []byte{128}, b: []byte{0, 32}, or: []byte{128, 32}, overlaps: false, covers: false},
+		{a: []byte{128, 32}, b: []byte{0, 32}, or: []byte{128, 32}, overlaps: true, covers: true},
+		{a: []byte{0, 32}, b: []byte{128}, or: []byte{128, 32}, overlaps: false, covers: false},
+		{a: []byte{64}, b: []byte{0, 0}, or: []byte{64, 0}, overlaps: false, covers: true},
+	}
+	for _, tt := range tests {
+		if or := Or(tt.a, tt.b); !bytes.Equal(or, tt.or) {
+			t.Errorf("Or(%v, %v) = %v, want = %v", tt.a, tt.b, or, tt.or)
+		}
+		if Overlaps(tt.a, tt.b) != tt.overlaps {
+			t.Errorf("Overlaps(%v, %v) = %v, want = %v", tt.a, tt.b, !tt.overlaps, tt.overlaps)
+		}
+		if Covers(tt.a, tt.b) != tt.covers {
+			t.Errorf("Covers(%v, %v) = %v, want = %v", tt.a, tt.b, !tt.covers, tt.covers)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package bls
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+)
+
+// backend implements the BLS signature scheme which the package API sits on.
+// Public keys are points of G2 and signatures points of G1, serialized in
+// the compressed form of the zcash library, and messages are hashed to G1
+// together with their domain. Every backend must produce the same encodings,
+// hash messages to the same points and accept the same signatures, which the
+// conformance tests check for each pair of backends.
+type backend interface {
+	name() string
+	randKey(r io.Reader) (secretKey, error)
+	secretKeyFromBytes(b [32]byte) (secretKey, error)
+	publicKeyFromBytes(b [96]byte) (publicKey, error)
+	signatureFromBytes(b [48]byte) (signature, error)
+	aggregatePublicKeys(pubs []publicKey) publicKey
+	aggregateSignatures(sigs []signature) signature
+	// verify checks a signature of msg by pub.
+	verify(sig signature, msg []byte, pub publicKey, domain uint64) bool
+	// verifyAggregate checks an aggregate signature where pubs[i] signed
+	// msgs[i], and the messages are distinct.
+	verifyAggregate(sig signature, pubs []publicKey, msgs [][]byte, domain uint64) bool
+}
+
+type secretKey interface {
+	publicKey() publicKey
+	sign(msg []byte, domain uint64) signature
+	marshal() [32]byte
+}
+
+type publicKey interface {
+	marshal() [96]byte
+}
+
+type signature interface {
+	marshal() [48]byte
+}
+
+var (
+	backendLock sync.RWMutex
+	backends    = map[string]backend{
+		phoreBackendName: phoreBackend{},
+		kilicBackendName: kilicBackend{},
+	}
+	// activeBackend is the default backend until SetBackend is called. The
+	// default is set at build time, see default_backend.go.
+	activeBackend = backends[defaultBackendName]
+)
+
+// SetBackend selects the implementation of the BLS signature scheme used by
+// keys and signatures created afterwards, out of the names returned by
+// Backends. Keys and signatures created by another backend are converted
+// through their encoding when they are used together with the new ones.
+func SetBackend(name string) error {
+	backendLock.Lock()
+	defer backendLock.Unlock()
+	b, ok := backends[name]
+	if !ok {
+		return fmt.Errorf("unknown BLS backend %q, expected one of %v", name, backendNames())
+	}
+	activeBackend = b
+	return nil
+}
+
+// BackendName returns the name of the selected BLS backend.
+func BackendName() string {
+	return currentBackend().name()
+}
+
+// Backends returns the names of the available BLS backends in sorted order.
+func Backends() []string {
+	backendLock.RLock()
+	defer backendLock.RUnlock()
+	return backendNames()
+}
+
+func backendNames() []string {
+	names := make([]string, 0, len(backends))
+	for name := range backends {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func currentBackend() backend {
+	backendLock.RLock()
+	defer backendLock.RUnlock()
+	return activeBackend
+}
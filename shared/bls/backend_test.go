@@ -0,0 +1,384 @@
+package bls
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"testing"
+)
+
+// conformanceMessages are the messages and domains every backend signs in the
+// conformance tests.
+var conformanceMessages = []struct {
+	msg    []byte
+	domain uint64
+}{
+	{msg: []byte{}, domain: 0},
+	{msg: []byte("hello"), domain: 0},
+	{msg: []byte("hello"), domain: 1},
+	{msg: bytes.Repeat([]byte{0xff}, 32), domain: 1<<64 - 1},
+}
+
+// knownPublicKeys are the encodings of the public keys of conformanceKey 1, 2
+// and 1000. The public key of the secret key 1 is the generator of G2.
+var knownPublicKeys = map[int]string{
+	1:    "93e02b6052719f607dacd3a088274f65596bd0d09920b61ab5da61bbdc7f5049334cf11213945d57e5ac7d055d042b7e024aa2b2f08f0a91260805272dc51051c6e47ad4fa403b02b4510b647ae3d1770bac0326a805bbefd48056c8c121bdb8",
+	2:    "aa4edef9c1ed7f729f520e47730a124fd70662a904ba1074728114d1031e1572c6c886f6b57ec72a6178288c47c335771638533957d540a9d2370f17cc7ed5863bc0b995b8825e0ee1ea1e1e4d00dbae81f14b0bf3611b78c952aacab827a053",
+	1000: "b0503a418b31b822166f675dbb934006879780fa32e949aeee28bbc3760fe67f824053c0d3e72149d7a9227e3bed23790226d6e0410f078ec4379ad5b8ae61a9d191e74668b2bf2cd629e97493eaf9c4e4930ead7af1191bbb41bceaef3df685",
+}
+
+// knownSignatures are, for each of the conformanceMessages, the encoding of the
+// point of G1 it hashes to, which is its signature with the secret key 1, and
+// of its signature with the secret key 1000.
+var knownSignatures = []struct {
+	hash string
+	sig  string
+}{
+	{
+		hash: "b63c3d1fedd79762769a7fffb1a76277fdf0a43cdd0554167fe1ce8e9813d872a4bbcd80daef63131506ff3cd0969527",
+		sig:  "b2710a066038c96f762955786759297ae9d4daa29332f3daaa66cc07e85ede5b48dd46b829bab1062f19184f3885b9de",
+	},
+	{
+		hash: "ac6e02c1aac50efb5dcc172172cd2ed359130f1395dea69569b757124e77429943fdf41d4b7ceea5dcbc584d849127e2",
+		sig:  "9022a7ff4a24c17a99eb3b2c9b0760698a26213bbba19cab33480f4364c1d85a5d2bf51fea43f557426b6ae8c33b48be",
+	},
+	{
+		hash: "919732fae70df19b6eff6193bc890067e79081a319903111bcd469b353adf3dc61468eb97d2de42ef4e910abc5120d13",
+		sig:  "a5369b8026ea6ac4298f7b063da77ffa33dc22117de397408f45f20aa5d88a18350450dbe72724b182ebe832db40efab",
+	},
+	{
+		hash: "a8d81899b4b83b0c6541a443df54f0f5d99b33f96a7e5aba158639560f383e7d9e6cf7820fa78aaa26612e2bba31311e",
+		sig:  "8d1f6c16620edd8f627f1e34a726127a8be942e26bce7637f31d70ef2c0267bffebdb1e243b3758641dc8f97bfe7e83e",
+	},
+}
+
+// conformanceKey returns the secret key with value i.
+func conformanceKey(t testing.TB, b backend, i int) secretKey {
+	var enc [32]byte
+	enc[30], enc[31] = byte(i>>8), byte(i)
+	k, err := b.secretKeyFromBytes(enc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return k
+}
+
+func testBackends(t *testing.T, f func(t *testing.T, b backend)) {
+	for _, name := range Backends() {
+		b := backends[name]
+		t.Run(name, func(t *testing.T) {
+			f(t, b)
+		})
+	}
+}
+
+func TestBackends_Encoding(t *testing.T) {
+	testBackends(t, func(t *testing.T, b backend) {
+		for _, i := range []int{1, 2, 3, 1000} {
+			k := conformanceKey(t, b, i)
+			if enc := k.marshal(); enc[31] != byte(i) || enc[30] != byte(i>>8) {
+				t.Errorf("Secret key %d is encoded as %#x", i, enc)
+			}
+			pub := k.publicKey().marshal()
+			decodedPub, err := b.publicKeyFromBytes(pub)
+			if err != nil {
+				t.Fatalf("Could not decode public key %d: %v", i, err)
+			}
+			if decodedPub.marshal() != pub {
+				t.Errorf("Public key %d changed after decoding", i)
+			}
+			sig := k.sign([]byte("hello"), 0).marshal()
+			decodedSig, err := b.signatureFromBytes(sig)
+			if err != nil {
+				t.Fatalf("Could not decode signature of key %d: %v", i, err)
+			}
+			if decodedSig.marshal() != sig {
+				t.Errorf("Signature of key %d changed after decoding", i)
+			}
+		}
+	})
+}
+
+func TestBackends_HashToCurve(t *testing.T) {
+	testBackends(t, func(t *testing.T, b backend) {
+		for _, m := range conformanceMessages {
+			// The signature of the secret key 1 is the point the message
+			// hashes to, so the signature of the key 3 is three times that
+			// point.
+			h := conformanceKey(t, b, 1).sign(m.msg, m.domain)
+			three := b.aggregateSignatures([]signature{h, h, h})
+			if got := conformanceKey(t, b, 3).sign(m.msg, m.domain); got.marshal() != three.marshal() {
+				t.Errorf("Signature of %q with key 3 is %#x, expected %#x", m.msg, got.marshal(), three.marshal())
+			}
+			for _, other := range conformanceMessages {
+				if (!bytes.Equal(other.msg, m.msg) || other.domain != m.domain) &&
+					conformanceKey(t, b, 1).sign(other.msg, other.domain).marshal() == h.marshal() {
+					t.Errorf("%q in domain %d and %q in domain %d hash to the same point", m.msg, m.domain, other.msg, other.domain)
+				}
+			}
+		}
+	})
+}
+
+func TestBackends_Verify(t *testing.T) {
+	testBackends(t, func(t *testing.T, b backend) {
+		k, err := b.randKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		other := conformanceKey(t, b, 7)
+		for _, m := range conformanceMessages {
+			sig := k.sign(m.msg, m.domain)
+			if !b.verify(sig, m.msg, k.publicKey(), m.domain) {
+				t.Errorf("Signature of %q in domain %d did not verify", m.msg, m.domain)
+			}
+			if b.verify(sig, append(m.msg, 0), k.publicKey(), m.domain) {
+				t.Errorf("Signature of %q verified for another message", m.msg)
+			}
+			if b.verify(sig, m.msg, k.publicKey(), m.domain^1) {
+				t.Errorf("Signature of %q verified for another domain", m.msg)
+			}
+			if b.verify(sig, m.msg, other.publicKey(), m.domain) {
+				t.Errorf("Signature of %q verified for another key", m.msg)
+			}
+		}
+	})
+}
+
+func TestBackends_VerifyAggregate(t *testing.T) {
+	testBackends(t, func(t *testing.T, b backend) {
+		var pubs []publicKey
+		var sigs []signature
+		var msgs [][]byte
+		for i := 1; i <= 4; i++ {
+			k := conformanceKey(t, b, i)
+			msg := []byte(fmt.Sprintf("message %d", i))
+			pubs = append(pubs, k.publicKey())
+			sigs = append(sigs, k.sign(msg, 5))
+			msgs = append(msgs, msg)
+		}
+		agg := b.aggregateSignatures(sigs)
+		if !b.verifyAggregate(agg, pubs, msgs, 5) {
+			t.Error("Aggregate signature over distinct messages did not verify")
+		}
+		if b.verifyAggregate(agg, pubs[:3], msgs[:3], 5) {
+			t.Error("Aggregate signature verified without one of its signers")
+		}
+		msgs[0], msgs[1] = msgs[1], msgs[0]
+		if b.verifyAggregate(agg, pubs, msgs, 5) {
+			t.Error("Aggregate signature verified with swapped messages")
+		}
+
+		// Signatures of the same message verify against the aggregate of
+		// the public keys.
+		sigs = sigs[:0]
+		for i := 1; i <= 4; i++ {
+			sigs = append(sigs, conformanceKey(t, b, i).sign([]byte("hello"), 5))
+		}
+		if !b.verify(b.aggregateSignatures(sigs), []byte("hello"), b.aggregatePublicKeys(pubs), 5) {
+			t.Error("Aggregate signature over a single message did not verify")
+		}
+	})
+}
+
+// TestBackends_KnownAnswers checks the public keys, hashes to G1 and signatures
+// of every backend against fixed encodings, so that a change of the output of
+// any backend, including the default one, is caught.
+func TestBackends_KnownAnswers(t *testing.T) {
+	if len(knownSignatures) != len(conformanceMessages) {
+		t.Fatalf("Expected %d known signatures, got %d", len(conformanceMessages), len(knownSignatures))
+	}
+	testBackends(t, func(t *testing.T, b backend) {
+		for i, want := range knownPublicKeys {
+			pub := conformanceKey(t, b, i).publicKey().marshal()
+			if got := hex.EncodeToString(pub[:]); got != want {
+				t.Errorf("Public key of secret key %d is %s, expected %s", i, got, want)
+			}
+		}
+		for i, m := range conformanceMessages {
+			hash := conformanceKey(t, b, 1).sign(m.msg, m.domain).marshal()
+			if got := hex.EncodeToString(hash[:]); got != knownSignatures[i].hash {
+				t.Errorf("%q in domain %d hashes to %s, expected %s", m.msg, m.domain, got, knownSignatures[i].hash)
+			}
+			sig := conformanceKey(t, b, 1000).sign(m.msg, m.domain).marshal()
+			if got := hex.EncodeToString(sig[:]); got != knownSignatures[i].sig {
+				t.Errorf("Signature of %q in domain %d is %s, expected %s", m.msg, m.domain, got, knownSignatures[i].sig)
+			}
+		}
+	})
+}
+
+// TestBackends_Agree checks that each backend encodes keys and signatures,
+// and hashes messages, like the current default backend, and accepts its
+// signatures.
+func TestBackends_Agree(t *testing.T) {
+	reference := backends[defaultBackendName]
+	for _, name := range Backends() {
+		b := backends[name]
+		if b == reference {
+			continue
+		}
+		t.Run(name, func(t *testing.T) {
+			for _, i := range []int{1, 2, 1000} {
+				want := conformanceKey(t, reference, i)
+				got := conformanceKey(t, b, i)
+				if got.publicKey().marshal() != want.publicKey().marshal() {
+					t.Errorf("Public key of secret key %d is %#x, expected %#x", i, got.publicKey().marshal(), want.publicKey().marshal())
+				}
+				for _, m := range conformanceMessages {
+					wantSig := want.sign(m.msg, m.domain).marshal()
+					if gotSig := got.sign(m.msg, m.domain).marshal(); gotSig != wantSig {
+						t.Errorf("Signature of %q in domain %d with key %d is %#x, expected %#x", m.msg, m.domain, i, gotSig, wantSig)
+					}
+					sig, err := b.signatureFromBytes(wantSig)
+					if err != nil {
+						t.Fatalf("Could not decode signature: %v", err)
+					}
+					pub, err := b.publicKeyFromBytes(want.publicKey().marshal())
+					if err != nil {
+						t.Fatalf("Could not decode public key: %v", err)
+					}
+					if !b.verify(sig, m.msg, pub, m.domain) {
+						t.Errorf("Signature of %q in domain %d with key %d did not verify", m.msg, m.domain, i)
+					}
+				}
+			}
+		})
+	}
+}
+
+// Encodings of points on the curves which are not in the subgroups of order
+// r: the point of E1 with x = 0 and the point of E2 with x = 2.
+const (
+	nonSubgroupG1 = "800000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"
+	nonSubgroupG2 = "800000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002"
+)
+
+// TestBackends_RejectNonSubgroupPoints checks that no backend decodes a
+// signature or public key outside the prime order subgroup, which would let
+// small subgroup points into aggregates.
+func TestBackends_RejectNonSubgroupPoints(t *testing.T) {
+	var sig [48]byte
+	var pub [96]byte
+	if _, err := hex.Decode(sig[:], []byte(nonSubgroupG1)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := hex.Decode(pub[:], []byte(nonSubgroupG2)); err != nil {
+		t.Fatal(err)
+	}
+	testBackends(t, func(t *testing.T, b backend) {
+		if _, err := b.signatureFromBytes(sig); err == nil {
+			t.Error("Expected an error for a signature outside the G1 subgroup")
+		}
+		if _, err := b.publicKeyFromBytes(pub); err == nil {
+			t.Error("Expected an error for a public key outside the G2 subgroup")
+		}
+	})
+}
+
+// rawSignature and rawPublicKey stand for values of another backend which
+// only have an encoding.
+type rawSignature [48]byte
+
+func (s rawSignature) marshal() [48]byte { return s }
+
+type rawPublicKey [96]byte
+
+func (p rawPublicKey) marshal() [96]byte { return p }
+
+func TestAggregate_UnconvertibleInputs(t *testing.T) {
+	b := backends[kilicBackendName]
+	other := backends[phoreBackendName]
+	k := conformanceKey(t, b, 1)
+
+	var badSig rawSignature
+	if _, err := hex.Decode(badSig[:], []byte(nonSubgroupG1)); err != nil {
+		t.Fatal(err)
+	}
+	sigs := []*Signature{
+		{b: b, val: k.sign([]byte("hello"), 0)},
+		{b: other, val: badSig},
+	}
+	if _, err := AggregateSignatures(sigs); err == nil {
+		t.Error("Expected an error aggregating a signature which can't be converted")
+	}
+
+	var badPub rawPublicKey
+	if _, err := hex.Decode(badPub[:], []byte(nonSubgroupG2)); err != nil {
+		t.Fatal(err)
+	}
+	pub := &PublicKey{b: b, val: k.publicKey()}
+	if _, err := pub.Aggregate(&PublicKey{b: other, val: badPub}); err == nil {
+		t.Error("Expected an error aggregating a public key which can't be converted")
+	}
+}
+
+func TestSetBackend(t *testing.T) {
+	defer func() {
+		if err := SetBackend(defaultBackendName); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	if err := SetBackend("missing"); err == nil {
+		t.Error("Expected an error for an unknown backend")
+	}
+	priv, err := RandKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig := priv.Sign([]byte("hello"), 0)
+	for _, name := range Backends() {
+		if err := SetBackend(name); err != nil {
+			t.Fatal(err)
+		}
+		if BackendName() != name {
+			t.Errorf("Backend is %s, expected %s", BackendName(), name)
+		}
+		// Public keys created by the new backend verify signatures created
+		// by the previous one.
+		pub, err := PublicKeyFromBytes(priv.PublicKey().Marshal())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !sig.Verify([]byte("hello"), pub, 0) {
+			t.Errorf("Signature did not verify after switching to the %s backend", name)
+		}
+	}
+}
+
+func BenchmarkBackends(b *testing.B) {
+	msg := []byte("hello")
+	for _, name := range Backends() {
+		backend := backends[name]
+		k := conformanceKey(b, backend, 12345)
+		pub := k.publicKey()
+		sig := k.sign(msg, 0)
+		b.Run(name+"/Sign", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				k.sign(msg, 0)
+			}
+		})
+		b.Run(name+"/Verify", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				backend.verify(sig, msg, pub, 0)
+			}
+		})
+		b.Run(name+"/DecodeSignature", func(b *testing.B) {
+			enc := sig.marshal()
+			for i := 0; i < b.N; i++ {
+				if _, err := backend.signatureFromBytes(enc); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(name+"/DecodePublicKey", func(b *testing.B) {
+			enc := pub.marshal()
+			for i := 0; i < b.N; i++ {
+				if _, err := backend.publicKeyFromBytes(enc); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
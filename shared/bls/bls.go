@@ -1,38 +1,44 @@
 // Package bls implements a go-wrapper around a library implementing the
 // the BLS12-381 curve and signature scheme. This package exposes a public API for
 // verifying and aggregating BLS signatures used by Ethereum 2.0.
+//
+// The scheme is implemented by one of several backends, selected at build
+// time with the bls_kilic tag, or at run time with SetBackend.
 package bls
 
 import (
 	"fmt"
 	"io"
 
-	gobls "github.com/phoreproject/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 )
 
 // Signature used in the BLS signature scheme.
 type Signature struct {
-	val *gobls.Signature
+	b   backend
+	val signature
 }
 
 // SecretKey used in the BLS signature scheme.
 type SecretKey struct {
-	val *gobls.SecretKey
+	b   backend
+	val secretKey
 }
 
 // PublicKey used in the BLS signature scheme.
 type PublicKey struct {
-	val *gobls.PublicKey
+	b   backend
+	val publicKey
 }
 
 // RandKey creates a new private key using a random method provided as an io.Reader.
 func RandKey(r io.Reader) (*SecretKey, error) {
-	k, err := gobls.RandKey(r)
+	b := currentBackend()
+	k, err := b.randKey(r)
 	if err != nil {
 		return nil, fmt.Errorf("could not initialize secret key: %v", err)
 	}
-	return &SecretKey{val: k}, nil
+	return &SecretKey{b: b, val: k}, nil
 }
 
 // SecretKeyFromBytes creates a BLS private key from a byte slice.
@@ -40,87 +46,172 @@ func SecretKeyFromBytes(priv []byte) (*SecretKey, error) {
 	if len(priv) != 32 {
 		return nil, fmt.Errorf("expected byte slice of length 32, received: %d", len(priv))
 	}
-	k := bytesutil.ToBytes32(priv)
-	return &SecretKey{val: gobls.DeserializeSecretKey(k)}, nil
+	b := currentBackend()
+	k, err := b.secretKeyFromBytes(bytesutil.ToBytes32(priv))
+	if err != nil {
+		return nil, fmt.Errorf("could not unmarshal bytes into secret key: %v", err)
+	}
+	return &SecretKey{b: b, val: k}, nil
 }
 
 // PublicKeyFromBytes creates a BLS public key from a byte slice.
 func PublicKeyFromBytes(pub []byte) (*PublicKey, error) {
-	b := bytesutil.ToBytes96(pub)
-	k, err := gobls.DeserializePublicKey(b)
+	b := currentBackend()
+	k, err := b.publicKeyFromBytes(bytesutil.ToBytes96(pub))
 	if err != nil {
 		return nil, fmt.Errorf("could not unmarshal bytes into public key: %v", err)
 	}
-	return &PublicKey{val: k}, nil
+	return &PublicKey{b: b, val: k}, nil
 }
 
 // SignatureFromBytes creates a BLS signature from a byte slice.
 func SignatureFromBytes(sig []byte) (*Signature, error) {
-	b := bytesutil.ToBytes48(sig)
-	s, err := gobls.DeserializeSignature(b)
+	b := currentBackend()
+	s, err := b.signatureFromBytes(bytesutil.ToBytes48(sig))
 	if err != nil {
 		return nil, fmt.Errorf("could not unmarshal bytes into signature: %v", err)
 	}
-	return &Signature{val: s}, nil
+	return &Signature{b: b, val: s}, nil
 }
 
 // PublicKey obtains the public key corresponding to the BLS secret key.
 func (s *SecretKey) PublicKey() *PublicKey {
-	return &PublicKey{val: gobls.PrivToPub(s.val)}
+	return &PublicKey{b: s.b, val: s.val.publicKey()}
 }
 
 // Sign a message using a secret key - in a beacon/validator client,
 func (s *SecretKey) Sign(msg []byte, domain uint64) *Signature {
-	sig := gobls.Sign(msg, s.val, domain)
-	return &Signature{val: sig}
+	return &Signature{b: s.b, val: s.val.sign(msg, domain)}
 }
 
 // Marshal a secret key into a byte slice.
 func (s *SecretKey) Marshal() []byte {
-	k := s.val.Serialize()
+	k := s.val.marshal()
 	return k[:]
 }
 
 // Marshal a public key into a byte slice.
 func (p *PublicKey) Marshal() []byte {
-	k := p.val.Serialize()
+	k := p.val.marshal()
 	return k[:]
 }
 
-// Aggregate two public keys.
-func (p *PublicKey) Aggregate(p2 *PublicKey) *PublicKey {
-	p1 := p.val
-	p1.Aggregate(p2.val)
-	return &PublicKey{val: p1}
+// Aggregate two public keys. It fails if p2 was created by another backend
+// and can't be converted into the backend of p.
+func (p *PublicKey) Aggregate(p2 *PublicKey) (*PublicKey, error) {
+	k2, err := p2.in(p.b)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert public key to the %s backend: %v", p.b.name(), err)
+	}
+	return &PublicKey{b: p.b, val: p.b.aggregatePublicKeys([]publicKey{p.val, k2})}, nil
 }
 
 // Verify a bls signature given a public key, a message, and a domain.
 func (s *Signature) Verify(msg []byte, pub *PublicKey, domain uint64) bool {
-	return gobls.Verify(msg, pub.val, s.val, domain)
+	k, err := pub.in(s.b)
+	if err != nil {
+		return false
+	}
+	return s.b.verify(s.val, msg, k, domain)
 }
 
 // VerifyAggregate verifies each public key against a message.
 // This is vulnerable to rogue public-key attack. Each user must
 // provide a proof-of-knowledge of the public key.
 func (s *Signature) VerifyAggregate(pubKeys []*PublicKey, msg []byte, domain uint64) bool {
-	var keys []*gobls.PublicKey
-	for _, v := range pubKeys {
-		keys = append(keys, v.val)
+	keys, err := publicKeysIn(pubKeys, s.b)
+	if err != nil {
+		return false
+	}
+	return s.b.verify(s.val, msg, s.b.aggregatePublicKeys(keys), domain)
+}
+
+// VerifyAggregateMessages verifies an aggregate signature over several
+// messages, where pubKeys[i] signed msgs[i]. The public keys which signed the
+// same message are aggregated first, so verification takes one pairing per
+// distinct message, and a single message takes the same path as
+// VerifyAggregate. Like VerifyAggregate, this relies on every public key
+// having a proof-of-knowledge of its secret key.
+func (s *Signature) VerifyAggregateMessages(pubKeys []*PublicKey, msgs [][]byte, domain uint64) bool {
+	if len(pubKeys) == 0 || len(pubKeys) != len(msgs) {
+		return false
+	}
+	keys, err := publicKeysIn(pubKeys, s.b)
+	if err != nil {
+		return false
+	}
+	var signers [][]publicKey
+	var distinct [][]byte
+	groups := make(map[string]int)
+	for i, msg := range msgs {
+		g, ok := groups[string(msg)]
+		if !ok {
+			g = len(signers)
+			groups[string(msg)] = g
+			signers = append(signers, nil)
+			distinct = append(distinct, msg)
+		}
+		signers[g] = append(signers[g], keys[i])
+	}
+	aggregated := make([]publicKey, len(signers))
+	for i, k := range signers {
+		aggregated[i] = s.b.aggregatePublicKeys(k)
 	}
-	return s.val.VerifyAggregateCommon(keys, msg, domain)
+	if len(distinct) == 1 {
+		return s.b.verify(s.val, distinct[0], aggregated[0], domain)
+	}
+	return s.b.verifyAggregate(s.val, aggregated, distinct, domain)
 }
 
 // Marshal a signature into a byte slice.
 func (s *Signature) Marshal() []byte {
-	k := s.val.Serialize()
+	k := s.val.marshal()
 	return k[:]
 }
 
 // AggregateSignatures converts a list of signatures into a single, aggregated sig.
-func AggregateSignatures(sigs []*Signature) *Signature {
-	var ss []*gobls.Signature
-	for _, v := range sigs {
-		ss = append(ss, v.val)
+// Signatures created by another backend than the first one are converted into its
+// backend, and the aggregation fails if one of them can't be converted.
+func AggregateSignatures(sigs []*Signature) (*Signature, error) {
+	b := currentBackend()
+	if len(sigs) > 0 {
+		b = sigs[0].b
+	}
+	ss := make([]signature, len(sigs))
+	for i, s := range sigs {
+		v, err := s.in(b)
+		if err != nil {
+			return nil, fmt.Errorf("could not convert signature %d to the %s backend: %v", i, b.name(), err)
+		}
+		ss[i] = v
+	}
+	return &Signature{b: b, val: b.aggregateSignatures(ss)}, nil
+}
+
+// in returns the value of p in backend b, converting it through its encoding
+// if p was created by another backend.
+func (p *PublicKey) in(b backend) (publicKey, error) {
+	if p.b == b {
+		return p.val, nil
+	}
+	return b.publicKeyFromBytes(p.val.marshal())
+}
+
+func (s *Signature) in(b backend) (signature, error) {
+	if s.b == b {
+		return s.val, nil
+	}
+	return b.signatureFromBytes(s.val.marshal())
+}
+
+func publicKeysIn(pubKeys []*PublicKey, b backend) ([]publicKey, error) {
+	keys := make([]publicKey, len(pubKeys))
+	for i, p := range pubKeys {
+		k, err := p.in(b)
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = k
 	}
-	return &Signature{val: gobls.AggregateSignatures(ss)}
+	return keys, nil
 }
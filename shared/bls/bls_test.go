@@ -3,6 +3,7 @@ package bls_test
 import (
 	"bytes"
 	"crypto/rand"
+	"encoding/hex"
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/shared/bls"
@@ -46,8 +47,273 @@ func TestVerifyAggregate(t *testing.T) {
 		pubkeys = append(pubkeys, pub)
 		sigs = append(sigs, sig)
 	}
-	aggSig := bls.AggregateSignatures(sigs)
+	aggSig, err := bls.AggregateSignatures(sigs)
+	if err != nil {
+		t.Fatal(err)
+	}
 	if !aggSig.VerifyAggregate(pubkeys, msg, 0) {
 		t.Error("Signature did not verify")
 	}
 }
+
+// katKey returns the deterministic secret key with the given index, so that
+// the known-answer tests below use the same keys on every run.
+func katKey(t testing.TB, i int) *bls.SecretKey {
+	b := bytesutil.ToBytes32([]byte{byte(i + 1)})
+	priv, err := bls.SecretKeyFromBytes(b[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return priv
+}
+
+func TestVerifyAggregateMessages_KnownAnswers(t *testing.T) {
+	msgA, msgB, msgC := []byte("custody bit 0"), []byte("custody bit 1"), []byte("another message")
+	sign := func(i int, msg []byte) *bls.Signature {
+		return katKey(t, i).Sign(msg, 1)
+	}
+	pub := func(i int) *bls.PublicKey {
+		return katKey(t, i).PublicKey()
+	}
+	tests := []struct {
+		name    string
+		sigs    []*bls.Signature
+		pubKeys []*bls.PublicKey
+		msgs    [][]byte
+		domain  uint64
+		valid   bool
+	}{
+		{
+			name:    "single signer",
+			sigs:    []*bls.Signature{sign(0, msgA)},
+			pubKeys: []*bls.PublicKey{pub(0)},
+			msgs:    [][]byte{msgA},
+			domain:  1,
+			valid:   true,
+		},
+		{
+			name:    "same message",
+			sigs:    []*bls.Signature{sign(0, msgA), sign(1, msgA), sign(2, msgA)},
+			pubKeys: []*bls.PublicKey{pub(0), pub(1), pub(2)},
+			msgs:    [][]byte{msgA, msgA, msgA},
+			domain:  1,
+			valid:   true,
+		},
+		{
+			name:    "distinct messages",
+			sigs:    []*bls.Signature{sign(0, msgA), sign(1, msgB), sign(2, msgC)},
+			pubKeys: []*bls.PublicKey{pub(0), pub(1), pub(2)},
+			msgs:    [][]byte{msgA, msgB, msgC},
+			domain:  1,
+			valid:   true,
+		},
+		{
+			name:    "custody bits",
+			sigs:    []*bls.Signature{sign(0, msgA), sign(1, msgB), sign(2, msgA), sign(3, msgB)},
+			pubKeys: []*bls.PublicKey{pub(0), pub(1), pub(2), pub(3)},
+			msgs:    [][]byte{msgA, msgB, msgA, msgB},
+			domain:  1,
+			valid:   true,
+		},
+		{
+			name:    "same key twice",
+			sigs:    []*bls.Signature{sign(0, msgA), sign(0, msgB)},
+			pubKeys: []*bls.PublicKey{pub(0), pub(0)},
+			msgs:    [][]byte{msgA, msgB},
+			domain:  1,
+			valid:   true,
+		},
+		{
+			name:    "swapped messages",
+			sigs:    []*bls.Signature{sign(0, msgA), sign(1, msgB)},
+			pubKeys: []*bls.PublicKey{pub(0), pub(1)},
+			msgs:    [][]byte{msgB, msgA},
+			domain:  1,
+			valid:   false,
+		},
+		{
+			name:    "missing signer",
+			sigs:    []*bls.Signature{sign(0, msgA), sign(1, msgB), sign(2, msgA)},
+			pubKeys: []*bls.PublicKey{pub(0), pub(1)},
+			msgs:    [][]byte{msgA, msgB},
+			domain:  1,
+			valid:   false,
+		},
+		{
+			name:    "missing signature",
+			sigs:    []*bls.Signature{sign(0, msgA), sign(1, msgB)},
+			pubKeys: []*bls.PublicKey{pub(0), pub(1), pub(2)},
+			msgs:    [][]byte{msgA, msgB, msgB},
+			domain:  1,
+			valid:   false,
+		},
+		{
+			name:    "wrong domain",
+			sigs:    []*bls.Signature{sign(0, msgA), sign(1, msgB)},
+			pubKeys: []*bls.PublicKey{pub(0), pub(1)},
+			msgs:    [][]byte{msgA, msgB},
+			domain:  2,
+			valid:   false,
+		},
+		{
+			name:    "mismatched lengths",
+			sigs:    []*bls.Signature{sign(0, msgA), sign(1, msgB)},
+			pubKeys: []*bls.PublicKey{pub(0), pub(1)},
+			msgs:    [][]byte{msgA},
+			domain:  1,
+			valid:   false,
+		},
+		{
+			name:    "no signers",
+			sigs:    []*bls.Signature{sign(0, msgA)},
+			pubKeys: []*bls.PublicKey{},
+			msgs:    [][]byte{},
+			domain:  1,
+			valid:   false,
+		},
+	}
+	for _, tt := range tests {
+		aggSig, err := bls.AggregateSignatures(tt.sigs)
+		if err != nil {
+			t.Fatalf("%s: could not aggregate signatures: %v", tt.name, err)
+		}
+		if valid := aggSig.VerifyAggregateMessages(tt.pubKeys, tt.msgs, tt.domain); valid != tt.valid {
+			t.Errorf("%s: verification returned %v, expected %v", tt.name, valid, tt.valid)
+		}
+	}
+}
+
+// TestVerifyAggregateMessages_KnownAggregate pins the public keys of the katKey
+// signers of the custody bits case and their aggregate signature, and checks
+// that the decoded aggregate verifies against the decoded keys.
+func TestVerifyAggregateMessages_KnownAggregate(t *testing.T) {
+	pubKeys := []string{
+		"b70f842a2c85614d88ce277000d12f08bf2ab38037e69b699902b78732d2b8d834f97b0a0205f130e5615d178a2ee4c90bcb66b915199dd61ea67d0eb55a2ca998cb3fb62cca7348b050c2f8fb411dcd79921567d01dc167de002370b21301d4",
+		"86f5c07ed1f439109f505a3270864d859698b97f5ec7106bfcef161d06864d276294e4a6ce3c8723bfd1763c0040819b056c480ca00e036858345c80f191222c5581520dd8528607339f63e210f503878c071bc9010f0958012668e942db64d3",
+		"85cd58a5a16946ec8c938885e58d454773ebfc329ed674361a8d64a9488ae1e309e7baf6554e50dddcde75898835eaaa0fc0e240b1c19b1634e0230a771832b52c4d523d333929082a8214d06fb839400e1be80f0c08e357cddd9d8ec5ff7873",
+		"b71f9e6eecb06c18bc5d05b0662070677fc1bc37d91efec6d31ff88a6888227965d182e25df887b8b6af8bd9ba9af52d10b6766bb449802c45b5ee67fee75bff6a7283084f21d72633693e5c8523bece4fc3ee3ab6e0d4f679461bad3e86c765",
+	}
+	aggregate := "a92a8ffb495bc6345ccefcf1af8259a6173d8bdeb73096aafd70b9d9fab61e26a6ef9933e4c9d9639d55a1730fa9dbcb"
+	msgA, msgB := []byte("custody bit 0"), []byte("custody bit 1")
+	msgs := [][]byte{msgA, msgB, msgA, msgB}
+
+	var sigs []*bls.Signature
+	var pubs []*bls.PublicKey
+	for i, want := range pubKeys {
+		if got := hex.EncodeToString(katKey(t, i).PublicKey().Marshal()); got != want {
+			t.Errorf("Public key %d is %s, expected %s", i, got, want)
+		}
+		enc, err := hex.DecodeString(want)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pub, err := bls.PublicKeyFromBytes(enc)
+		if err != nil {
+			t.Fatalf("Could not decode public key %d: %v", i, err)
+		}
+		pubs = append(pubs, pub)
+		sigs = append(sigs, katKey(t, i).Sign(msgs[i], 1))
+	}
+	aggSig, err := bls.AggregateSignatures(sigs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := hex.EncodeToString(aggSig.Marshal()); got != aggregate {
+		t.Errorf("Aggregate signature is %s, expected %s", got, aggregate)
+	}
+
+	enc, err := hex.DecodeString(aggregate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	aggSig, err = bls.SignatureFromBytes(enc)
+	if err != nil {
+		t.Fatalf("Could not decode aggregate signature: %v", err)
+	}
+	if !aggSig.VerifyAggregateMessages(pubs, msgs, 1) {
+		t.Error("Known aggregate signature did not verify")
+	}
+	if aggSig.VerifyAggregateMessages(pubs, [][]byte{msgB, msgA, msgB, msgA}, 1) {
+		t.Error("Known aggregate signature verified with flipped custody bits")
+	}
+}
+
+func TestVerifyAggregateMessages_MatchesVerifyAggregate(t *testing.T) {
+	msg := []byte("hello")
+	pubKeys := make([]*bls.PublicKey, 10)
+	sigs := make([]*bls.Signature, 10)
+	msgs := make([][]byte, 10)
+	for i := range pubKeys {
+		pubKeys[i] = katKey(t, i).PublicKey()
+		sigs[i] = katKey(t, i).Sign(msg, 0)
+		msgs[i] = msg
+	}
+	aggSig, err := bls.AggregateSignatures(sigs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !aggSig.VerifyAggregate(pubKeys, msg, 0) || !aggSig.VerifyAggregateMessages(pubKeys, msgs, 0) {
+		t.Error("Aggregate signature over a single message did not verify")
+	}
+	// Verifying must not modify the public keys.
+	for i, pub := range pubKeys {
+		if !bytes.Equal(pub.Marshal(), katKey(t, i).PublicKey().Marshal()) {
+			t.Errorf("Public key %d was modified by verification", i)
+		}
+	}
+}
+
+func benchmarkVerifyAggregateMessages(b *testing.B, signers int, distinct int) {
+	pubKeys := make([]*bls.PublicKey, signers)
+	sigs := make([]*bls.Signature, signers)
+	msgs := make([][]byte, signers)
+	for i := range pubKeys {
+		priv := katKey(b, i)
+		msgs[i] = []byte{byte(i % distinct)}
+		pubKeys[i] = priv.PublicKey()
+		sigs[i] = priv.Sign(msgs[i], 0)
+	}
+	aggSig, err := bls.AggregateSignatures(sigs)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if !aggSig.VerifyAggregateMessages(pubKeys, msgs, 0) {
+			b.Fatal("Aggregate signature did not verify")
+		}
+	}
+}
+
+func BenchmarkVerifyAggregateMessages_SameMessage(b *testing.B) {
+	benchmarkVerifyAggregateMessages(b, 128, 1)
+}
+
+func BenchmarkVerifyAggregateMessages_CustodyBits(b *testing.B) {
+	benchmarkVerifyAggregateMessages(b, 128, 2)
+}
+
+func BenchmarkVerifyAggregateMessages_DistinctMessages(b *testing.B) {
+	benchmarkVerifyAggregateMessages(b, 128, 128)
+}
+
+func BenchmarkVerifyAggregate(b *testing.B) {
+	msg := []byte("hello")
+	pubKeys := make([]*bls.PublicKey, 128)
+	sigs := make([]*bls.Signature, 128)
+	for i := range pubKeys {
+		priv := katKey(b, i)
+		pubKeys[i] = priv.PublicKey()
+		sigs[i] = priv.Sign(msg, 0)
+	}
+	aggSig, err := bls.AggregateSignatures(sigs)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if !aggSig.VerifyAggregate(pubKeys, msg, 0) {
+			b.Fatal("Aggregate signature did not verify")
+		}
+	}
+}
@@ -0,0 +1,7 @@
+// +build !bls_kilic
+
+package bls
+
+// defaultBackendName is the backend used until SetBackend is called. Build
+// with the bls_kilic tag to use the kilic backend by default.
+const defaultBackendName = phoreBackendName
@@ -0,0 +1,6 @@
+// +build bls_kilic
+
+package bls
+
+// defaultBackendName is the backend used until SetBackend is called.
+const defaultBackendName = kilicBackendName
@@ -0,0 +1,294 @@
+package bls
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/big"
+
+	bls12381 "github.com/kilic/bls12-381"
+	"golang.org/x/crypto/blake2b"
+)
+
+const kilicBackendName = "kilic"
+
+var (
+	// fieldModulus is the modulus q of the base field of BLS12-381.
+	fieldModulus, _ = new(big.Int).SetString("1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaab", 16)
+	// groupOrder is the order r of G1 and G2, and the modulus of secret keys.
+	groupOrder, _ = new(big.Int).SetString("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001", 16)
+	// g1Cofactor is the cofactor of G1 in the curve y^2 = x^3 + 4.
+	g1Cofactor, _ = new(big.Int).SetString("396c8c005555e1568c00aaab0000aaab", 16)
+	g1B           = big.NewInt(4)
+	halfModulus   = new(big.Int).Rsh(fieldModulus, 1)
+	// sqrtNegThree and its combination below are the constants of the
+	// Shallue-van de Woestijne encoding.
+	sqrtNegThree                = new(big.Int).ModSqrt(new(big.Int).Sub(fieldModulus, big.NewInt(3)), fieldModulus)
+	sqrtNegThreeMinusOneOverTwo = new(big.Int).Mod(new(big.Int).Mul(
+		new(big.Int).Sub(sqrtNegThree, big.NewInt(1)),
+		new(big.Int).ModInverse(big.NewInt(2), fieldModulus),
+	), fieldModulus)
+)
+
+// kilicBackend implements the signature scheme in pure Go with the
+// kilic/bls12-381 library, as an alternative to phoreproject/bls. It hashes messages to G1 like phoreproject/bls, by
+// adding the Shallue-van de Woestijne encodings of two field elements derived
+// from BLAKE2b hashes of the message and its domain, and clearing the cofactor.
+type kilicBackend struct{}
+
+type kilicSecretKey struct{ val *big.Int }
+
+type kilicPublicKey struct{ val *bls12381.PointG2 }
+
+type kilicSignature struct{ val *bls12381.PointG1 }
+
+func (kilicBackend) name() string {
+	return kilicBackendName
+}
+
+func (kilicBackend) randKey(r io.Reader) (secretKey, error) {
+	// Reduce 64 random bytes, so that the key is close to uniform.
+	var b [64]byte
+	for {
+		if _, err := io.ReadFull(r, b[:]); err != nil {
+			return nil, err
+		}
+		k := new(big.Int).Mod(new(big.Int).SetBytes(b[:]), groupOrder)
+		if k.Sign() != 0 {
+			return kilicSecretKey{val: k}, nil
+		}
+	}
+}
+
+func (kilicBackend) secretKeyFromBytes(b [32]byte) (secretKey, error) {
+	return kilicSecretKey{val: new(big.Int).Mod(new(big.Int).SetBytes(b[:]), groupOrder)}, nil
+}
+
+// publicKeyFromBytes rejects points outside of the subgroup of G2, which the
+// library only checks when decompressing in some of its versions.
+func (kilicBackend) publicKeyFromBytes(b [96]byte) (publicKey, error) {
+	g := bls12381.NewG2()
+	p, err := g.FromCompressed(b[:])
+	if err != nil {
+		return nil, err
+	}
+	if !g.InCorrectSubgroup(p) {
+		return nil, errors.New("public key is not in the G2 subgroup")
+	}
+	return kilicPublicKey{val: p}, nil
+}
+
+// signatureFromBytes rejects points outside of the subgroup of G1.
+func (kilicBackend) signatureFromBytes(b [48]byte) (signature, error) {
+	g := bls12381.NewG1()
+	p, err := g.FromCompressed(b[:])
+	if err != nil {
+		return nil, err
+	}
+	if !g.InCorrectSubgroup(p) {
+		return nil, errors.New("signature is not in the G1 subgroup")
+	}
+	return kilicSignature{val: p}, nil
+}
+
+func (kilicBackend) aggregatePublicKeys(pubs []publicKey) publicKey {
+	g := bls12381.NewG2()
+	agg := g.Zero()
+	for _, p := range pubs {
+		g.Add(agg, agg, p.(kilicPublicKey).val)
+	}
+	return kilicPublicKey{val: agg}
+}
+
+func (kilicBackend) aggregateSignatures(sigs []signature) signature {
+	g := bls12381.NewG1()
+	agg := g.Zero()
+	for _, s := range sigs {
+		g.Add(agg, agg, s.(kilicSignature).val)
+	}
+	return kilicSignature{val: agg}
+}
+
+// verify checks that e(H(msg), pub) = e(sig, g2).
+func (b kilicBackend) verify(sig signature, msg []byte, pub publicKey, domain uint64) bool {
+	return b.verifyAggregate(sig, []publicKey{pub}, [][]byte{msg}, domain)
+}
+
+// verifyAggregate checks that the product of e(H(msgs[i]), pubs[i]) is
+// e(sig, g2), with a single final exponentiation.
+func (kilicBackend) verifyAggregate(sig signature, pubs []publicKey, msgs [][]byte, domain uint64) bool {
+	if len(pubs) != len(msgs) {
+		return false
+	}
+	e := bls12381.NewEngine()
+	for i, p := range pubs {
+		h, err := hashToG1(msgs[i], domain)
+		if err != nil {
+			return false
+		}
+		// The engine converts its points to affine coordinates in place, so
+		// pass it a copy of the public key.
+		e.AddPair(h, e.G2.New().Set(p.(kilicPublicKey).val))
+	}
+	e.AddPairInv(sig.(kilicSignature).val, e.G2.One())
+	return e.Check()
+}
+
+func (k kilicSecretKey) publicKey() publicKey {
+	g := bls12381.NewG2()
+	return kilicPublicKey{val: g.MulScalarBig(g.New(), g.One(), k.val)}
+}
+
+func (k kilicSecretKey) sign(msg []byte, domain uint64) signature {
+	h, err := hashToG1(msg, domain)
+	if err != nil {
+		// The encoding always results in a point on the curve.
+		panic(err)
+	}
+	g := bls12381.NewG1()
+	return kilicSignature{val: g.MulScalarBig(h, h, k.val)}
+}
+
+func (k kilicSecretKey) marshal() [32]byte {
+	var b [32]byte
+	putBigInt(b[:], k.val)
+	return b
+}
+
+func (p kilicPublicKey) marshal() [96]byte {
+	var b [96]byte
+	copy(b[:], bls12381.NewG2().ToCompressed(p.val))
+	return b
+}
+
+func (s kilicSignature) marshal() [48]byte {
+	var b [48]byte
+	copy(b[:], bls12381.NewG1().ToCompressed(s.val))
+	return b
+}
+
+// hashToG1 hashes a message and its domain to a point of G1.
+func hashToG1(msg []byte, domain uint64) (*bls12381.PointG1, error) {
+	var domainBytes [8]byte
+	binary.BigEndian.PutUint64(domainBytes[:], domain)
+	g := bls12381.NewG1()
+	t0, err := swEncode(g, hashToField(msg, domainBytes, "G1_0"))
+	if err != nil {
+		return nil, err
+	}
+	t1, err := swEncode(g, hashToField(msg, domainBytes, "G1_1"))
+	if err != nil {
+		return nil, err
+	}
+	return clearCofactor(g, g.Add(g.New(), t0, t1)), nil
+}
+
+// clearCofactor multiplies p by the cofactor of G1 with double-and-add, as
+// the multiplication of G1 assumes points of the subgroup.
+func clearCofactor(g *bls12381.G1, p *bls12381.PointG1) *bls12381.PointG1 {
+	r := g.Zero()
+	for i := g1Cofactor.BitLen() - 1; i >= 0; i-- {
+		g.Double(r, r)
+		if g1Cofactor.Bit(i) == 1 {
+			g.Add(r, r, p)
+		}
+	}
+	return r
+}
+
+// hashToField reduces the 512-bit BLAKE2b hash of the message, its domain and
+// a tag modulo q.
+func hashToField(msg []byte, domain [8]byte, tag string) *big.Int {
+	h, err := blake2b.New512(nil)
+	if err != nil {
+		panic(err)
+	}
+	h.Write(msg)
+	h.Write(domain[:])
+	h.Write([]byte(tag))
+	return new(big.Int).Mod(new(big.Int).SetBytes(h.Sum(nil)), fieldModulus)
+}
+
+// swEncode maps a field element t to a point of the curve with the encoding
+// of Fouque and Tibouchi, "Indifferentiable Hashing to Barreto-Naehrig
+// Curves", choosing the y-coordinate with the same parity as t.
+func swEncode(g *bls12381.G1, t *big.Int) (*bls12381.PointG1, error) {
+	if t.Sign() == 0 {
+		return g.Zero(), nil
+	}
+	parity := t.Cmp(halfModulus) > 0
+
+	// w = sqrt(-3) * t / (t^2 + b + 1)
+	w := new(big.Int).Mul(t, t)
+	w.Add(w, g1B)
+	w.Add(w, big.NewInt(1))
+	w.Mod(w, fieldModulus)
+	if w.Sign() == 0 {
+		p := g.One()
+		if parity {
+			g.Neg(p, p)
+		}
+		return p, nil
+	}
+	w.ModInverse(w, fieldModulus)
+	w.Mul(w, sqrtNegThree)
+	w.Mul(w, t)
+	w.Mod(w, fieldModulus)
+
+	// x1 = (sqrt(-3) - 1) / 2 - w * t
+	x1 := new(big.Int).Mul(w, t)
+	x1.Sub(sqrtNegThreeMinusOneOverTwo, x1)
+	x1.Mod(x1, fieldModulus)
+	if p, ok, err := pointFromX(g, x1, parity); ok || err != nil {
+		return p, err
+	}
+	// x2 = -1 - x1
+	x2 := new(big.Int).Neg(x1)
+	x2.Sub(x2, big.NewInt(1))
+	x2.Mod(x2, fieldModulus)
+	if p, ok, err := pointFromX(g, x2, parity); ok || err != nil {
+		return p, err
+	}
+	// x3 = 1 / w^2 + 1
+	x3 := new(big.Int).Mul(w, w)
+	x3.ModInverse(x3, fieldModulus)
+	x3.Add(x3, big.NewInt(1))
+	x3.Mod(x3, fieldModulus)
+	p, ok, err := pointFromX(g, x3, parity)
+	if err == nil && !ok {
+		err = errors.New("no point for the third candidate x-coordinate")
+	}
+	return p, err
+}
+
+// pointFromX returns the point with x-coordinate x and the greater or the
+// lesser of the two y-coordinates, if x is on the curve.
+func pointFromX(g *bls12381.G1, x *big.Int, greatest bool) (*bls12381.PointG1, bool, error) {
+	y2 := new(big.Int).Mul(x, x)
+	y2.Mul(y2, x)
+	y2.Add(y2, g1B)
+	y2.Mod(y2, fieldModulus)
+	y := new(big.Int).ModSqrt(y2, fieldModulus)
+	if y == nil {
+		return nil, false, nil
+	}
+	negY := new(big.Int).Sub(fieldModulus, y)
+	negY.Mod(negY, fieldModulus)
+	if (y.Cmp(negY) > 0) != greatest {
+		y = negY
+	}
+	var b [96]byte
+	putBigInt(b[:48], x)
+	putBigInt(b[48:], y)
+	p, err := g.FromBytes(b[:])
+	if err != nil {
+		return nil, false, err
+	}
+	return p, true, nil
+}
+
+// putBigInt writes x to b as a big-endian integer of len(b) bytes.
+func putBigInt(b []byte, x *big.Int) {
+	xb := x.Bytes()
+	copy(b[len(b)-len(xb):], xb)
+}
@@ -0,0 +1,99 @@
+package bls
+
+import (
+	"io"
+
+	gobls "github.com/phoreproject/bls"
+)
+
+const phoreBackendName = "phore"
+
+// phoreBackend implements the signature scheme with the phoreproject/bls
+// library.
+type phoreBackend struct{}
+
+type phoreSecretKey struct{ val *gobls.SecretKey }
+
+type phorePublicKey struct{ val *gobls.PublicKey }
+
+type phoreSignature struct{ val *gobls.Signature }
+
+func (phoreBackend) name() string {
+	return phoreBackendName
+}
+
+func (phoreBackend) randKey(r io.Reader) (secretKey, error) {
+	k, err := gobls.RandKey(r)
+	if err != nil {
+		return nil, err
+	}
+	return phoreSecretKey{val: k}, nil
+}
+
+func (phoreBackend) secretKeyFromBytes(b [32]byte) (secretKey, error) {
+	return phoreSecretKey{val: gobls.DeserializeSecretKey(b)}, nil
+}
+
+func (phoreBackend) publicKeyFromBytes(b [96]byte) (publicKey, error) {
+	k, err := gobls.DeserializePublicKey(b)
+	if err != nil {
+		return nil, err
+	}
+	return phorePublicKey{val: k}, nil
+}
+
+func (phoreBackend) signatureFromBytes(b [48]byte) (signature, error) {
+	s, err := gobls.DeserializeSignature(b)
+	if err != nil {
+		return nil, err
+	}
+	return phoreSignature{val: s}, nil
+}
+
+func (phoreBackend) aggregatePublicKeys(pubs []publicKey) publicKey {
+	agg := gobls.NewAggregatePubkey()
+	for _, p := range pubs {
+		agg.Aggregate(p.(phorePublicKey).val)
+	}
+	return phorePublicKey{val: agg}
+}
+
+func (phoreBackend) aggregateSignatures(sigs []signature) signature {
+	ss := make([]*gobls.Signature, len(sigs))
+	for i, s := range sigs {
+		ss[i] = s.(phoreSignature).val
+	}
+	return phoreSignature{val: gobls.AggregateSignatures(ss)}
+}
+
+func (phoreBackend) verify(sig signature, msg []byte, pub publicKey, domain uint64) bool {
+	return gobls.Verify(msg, pub.(phorePublicKey).val, sig.(phoreSignature).val, domain)
+}
+
+func (phoreBackend) verifyAggregate(sig signature, pubs []publicKey, msgs [][]byte, domain uint64) bool {
+	keys := make([]*gobls.PublicKey, len(pubs))
+	for i, p := range pubs {
+		keys[i] = p.(phorePublicKey).val
+	}
+	return sig.(phoreSignature).val.VerifyAggregate(keys, msgs, domain)
+}
+
+func (k phoreSecretKey) publicKey() publicKey {
+	return phorePublicKey{val: gobls.PrivToPub(k.val)}
+}
+
+func (k phoreSecretKey) sign(msg []byte, domain uint64) signature {
+	return phoreSignature{val: gobls.Sign(msg, k.val, domain)}
+}
+
+func (k phoreSecretKey) marshal() [32]byte {
+	return k.val.Serialize()
+}
+
+func (p phorePublicKey) marshal() [96]byte {
+	return p.val.Serialize()
+}
+
+func (s phoreSignature) marshal() [48]byte {
+	return s.val.Serialize()
+}
@@ -64,6 +64,19 @@ var (
 		Usage: "The port used by libp2p.",
 		Value: 12000,
 	}
+	// ForkScheduleFlag defines the forks to activate after genesis, overriding the fork
+	// schedule of the beacon chain config. Beacon nodes and validator clients of the same
+	// network must use the same fork schedule.
+	ForkScheduleFlag = cli.StringFlag{
+		Name:  "fork-schedule",
+		Usage: "Comma separated list of epoch:version pairs activating fork versions at epochs since genesis, e.g. 100:1,200:2",
+	}
+	// BLSBackendFlag selects the implementation of the BLS signature scheme. All backends
+	// produce the same keys and signatures, so it only affects performance.
+	BLSBackendFlag = cli.StringFlag{
+		Name:  "bls-backend",
+		Usage: "BLS signature backend to use (phore, kilic), defaults to the backend selected at build time",
+	}
 	// ClearDBFlag tells the beacon node to remove any previously stored data at the data directory.
 	ClearDBFlag = cli.BoolFlag{
 		Name:  "clear-db",
@@ -0,0 +1,44 @@
+package forkutil
+
+import (
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// ScheduledForkVersion returns the fork version which the fork schedule
+// of the beacon chain config assigns to the given epoch.
+func ScheduledForkVersion(epoch uint64) uint64 {
+	version, _ := latestScheduledFork(epoch)
+	return version
+}
+
+// ScheduledFork returns the fork in effect at the given epoch. Forks scheduled
+// after the epoch of the given fork, but no later than the given epoch, take
+// precedence over it, so a caller holding a state from before a scheduled fork
+// still signs and verifies with the domain of the fork the epoch belongs to.
+func ScheduledFork(fork *pb.Fork, epoch uint64) *pb.Fork {
+	version, forkEpoch := latestScheduledFork(epoch)
+	if forkEpoch == params.BeaconConfig().GenesisEpoch || forkEpoch <= fork.GetEpoch() {
+		return fork
+	}
+	return &pb.Fork{
+		PreviousVersion: ScheduledForkVersion(forkEpoch - 1),
+		CurrentVersion:  version,
+		Epoch:           forkEpoch,
+	}
+}
+
+// latestScheduledFork returns the version and the activation epoch of the last
+// scheduled fork activating no later than the given epoch. The genesis fork
+// version and genesis epoch are returned if no such fork is scheduled.
+func latestScheduledFork(epoch uint64) (uint64, uint64) {
+	cfg := params.BeaconConfig()
+	version, forkEpoch := cfg.GenesisForkVersion, cfg.GenesisEpoch
+	for sinceGenesis, v := range cfg.ForkSchedule {
+		e := cfg.GenesisEpoch + sinceGenesis
+		if e <= epoch && e > forkEpoch {
+			version, forkEpoch = v, e
+		}
+	}
+	return version, forkEpoch
+}
@@ -0,0 +1,62 @@
+package forkutil
+
+import (
+	"reflect"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestScheduledForkVersion_OK(t *testing.T) {
+	defer params.OverrideBeaconConfig(params.BeaconConfig())
+	cfg := *params.BeaconConfig()
+	cfg.GenesisForkVersion = 7
+	cfg.ForkSchedule = map[uint64]uint64{10: 8, 20: 9}
+	params.OverrideBeaconConfig(&cfg)
+
+	genesisEpoch := cfg.GenesisEpoch
+	tests := []struct {
+		epoch   uint64
+		version uint64
+	}{
+		{epoch: 0, version: 7},
+		{epoch: genesisEpoch, version: 7},
+		{epoch: genesisEpoch + 9, version: 7},
+		{epoch: genesisEpoch + 10, version: 8},
+		{epoch: genesisEpoch + 19, version: 8},
+		{epoch: genesisEpoch + 20, version: 9},
+		{epoch: genesisEpoch + 1000, version: 9},
+	}
+	for _, tt := range tests {
+		if v := ScheduledForkVersion(tt.epoch); v != tt.version {
+			t.Errorf("ScheduledForkVersion(genesis + %d) = %d, wanted %d", tt.epoch-genesisEpoch, v, tt.version)
+		}
+	}
+}
+
+func TestScheduledFork_RotatesAtScheduledEpoch(t *testing.T) {
+	defer params.OverrideBeaconConfig(params.BeaconConfig())
+	cfg := *params.BeaconConfig()
+	cfg.ForkSchedule = map[uint64]uint64{10: 1, 20: 2}
+	params.OverrideBeaconConfig(&cfg)
+
+	genesisFork := &pb.Fork{Epoch: cfg.GenesisEpoch}
+	if fork := ScheduledFork(genesisFork, cfg.GenesisEpoch+9); fork != genesisFork {
+		t.Errorf("Expected fork to stay the same before the scheduled epoch, received %v", fork)
+	}
+
+	want := &pb.Fork{PreviousVersion: 0, CurrentVersion: 1, Epoch: cfg.GenesisEpoch + 10}
+	fork := ScheduledFork(genesisFork, cfg.GenesisEpoch+10)
+	if !reflect.DeepEqual(fork, want) {
+		t.Errorf("Wanted fork %v, received %v", want, fork)
+	}
+	if fork := ScheduledFork(want, cfg.GenesisEpoch+19); fork != want {
+		t.Errorf("Expected an already rotated fork to be returned as is, received %v", fork)
+	}
+
+	want = &pb.Fork{PreviousVersion: 1, CurrentVersion: 2, Epoch: cfg.GenesisEpoch + 20}
+	if fork := ScheduledFork(genesisFork, cfg.GenesisEpoch+25); !reflect.DeepEqual(fork, want) {
+		t.Errorf("Wanted fork %v, received %v", want, fork)
+	}
+}
@@ -0,0 +1,27 @@
+package interop
+
+import (
+	"fmt"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+)
+
+// GenerateDepositInputs creates the deposit inputs of validators with the given
+// secret keys, each signed by and withdrawing to the validator's key.
+func GenerateDepositInputs(keys []*bls.SecretKey) ([]*pb.DepositInput, error) {
+	depositInputs := make([]*pb.DepositInput, len(keys))
+	for i, key := range keys {
+		k := &keystore.Key{
+			PublicKey: key.PublicKey(),
+			SecretKey: key,
+		}
+		depositInput, err := keystore.DepositInput(k, k)
+		if err != nil {
+			return nil, fmt.Errorf("could not create deposit input: %v", err)
+		}
+		depositInputs[i] = depositInput
+	}
+	return depositInputs, nil
+}
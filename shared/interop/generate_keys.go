@@ -0,0 +1,48 @@
+// Package interop generates the deterministic validator keys used to start
+// test networks shared by several beacon nodes and validator clients, without
+// having to distribute keystores or deploy a deposit contract.
+package interop
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/prysmaticlabs/prysm/shared/bls"
+)
+
+// curveOrder is the order of the BLS12-381 curve.
+var curveOrder, _ = new(big.Int).SetString("52435875175126190479447740508185965837690552500527637822603658699938581184513", 10)
+
+// DeterministicallyGenerateKeys derives the BLS secret keys of the validators with
+// indices startIndex to startIndex+numKeys-1 as specified by the interop mock start:
+//
+//   privkey = int.from_bytes(sha256(int_to_bytes(index, length=32)), "little") % curve_order
+func DeterministicallyGenerateKeys(startIndex uint64, numKeys uint64) ([]*bls.SecretKey, error) {
+	keys := make([]*bls.SecretKey, numKeys)
+	for i := uint64(0); i < numKeys; i++ {
+		enc := make([]byte, 32)
+		binary.LittleEndian.PutUint64(enc, startIndex+i)
+		hash := sha256.Sum256(enc)
+		num := new(big.Int).SetBytes(reverse(hash[:]))
+		num.Mod(num, curveOrder)
+		priv := make([]byte, 32)
+		copy(priv[32-len(num.Bytes()):], num.Bytes())
+		key, err := bls.SecretKeyFromBytes(priv)
+		if err != nil {
+			return nil, fmt.Errorf("could not create key for validator %d: %v", startIndex+i, err)
+		}
+		keys[i] = key
+	}
+	return keys, nil
+}
+
+// reverse returns a copy of the input with the order of its bytes reversed.
+func reverse(input []byte) []byte {
+	output := make([]byte, len(input))
+	for i, b := range input {
+		output[len(input)-1-i] = b
+	}
+	return output
+}
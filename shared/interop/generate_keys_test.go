@@ -0,0 +1,45 @@
+package interop
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func TestDeterministicallyGenerateKeys_MatchesInteropKeys(t *testing.T) {
+	// Secret keys of the first validators in the interop mock start test vectors.
+	tests := []string{
+		"25295f0d1d592a90b333e26e85149708208e9f8e8bc18f6c77bd62f8ad7a6866",
+		"51d0b65185db6989ab0b560d6deed19c7ead0e24b9b6372cbecb1f26bdfad000",
+		"315ed405fafe339603932eebe8dbfd650ce5dafa561f6928664c75db85f97857",
+	}
+	keys, err := DeterministicallyGenerateKeys(0, uint64(len(tests)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, tt := range tests {
+		want, err := hex.DecodeString(tt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(keys[i].Marshal(), want) {
+			t.Errorf("Wrong key for validator %d, wanted %#x, received %#x", i, want, keys[i].Marshal())
+		}
+	}
+}
+
+func TestDeterministicallyGenerateKeys_StartIndex(t *testing.T) {
+	keys, err := DeterministicallyGenerateKeys(0, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	offsetKeys, err := DeterministicallyGenerateKeys(5, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, key := range offsetKeys {
+		if !bytes.Equal(key.Marshal(), keys[5+i].Marshal()) {
+			t.Errorf("Expected key at start index 5 + %d to equal key of validator %d", i, 5+i)
+		}
+	}
+}
@@ -1,7 +1,7 @@
 package keystore
 
 import (
-	"bytes"
+	"fmt"
 
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -16,8 +16,7 @@ import (
 //   To submit a deposit:
 //
 //   - Pack the validator's initialization parameters into deposit_input, a DepositInput SSZ object.
-//   - Set deposit_input.proof_of_possession = EMPTY_SIGNATURE.
-//   - Let proof_of_possession be the result of bls_sign of the hash_tree_root(deposit_input) with domain=DOMAIN_DEPOSIT.
+//   - Let proof_of_possession be the result of bls_sign of the signed_root(deposit_input) with domain=DOMAIN_DEPOSIT.
 //   - Set deposit_input.proof_of_possession = proof_of_possession.
 //   - Let amount be the amount in Gwei to be deposited by the validator where MIN_DEPOSIT_AMOUNT <= amount <= MAX_DEPOSIT_AMOUNT.
 //   - Send a transaction on the Ethereum 1.0 chain to DEPOSIT_CONTRACT_ADDRESS executing deposit along with serialize(deposit_input) as the singular bytes input along with a deposit amount in Gwei.
@@ -29,11 +28,11 @@ func DepositInput(depositKey *Key, withdrawalKey *Key) (*pb.DepositInput, error)
 		WithdrawalCredentialsHash32: withdrawalCredentialsHash(withdrawalKey),
 	}
 
-	buf := new(bytes.Buffer)
-	if err := ssz.Encode(buf, di); err != nil {
-		return nil, err
+	root, err := ssz.SigningRoot(di)
+	if err != nil {
+		return nil, fmt.Errorf("could not get signing root of deposit input: %v", err)
 	}
-	di.ProofOfPossession = depositKey.SecretKey.Sign(buf.Bytes(), params.BeaconConfig().DomainDeposit).Marshal()
+	di.ProofOfPossession = depositKey.SecretKey.Sign(root[:], params.BeaconConfig().DomainDeposit).Marshal()
 
 	return di, nil
 }
@@ -5,8 +5,6 @@ import (
 	"crypto/rand"
 	"testing"
 
-	"github.com/gogo/protobuf/proto"
-	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/keystore"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -36,15 +34,12 @@ func TestDepositInput_GeneratesPb(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	// Verify that the proof of possession is a signed copy of the input data.
-	proofOfPossessionInputPb := proto.Clone(result).(*pb.DepositInput)
-	proofOfPossessionInputPb.ProofOfPossession = nil
-	buf := new(bytes.Buffer)
-	if err := ssz.Encode(buf, proofOfPossessionInputPb); err != nil {
+	// Verify that the proof of possession signs the signing root of the input data.
+	root, err := ssz.SigningRoot(result)
+	if err != nil {
 		t.Fatal(err)
 	}
-
-	if !sig.Verify(buf.Bytes(), k1.PublicKey, params.BeaconConfig().DomainDeposit) {
+	if !sig.Verify(root[:], k1.PublicKey, params.BeaconConfig().DomainDeposit) {
 		t.Error("Invalid proof of proofOfPossession signature")
 	}
 }
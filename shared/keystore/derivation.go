@@ -0,0 +1,174 @@
+package keystore
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// WithdrawalKeyPath is the EIP-2334 derivation path of the withdrawal key of
+	// the validator with the given account index.
+	WithdrawalKeyPath = "m/12381/3600/%d/0"
+	// ValidatorKeyPath is the EIP-2334 derivation path of the signing key of the
+	// validator with the given account index.
+	ValidatorKeyPath = "m/12381/3600/%d/0/0"
+
+	// minSeedLength is the minimum length in bytes of the seed of a master key.
+	minSeedLength = 32
+	// lamportChunks is the number of chunks of each of the two lamport secret
+	// keys derived from a parent key.
+	lamportChunks = 255
+)
+
+// blsCurveOrder is the order r of the BLS12-381 curve subgroups.
+var blsCurveOrder, _ = new(big.Int).SetString("52435875175126190479447740508185965837690552500527637822603658699938581184513", 10)
+
+// DeriveMasterSecretKey derives the root BLS secret key of a key tree from a
+// seed of at least 32 bytes, as in EIP-2333.
+func DeriveMasterSecretKey(seed []byte) (*bls.SecretKey, error) {
+	if len(seed) < minSeedLength {
+		return nil, fmt.Errorf("seed must be at least %d bytes, received %d", minSeedLength, len(seed))
+	}
+	return hkdfModR(seed)
+}
+
+// DeriveChildSecretKey derives the secret key with the given index below a
+// parent secret key, as in EIP-2333.
+func DeriveChildSecretKey(parent *bls.SecretKey, index uint32) (*bls.SecretKey, error) {
+	lamportPK, err := parentToLamportPK(parent.Marshal(), index)
+	if err != nil {
+		return nil, err
+	}
+	return hkdfModR(lamportPK)
+}
+
+// DeriveSecretKey derives the secret key at a path such as "m/12381/3600/0/0"
+// of the key tree rooted at the master key of the seed.
+func DeriveSecretKey(seed []byte, path string) (*bls.SecretKey, error) {
+	indices, err := parsePath(path)
+	if err != nil {
+		return nil, err
+	}
+	sk, err := DeriveMasterSecretKey(seed)
+	if err != nil {
+		return nil, err
+	}
+	for _, index := range indices {
+		sk, err = DeriveChildSecretKey(sk, index)
+		if err != nil {
+			return nil, fmt.Errorf("could not derive child key %d of path %s: %v", index, path, err)
+		}
+	}
+	return sk, nil
+}
+
+// NewKeyFromSeed derives the key at a path of the key tree rooted at the master
+// key of the seed.
+func NewKeyFromSeed(seed []byte, path string) (*Key, error) {
+	secretKey, err := DeriveSecretKey(seed, path)
+	if err != nil {
+		return nil, fmt.Errorf("could not derive key: %v", err)
+	}
+	return newKeyFromBLS(secretKey)
+}
+
+// parsePath returns the child indices of a derivation path, which starts with
+// the master key "m".
+func parsePath(path string) ([]uint32, error) {
+	parts := strings.Split(path, "/")
+	if parts[0] != "m" {
+		return nil, fmt.Errorf("derivation path %q does not start with m", path)
+	}
+	indices := make([]uint32, len(parts)-1)
+	for i, part := range parts[1:] {
+		index, err := strconv.ParseUint(part, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid index %q in derivation path %q", part, path)
+		}
+		indices[i] = uint32(index)
+	}
+	return indices, nil
+}
+
+// hkdfModR maps input key material to a non-zero BLS secret key.
+//
+// Spec pseudocode definition:
+//   def HKDF_mod_r(IKM: bytes, key_info: bytes=b'') -> int:
+//     L = 48
+//     salt = b'BLS-SIG-KEYGEN-SALT-'
+//     SK = 0
+//     while SK == 0:
+//       salt = H(salt)
+//       PRK = HKDF-Extract(salt, IKM || I2OSP(0, 1))
+//       OKM = HKDF-Expand(PRK, key_info || I2OSP(L, 2), L)
+//       SK = OS2IP(OKM) mod r
+//     return SK
+func hkdfModR(ikm []byte) (*bls.SecretKey, error) {
+	const l = 48
+	salt := []byte("BLS-SIG-KEYGEN-SALT-")
+	secret := append(append([]byte{}, ikm...), 0)
+	info := []byte{0, l}
+	sk := new(big.Int)
+	for sk.Sign() == 0 {
+		h := sha256.Sum256(salt)
+		salt = h[:]
+		okm := make([]byte, l)
+		if _, err := io.ReadFull(hkdf.New(sha256.New, secret, salt, info), okm); err != nil {
+			return nil, fmt.Errorf("could not expand key material: %v", err)
+		}
+		sk.Mod(sk.SetBytes(okm), blsCurveOrder)
+	}
+	enc := make([]byte, 32)
+	b := sk.Bytes()
+	copy(enc[len(enc)-len(b):], b)
+	secretKey, err := bls.SecretKeyFromBytes(enc)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode derived secret key: %v", err)
+	}
+	return secretKey, nil
+}
+
+// parentToLamportPK returns the compressed lamport public key which seeds the
+// child key with the given index.
+//
+// Spec pseudocode definition:
+//   def parent_SK_to_lamport_PK(parent_SK: int, index: int) -> bytes:
+//     salt = I2OSP(index, 4)
+//     IKM = I2OSP(parent_SK, 32)
+//     lamport_0 = IKM_to_lamport_SK(IKM, salt)
+//     not_IKM = flip_bits(IKM)
+//     lamport_1 = IKM_to_lamport_SK(not_IKM, salt)
+//     lamport_SK = lamport_0 + lamport_1
+//     lamport_PK = b''
+//     for SK in lamport_SK:
+//       lamport_PK += SHA256(SK)
+//     compressed_lamport_PK = SHA256(lamport_PK)
+//     return compressed_lamport_PK
+func parentToLamportPK(parent []byte, index uint32) ([]byte, error) {
+	salt := make([]byte, 4)
+	binary.BigEndian.PutUint32(salt, index)
+	notParent := make([]byte, len(parent))
+	for i, b := range parent {
+		notParent[i] = ^b
+	}
+	lamportPK := sha256.New()
+	for _, ikm := range [][]byte{parent, notParent} {
+		okm := make([]byte, 32*lamportChunks)
+		if _, err := io.ReadFull(hkdf.New(sha256.New, ikm, salt, nil), okm); err != nil {
+			return nil, fmt.Errorf("could not expand lamport key material: %v", err)
+		}
+		for i := 0; i < lamportChunks; i++ {
+			h := sha256.Sum256(okm[32*i : 32*(i+1)])
+			lamportPK.Write(h[:])
+		}
+	}
+	return lamportPK.Sum(nil), nil
+}
@@ -0,0 +1,97 @@
+package keystore
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"testing"
+)
+
+// EIP-2333 test vectors.
+var derivationTests = []struct {
+	seed       string
+	masterSK   string
+	childIndex uint32
+	childSK    string
+}{
+	{
+		seed:       "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+		masterSK:   "6083874454709270928345386274498605044986640685124978867557563392430687146096",
+		childIndex: 0,
+		childSK:    "20397789859736650942317412262472558107875392172444076792671091975210932703118",
+	},
+	{
+		seed:       "3141592653589793238462643383279502884197169399375105820974944592",
+		masterSK:   "29757020647961307431480504535336562678282505419141012933316116377660817309383",
+		childIndex: 3141592653,
+		childSK:    "25457201688850691947727629385191704516744796114925897962676248250929345014287",
+	},
+}
+
+func decimalKey(t *testing.T, enc []byte) string {
+	t.Helper()
+	return new(big.Int).SetBytes(enc).String()
+}
+
+func TestDeriveSecretKey_TestVectors(t *testing.T) {
+	for _, tt := range derivationTests {
+		seed, err := hex.DecodeString(tt.seed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		master, err := DeriveMasterSecretKey(seed)
+		if err != nil {
+			t.Fatalf("Could not derive master key: %v", err)
+		}
+		if got := decimalKey(t, master.Marshal()); got != tt.masterSK {
+			t.Errorf("Master key is %s, expected %s", got, tt.masterSK)
+		}
+		child, err := DeriveChildSecretKey(master, tt.childIndex)
+		if err != nil {
+			t.Fatalf("Could not derive child key: %v", err)
+		}
+		if got := decimalKey(t, child.Marshal()); got != tt.childSK {
+			t.Errorf("Child key %d is %s, expected %s", tt.childIndex, got, tt.childSK)
+		}
+		byPath, err := DeriveSecretKey(seed, fmt.Sprintf("m/%d", tt.childIndex))
+		if err != nil {
+			t.Fatalf("Could not derive key by path: %v", err)
+		}
+		if got := decimalKey(t, byPath.Marshal()); got != tt.childSK {
+			t.Errorf("Key at path m/%d is %s, expected %s", tt.childIndex, got, tt.childSK)
+		}
+	}
+}
+
+func TestDeriveMasterSecretKey_ShortSeed(t *testing.T) {
+	if _, err := DeriveMasterSecretKey(make([]byte, 31)); err == nil {
+		t.Error("Expected an error for a seed shorter than 32 bytes")
+	}
+}
+
+func TestDeriveSecretKey_InvalidPath(t *testing.T) {
+	seed := make([]byte, 32)
+	for _, path := range []string{"", "12381/3600", "m/", "m/-1", "m/4294967296", "m/a/0"} {
+		if _, err := DeriveSecretKey(seed, path); err == nil {
+			t.Errorf("Expected an error for derivation path %q", path)
+		}
+	}
+}
+
+func TestNewKeyFromSeed_ValidatorAndWithdrawalKeysDiffer(t *testing.T) {
+	seed := make([]byte, 32)
+	keys := make(map[string]bool)
+	for i := 0; i < 2; i++ {
+		for _, path := range []string{ValidatorKeyPath, WithdrawalKeyPath} {
+			key, err := NewKeyFromSeed(seed, fmt.Sprintf(path, i))
+			if err != nil {
+				t.Fatal(err)
+			}
+			pub := hex.EncodeToString(key.PublicKey.Marshal())
+			if keys[pub] {
+				t.Errorf("Key at path %s was derived twice", fmt.Sprintf(path, i))
+			}
+			keys[pub] = true
+		}
+	}
+}
@@ -0,0 +1,265 @@
+package keystore
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pborman/uuid"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// EIP2335KDFScrypt selects scrypt to derive the decryption key of an EIP-2335 keystore.
+	EIP2335KDFScrypt = "scrypt"
+	// EIP2335KDFPBKDF2 selects PBKDF2 to derive the decryption key of an EIP-2335 keystore.
+	EIP2335KDFPBKDF2 = "pbkdf2"
+
+	eip2335Version     = 4
+	eip2335Cipher      = "aes-128-ctr"
+	eip2335Checksum    = "sha256"
+	eip2335PBKDF2C     = 1 << 18
+	eip2335PBKDF2PRF   = "hmac-sha256"
+	eip2335Description = "Validator signing key"
+)
+
+// ErrNotEIP2335 is returned when decrypting a keystore which is not in the EIP-2335 format.
+var ErrNotEIP2335 = errors.New("keystore is not an EIP-2335 keystore")
+
+// eip2335JSON is the layout of an EIP-2335 BLS keystore.
+type eip2335JSON struct {
+	Crypto      eip2335CryptoJSON `json:"crypto"`
+	Description string            `json:"description"`
+	PublicKey   string            `json:"pubkey"`
+	Path        string            `json:"path"`
+	ID          string            `json:"uuid"`
+	Version     uint              `json:"version"`
+}
+
+type eip2335CryptoJSON struct {
+	KDF      eip2335ModuleJSON `json:"kdf"`
+	Checksum eip2335ModuleJSON `json:"checksum"`
+	Cipher   eip2335ModuleJSON `json:"cipher"`
+}
+
+type eip2335ModuleJSON struct {
+	Function string                 `json:"function"`
+	Params   map[string]interface{} `json:"params"`
+	Message  string                 `json:"message"`
+}
+
+// EncryptKeyEIP2335 encrypts a key with a password into an EIP-2335 keystore, so
+// that it can be imported by other clients and tools. The key is derived from the
+// password with the kdf, either EIP2335KDFScrypt or EIP2335KDFPBKDF2, and path is
+// the EIP-2334 derivation path of the key, if known.
+func EncryptKeyEIP2335(key *Key, password string, path string, kdf string) ([]byte, error) {
+	salt := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, fmt.Errorf("could not generate salt: %v", err)
+	}
+	kdfParams := map[string]interface{}{
+		"dklen": scryptDKLen,
+		"salt":  hex.EncodeToString(salt),
+	}
+	switch kdf {
+	case EIP2335KDFScrypt:
+		kdfParams["n"] = StandardScryptN
+		kdfParams["r"] = scryptR
+		kdfParams["p"] = StandardScryptP
+	case EIP2335KDFPBKDF2:
+		kdfParams["c"] = eip2335PBKDF2C
+		kdfParams["prf"] = eip2335PBKDF2PRF
+	default:
+		return nil, fmt.Errorf("unsupported KDF: %s", kdf)
+	}
+	kdfModule := eip2335ModuleJSON{
+		Function: kdf,
+		Params:   kdfParams,
+	}
+	derivedKey, err := eip2335DecryptionKey(kdfModule, password)
+	if err != nil {
+		return nil, err
+	}
+
+	iv := make([]byte, aes.BlockSize)
+	if _, err := io.ReadFull(rand.Reader, iv); err != nil {
+		return nil, fmt.Errorf("could not generate iv: %v", err)
+	}
+	cipherText, err := aesCTRXOR(derivedKey[:16], key.SecretKey.Marshal(), iv)
+	if err != nil {
+		return nil, err
+	}
+	checksum := sha256.Sum256(append(append([]byte{}, derivedKey[16:32]...), cipherText...))
+
+	return json.MarshalIndent(&eip2335JSON{
+		Crypto: eip2335CryptoJSON{
+			KDF: kdfModule,
+			Checksum: eip2335ModuleJSON{
+				Function: eip2335Checksum,
+				Params:   map[string]interface{}{},
+				Message:  hex.EncodeToString(checksum[:]),
+			},
+			Cipher: eip2335ModuleJSON{
+				Function: eip2335Cipher,
+				Params:   map[string]interface{}{"iv": hex.EncodeToString(iv)},
+				Message:  hex.EncodeToString(cipherText),
+			},
+		},
+		Description: eip2335Description,
+		PublicKey:   hex.EncodeToString(key.PublicKey.Marshal()),
+		Path:        path,
+		ID:          key.ID.String(),
+		Version:     eip2335Version,
+	}, "", "  ")
+}
+
+// DecryptKeyEIP2335 decrypts the key of an EIP-2335 keystore with its password.
+func DecryptKeyEIP2335(keyjson []byte, password string) (*Key, error) {
+	// Check the version first, as the crypto section of other formats differs.
+	version := struct {
+		Version uint `json:"version"`
+	}{}
+	if err := json.Unmarshal(keyjson, &version); err != nil {
+		return nil, err
+	}
+	if version.Version != eip2335Version {
+		return nil, ErrNotEIP2335
+	}
+	k := new(eip2335JSON)
+	if err := json.Unmarshal(keyjson, k); err != nil {
+		return nil, err
+	}
+	if k.Crypto.Checksum.Function != eip2335Checksum {
+		return nil, fmt.Errorf("checksum not supported: %v", k.Crypto.Checksum.Function)
+	}
+	if k.Crypto.Cipher.Function != eip2335Cipher {
+		return nil, fmt.Errorf("cipher not supported: %v", k.Crypto.Cipher.Function)
+	}
+	checksum, err := hex.DecodeString(k.Crypto.Checksum.Message)
+	if err != nil {
+		return nil, err
+	}
+	iv, ok := k.Crypto.Cipher.Params["iv"].(string)
+	if !ok {
+		return nil, errors.New("missing cipher iv")
+	}
+	ivBytes, err := hex.DecodeString(iv)
+	if err != nil {
+		return nil, err
+	}
+	cipherText, err := hex.DecodeString(k.Crypto.Cipher.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	derivedKey, err := eip2335DecryptionKey(k.Crypto.KDF, password)
+	if err != nil {
+		return nil, err
+	}
+	calculatedChecksum := sha256.Sum256(append(append([]byte{}, derivedKey[16:32]...), cipherText...))
+	if !bytes.Equal(calculatedChecksum[:], checksum) {
+		return nil, ErrDecrypt
+	}
+	plainText, err := aesCTRXOR(derivedKey[:16], cipherText, ivBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	secretKey, err := bls.SecretKeyFromBytes(plainText)
+	if err != nil {
+		return nil, err
+	}
+	publicKey := secretKey.PublicKey()
+	// The public key is optional, but if present it must be the one of the secret key.
+	if k.PublicKey != "" && k.PublicKey != hex.EncodeToString(publicKey.Marshal()) {
+		return nil, fmt.Errorf("public key %s does not match the decrypted secret key", k.PublicKey)
+	}
+	id := uuid.Parse(k.ID)
+	if id == nil {
+		id = uuid.NewRandom()
+	}
+	return &Key{
+		ID:        id,
+		PublicKey: publicKey,
+		SecretKey: secretKey,
+	}, nil
+}
+
+// eip2335DecryptionKey derives the decryption key of an EIP-2335 keystore from
+// its password with the kdf module.
+func eip2335DecryptionKey(kdf eip2335ModuleJSON, password string) ([]byte, error) {
+	salt, ok := kdf.Params["salt"].(string)
+	if !ok {
+		return nil, errors.New("missing kdf salt")
+	}
+	saltBytes, err := hex.DecodeString(salt)
+	if err != nil {
+		return nil, err
+	}
+	dkLen, err := eip2335Param(kdf.Params, "dklen")
+	if err != nil {
+		return nil, err
+	}
+	// The checksum and cipher keys are the two halves of the first 32 bytes.
+	if dkLen < 32 {
+		return nil, fmt.Errorf("kdf dklen must be at least 32, received %d", dkLen)
+	}
+	auth := eip2335Password(password)
+
+	switch kdf.Function {
+	case EIP2335KDFScrypt:
+		var n, r, p int
+		if n, err = eip2335Param(kdf.Params, "n"); err != nil {
+			return nil, err
+		}
+		if r, err = eip2335Param(kdf.Params, "r"); err != nil {
+			return nil, err
+		}
+		if p, err = eip2335Param(kdf.Params, "p"); err != nil {
+			return nil, err
+		}
+		return scrypt.Key(auth, saltBytes, n, r, p, dkLen)
+	case EIP2335KDFPBKDF2:
+		if prf, _ := kdf.Params["prf"].(string); prf != eip2335PBKDF2PRF {
+			return nil, fmt.Errorf("unsupported PBKDF2 PRF: %s", prf)
+		}
+		c, err := eip2335Param(kdf.Params, "c")
+		if err != nil {
+			return nil, err
+		}
+		return pbkdf2.Key(auth, saltBytes, c, dkLen, sha256.New), nil
+	}
+	return nil, fmt.Errorf("unsupported KDF: %s", kdf.Function)
+}
+
+// eip2335Param returns the integer kdf parameter with the given name.
+func eip2335Param(params map[string]interface{}, name string) (int, error) {
+	switch v := params[name].(type) {
+	case int:
+		return v, nil
+	case float64:
+		return int(v), nil
+	}
+	return 0, fmt.Errorf("missing kdf parameter %s", name)
+}
+
+// eip2335Password returns the bytes of a password as EIP-2335 defines them: the
+// UTF-8 encoding of its NFKD normalization, without C0, C1 and delete control codes.
+func eip2335Password(password string) []byte {
+	return []byte(strings.Map(func(r rune) rune {
+		if r < 0x20 || (r >= 0x7f && r <= 0x9f) {
+			return -1
+		}
+		return r
+	}, norm.NFKD.String(password)))
+}
@@ -0,0 +1,163 @@
+package keystore
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/pborman/uuid"
+)
+
+// Test vectors from EIP-2335.
+const (
+	eip2335TestPassword = "\U0001d531\U0001d522\U0001d530\U0001d531\U0001d52d\U0001d51e\U0001d530\U0001d530\U0001d534\U0001d52c\U0001d52f\U0001d521\U0001f511"
+	eip2335TestSecret   = "000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f"
+
+	eip2335ScryptKeystore = `{
+    "crypto": {
+        "kdf": {
+            "function": "scrypt",
+            "params": {
+                "dklen": 32,
+                "n": 262144,
+                "p": 1,
+                "r": 8,
+                "salt": "d4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3"
+            },
+            "message": ""
+        },
+        "checksum": {
+            "function": "sha256",
+            "params": {},
+            "message": "d2217fe5f3e9a1e34581ef8a78f7c9928e436d36dacc5e846690a5581e8ea484"
+        },
+        "cipher": {
+            "function": "aes-128-ctr",
+            "params": {
+                "iv": "264daa3f303d7259501c93d997d84fe6"
+            },
+            "message": "06ae90d55fe0a6e9c5c3bc5b170827b2e5cce3929ed3f116c2811e6366dfe20f"
+        }
+    },
+    "description": "This is a test keystore that uses scrypt to secure the secret.",
+    "path": "m/12381/60/3141592653/589793238",
+    "uuid": "1d85ae20-35c5-4611-98e8-aa14a633906f",
+    "version": 4
+}`
+
+	eip2335PBKDF2Keystore = `{
+    "crypto": {
+        "kdf": {
+            "function": "pbkdf2",
+            "params": {
+                "dklen": 32,
+                "c": 262144,
+                "prf": "hmac-sha256",
+                "salt": "d4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3"
+            },
+            "message": ""
+        },
+        "checksum": {
+            "function": "sha256",
+            "params": {},
+            "message": "8a9f5d9912ed7e75ea794bc5a89bca5f193721d30868ade6f73043c6ea6febf1"
+        },
+        "cipher": {
+            "function": "aes-128-ctr",
+            "params": {
+                "iv": "264daa3f303d7259501c93d997d84fe6"
+            },
+            "message": "cee03fde2af33149775b7223e7845e4fb2c8ae1792e5f99fe9ecf474cc8c16ad"
+        }
+    },
+    "description": "This is a test keystore that uses PBKDF2 to secure the secret.",
+    "path": "m/12381/60/0/0",
+    "uuid": "64625def-3331-4eea-ab6f-782f3ed16a83",
+    "version": 4
+}`
+)
+
+func TestDecryptKeyEIP2335_TestVectors(t *testing.T) {
+	for name, keystore := range map[string]string{
+		"scrypt": eip2335ScryptKeystore,
+		"pbkdf2": eip2335PBKDF2Keystore,
+	} {
+		key, err := DecryptKeyEIP2335([]byte(keystore), eip2335TestPassword)
+		if err != nil {
+			t.Fatalf("Could not decrypt %s keystore: %v", name, err)
+		}
+		if secret := hex.EncodeToString(key.SecretKey.Marshal()); secret != eip2335TestSecret {
+			t.Errorf("Unexpected %s keystore secret key. want=%s got=%s", name, eip2335TestSecret, secret)
+		}
+		if key.ID.String() == "" {
+			t.Errorf("Expected the %s keystore uuid to be kept", name)
+		}
+	}
+}
+
+func TestDecryptKeyEIP2335_WrongPassword(t *testing.T) {
+	if _, err := DecryptKeyEIP2335([]byte(eip2335PBKDF2Keystore), "testpassword"); err != ErrDecrypt {
+		t.Errorf("Expected %v, received %v", ErrDecrypt, err)
+	}
+}
+
+func TestDecryptKeyEIP2335_NotEIP2335(t *testing.T) {
+	key, err := NewKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyjson, err := EncryptKey(key, "password", LightScryptN, LightScryptP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := DecryptKeyEIP2335(keyjson, "password"); err != ErrNotEIP2335 {
+		t.Errorf("Expected %v, received %v", ErrNotEIP2335, err)
+	}
+}
+
+func TestEncryptKeyEIP2335_RoundTrip(t *testing.T) {
+	key, err := NewKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, kdf := range []string{EIP2335KDFScrypt, EIP2335KDFPBKDF2} {
+		keyjson, err := EncryptKeyEIP2335(key, "password", "m/12381/3600/0/0/0", kdf)
+		if err != nil {
+			t.Fatalf("Could not encrypt key with %s: %v", kdf, err)
+		}
+		if !strings.Contains(string(keyjson), `"path": "m/12381/3600/0/0/0"`) {
+			t.Errorf("Expected the %s keystore to contain the derivation path: %s", kdf, keyjson)
+		}
+		decrypted, err := DecryptKeyEIP2335(keyjson, "password")
+		if err != nil {
+			t.Fatalf("Could not decrypt %s keystore: %v", kdf, err)
+		}
+		if !bytes.Equal(decrypted.SecretKey.Marshal(), key.SecretKey.Marshal()) {
+			t.Errorf("Decrypted %s keystore secret key differs from the encrypted one", kdf)
+		}
+		if !uuid.Equal(decrypted.ID, key.ID) {
+			t.Errorf("Decrypted %s keystore uuid differs. want=%s got=%s", kdf, key.ID, decrypted.ID)
+		}
+	}
+}
+
+func TestEncryptKeyEIP2335_UnsupportedKDF(t *testing.T) {
+	key, err := NewKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := EncryptKeyEIP2335(key, "password", "", "argon2"); err == nil {
+		t.Error("Expected an unsupported KDF error, received nil")
+	}
+}
+
+func TestEIP2335Password_StripsControlCodes(t *testing.T) {
+	if got := string(eip2335Password(eip2335TestPassword)); got != "testpassword\U0001f511" {
+		t.Errorf("Unexpected normalized password %q", got)
+	}
+	if got := string(eip2335Password("pass\x7fwo\u0085rd\n")); got != "password" {
+		t.Errorf("Unexpected normalized password %q", got)
+	}
+}
@@ -0,0 +1,39 @@
+package keystore
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/tyler-smith/go-bip39"
+)
+
+// mnemonicEntropyBytes is the entropy of a new mnemonic, which makes it 24 words long.
+const mnemonicEntropyBytes = 32
+
+// ErrInvalidMnemonic is returned for a mnemonic with unknown words, a wrong
+// number of words or a wrong checksum.
+var ErrInvalidMnemonic = errors.New("invalid mnemonic")
+
+// NewMnemonic generates a new random BIP-39 mnemonic, from which validator keys
+// can be derived and recovered with SeedFromMnemonic.
+func NewMnemonic(rand io.Reader) (string, error) {
+	entropy := make([]byte, mnemonicEntropyBytes)
+	if _, err := io.ReadFull(rand, entropy); err != nil {
+		return "", fmt.Errorf("could not generate entropy: %v", err)
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
+// SeedFromMnemonic returns the BIP-39 seed of a mnemonic protected by an
+// optional passphrase, which roots the key tree of DeriveSecretKey.
+func SeedFromMnemonic(mnemonic string, passphrase string) ([]byte, error) {
+	// Words separated by any whitespace, as when pasted from a backup, give
+	// the same seed.
+	mnemonic = strings.Join(strings.Fields(mnemonic), " ")
+	if _, err := bip39.MnemonicToByteArray(mnemonic); err != nil {
+		return nil, ErrInvalidMnemonic
+	}
+	return bip39.NewSeed(mnemonic, passphrase), nil
+}
@@ -0,0 +1,57 @@
+package keystore
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestSeedFromMnemonic_TestVector(t *testing.T) {
+	// The BIP-39 seed of this mnemonic is the seed of the first EIP-2333 test vector.
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	seed, err := SeedFromMnemonic(mnemonic, "TREZOR")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := hex.EncodeToString(seed); got != derivationTests[0].seed {
+		t.Errorf("Seed is %s, expected %s", got, derivationTests[0].seed)
+	}
+	spaced, err := SeedFromMnemonic("  "+strings.Replace(mnemonic, " ", "\n", -1)+"\n", "TREZOR")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(spaced, seed) {
+		t.Error("Expected the same seed for a mnemonic with other whitespace")
+	}
+}
+
+func TestSeedFromMnemonic_Invalid(t *testing.T) {
+	tests := []string{
+		"",
+		"abandon abandon abandon",
+		// Wrong checksum.
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon",
+		// Unknown word.
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon prysm",
+	}
+	for _, mnemonic := range tests {
+		if _, err := SeedFromMnemonic(mnemonic, ""); err != ErrInvalidMnemonic {
+			t.Errorf("Expected %v for mnemonic %q, received %v", ErrInvalidMnemonic, mnemonic, err)
+		}
+	}
+}
+
+func TestNewMnemonic_Recoverable(t *testing.T) {
+	mnemonic, err := NewMnemonic(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if words := len(strings.Fields(mnemonic)); words != 24 {
+		t.Errorf("Mnemonic has %d words, expected 24", words)
+	}
+	if _, err := SeedFromMnemonic(mnemonic, ""); err != nil {
+		t.Errorf("Could not recover seed from new mnemonic: %v", err)
+	}
+}
@@ -48,6 +48,7 @@ type Server struct {
 	topicMapping  map[reflect.Type]string
 	bootstrapNode string
 	relayNodeAddr string
+	refusedPeers  sync.Map // Peers disconnected with Disconnect, whose messages are dropped.
 }
 
 // ServerConfig for peer to peer networking.
@@ -190,6 +191,13 @@ func (s *Server) RegisterTopic(topic string, message proto.Message, adapters ...
 	}
 
 	handler := func(msg proto.Message, peerID peer.ID) {
+		if _, refused := s.refusedPeers.Load(peerID); refused {
+			log.WithFields(logrus.Fields{
+				"topic": topic,
+				"peer":  peerID,
+			}).Debug("Dropping message of disconnected peer")
+			return
+		}
 		log.WithField("topic", topic).Debug("Processing incoming message")
 		var h Handler = func(pMsg Message) {
 			s.emit(pMsg, feed)
@@ -316,6 +324,13 @@ func (s *Server) Send(ctx context.Context, msg proto.Message, peerID peer.ID) er
 	return w.WriteMsg(msg)
 }
 
+// Disconnect closes the connections to a peer and drops any message received from
+// it afterwards, such as a peer found to be on another chain.
+func (s *Server) Disconnect(peerID peer.ID) error {
+	s.refusedPeers.Store(peerID, true)
+	return s.host.Network().ClosePeer(peerID)
+}
+
 // Broadcast publishes a message to all localized peers using gossipsub.
 // msg must be a proto.Message that can be encoded into a byte array.
 // It publishes the first 100 chars of msg over the msg's mapped topic.
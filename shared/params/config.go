@@ -3,33 +3,38 @@
 package params
 
 import (
+	"fmt"
 	"math/big"
+	"strconv"
+	"strings"
 )
 
 // BeaconChainConfig contains constant configs for node to participate in beacon chain.
 type BeaconChainConfig struct {
 	// Misc constants.
-	ShardCount                   uint64 // ShardCount is the number of shard chains in Ethereum 2.0.
-	TargetCommitteeSize          uint64 // TargetCommitteeSize is the number of validators in a committee when the chain is healthy.
-	MaxBalanceChurnQuotient      uint64 // MaxBalanceChurnQuotient is used to determine how many validators can rotate per epoch.
-	BeaconChainShardNumber       uint64 // BeaconChainShardNumber is the shard number of the beacon chain.
-	MaxIndicesPerSlashableVote   uint64 // MaxIndicesPerSlashableVote is used to determine how many validators can be slashed per vote.
-	LatestBlockRootsLength       uint64 // LatestBlockRootsLength is the number of block roots kept in the beacon state.
-	LatestRandaoMixesLength      uint64 // LatestRandaoMixesLength is the number of randao mixes kept in the beacon state.
-	LatestSlashedExitLength      uint64 // LatestSlashedExitLength is used to track penalized exit balances per time interval.
-	LatestActiveIndexRootsLength uint64 // LatestIndexRootsLength is the number of index roots kept in beacon state, used by light client.
-	MaxExitDequeuesPerEpoch      uint64 // MaxWithdrawalsPerEpoch is the max withdrawals can happen for a single epoch.
-	ValidatorPrivkeyFileName     string // ValidatorPrivKeyFileName specifies the string name of a validator private key file.
-	WithdrawalPrivkeyFileName    string // WithdrawalPrivKeyFileName specifies the string name of a withdrawal private key file.
-	BLSPubkeyLength              int    // BLSPubkeyLength defines the expected length of BLS public keys in bytes.
+	ShardCount                    uint64 // ShardCount is the number of shard chains in Ethereum 2.0.
+	TargetCommitteeSize           uint64 // TargetCommitteeSize is the number of validators in a committee when the chain is healthy.
+	MaxBalanceChurnQuotient       uint64 // MaxBalanceChurnQuotient is used to determine how many validators can rotate per epoch.
+	BeaconChainShardNumber        uint64 // BeaconChainShardNumber is the shard number of the beacon chain.
+	MaxIndicesPerSlashableVote    uint64 // MaxIndicesPerSlashableVote is used to determine how many validators can be slashed per vote.
+	LatestBlockRootsLength        uint64 // LatestBlockRootsLength is the number of block roots kept in the beacon state.
+	LatestRandaoMixesLength       uint64 // LatestRandaoMixesLength is the number of randao mixes kept in the beacon state.
+	LatestSlashedExitLength       uint64 // LatestSlashedExitLength is used to track penalized exit balances per time interval.
+	LatestActiveIndexRootsLength  uint64 // LatestIndexRootsLength is the number of index roots kept in beacon state, used by light client.
+	MaxExitDequeuesPerEpoch       uint64 // MaxWithdrawalsPerEpoch is the max withdrawals can happen for a single epoch.
+	ValidatorPrivkeyFileName      string // ValidatorPrivKeyFileName specifies the string name of a validator private key file.
+	WithdrawalPrivkeyFileName     string // WithdrawalPrivKeyFileName specifies the string name of a withdrawal private key file.
+	BLSPubkeyLength               int    // BLSPubkeyLength defines the expected length of BLS public keys in bytes.
+	TargetAggregatorsPerCommittee uint64 // TargetAggregatorsPerCommittee is the number of committee members expected to aggregate the attestations of a committee.
 
 	// BLS domain values.
-	DomainDeposit     uint64 // DomainDeposit defines the BLS signature domain for deposit verification.
-	DomainAttestation uint64 // DomainAttestation defines the BLS signature domain for attestation verification.
-	DomainProposal    uint64 // DomainProposal defines the BLS signature domain for proposal verification.
-	DomainExit        uint64 // DomainExit defines the BLS signature domain for exit verification.
-	DomainRandao      uint64 // DomainRandao defines the BLS signature domain for randao verification.
-	DomainTransfer    uint64 // DomainTransfer defines the BLS signature domain for transfer verification.
+	DomainDeposit        uint64 // DomainDeposit defines the BLS signature domain for deposit verification.
+	DomainAttestation    uint64 // DomainAttestation defines the BLS signature domain for attestation verification.
+	DomainProposal       uint64 // DomainProposal defines the BLS signature domain for proposal verification.
+	DomainExit           uint64 // DomainExit defines the BLS signature domain for exit verification.
+	DomainRandao         uint64 // DomainRandao defines the BLS signature domain for randao verification.
+	DomainTransfer       uint64 // DomainTransfer defines the BLS signature domain for transfer verification.
+	DomainSelectionProof uint64 // DomainSelectionProof defines the BLS signature domain for the selection proofs of attestation aggregators.
 
 	// Deposit contract constants.
 	DepositContractAddress   []byte // DepositContractAddress is the address of the deposit contract in PoW chain.
@@ -50,6 +55,9 @@ type BeaconChainConfig struct {
 	EmptySignature          [96]byte // EmptySignature is used to represent a zeroed out BLS Signature.
 	BLSWithdrawalPrefixByte byte     // BLSWithdrawalPrefixByte is used for BLS withdrawal and it's the first byte.
 
+	// Fork constants.
+	ForkSchedule map[uint64]uint64 // ForkSchedule maps the number of epochs since genesis at which a fork activates to the version it activates.
+
 	// Time parameters constants.
 	SecondsPerSlot               uint64 // SecondsPerSlot is how many seconds are in a single slot.
 	MinAttestationInclusionDelay uint64 // MinAttestationInclusionDelay defines how long validator has to wait to include attestation for beacon block.
@@ -99,27 +107,29 @@ type ShardChainConfig struct {
 
 var defaultBeaconConfig = &BeaconChainConfig{
 	// Misc constant.
-	ShardCount:                   1024,
-	TargetCommitteeSize:          128,
-	MaxBalanceChurnQuotient:      32,
-	BeaconChainShardNumber:       1<<64 - 1,
-	MaxIndicesPerSlashableVote:   4096,
-	LatestBlockRootsLength:       8192,
-	LatestRandaoMixesLength:      8192,
-	LatestSlashedExitLength:      8192,
-	LatestActiveIndexRootsLength: 8192,
-	MaxExitDequeuesPerEpoch:      4,
-	ValidatorPrivkeyFileName:     "/validatorprivatekey",
-	WithdrawalPrivkeyFileName:    "/shardwithdrawalkey",
-	BLSPubkeyLength:              96,
+	ShardCount:                    1024,
+	TargetCommitteeSize:           128,
+	MaxBalanceChurnQuotient:       32,
+	BeaconChainShardNumber:        1<<64 - 1,
+	MaxIndicesPerSlashableVote:    4096,
+	LatestBlockRootsLength:        8192,
+	LatestRandaoMixesLength:       8192,
+	LatestSlashedExitLength:       8192,
+	LatestActiveIndexRootsLength:  8192,
+	MaxExitDequeuesPerEpoch:       4,
+	ValidatorPrivkeyFileName:      "/validatorprivatekey",
+	WithdrawalPrivkeyFileName:     "/shardwithdrawalkey",
+	BLSPubkeyLength:               96,
+	TargetAggregatorsPerCommittee: 16,
 
 	// BLS domain values.
-	DomainDeposit:     0,
-	DomainAttestation: 1,
-	DomainProposal:    2,
-	DomainExit:        3,
-	DomainRandao:      4,
-	DomainTransfer:    5,
+	DomainDeposit:        0,
+	DomainAttestation:    1,
+	DomainProposal:       2,
+	DomainExit:           3,
+	DomainRandao:         4,
+	DomainTransfer:       5,
+	DomainSelectionProof: 6,
 
 	// Deposit contract constants.
 	DepositContractTreeDepth: 32,
@@ -140,6 +150,9 @@ var defaultBeaconConfig = &BeaconChainConfig{
 	EmptySignature:          [96]byte{},
 	BLSWithdrawalPrefixByte: byte(0),
 
+	// Fork constants.
+	ForkSchedule: map[uint64]uint64{},
+
 	// Time parameter constants.
 	SecondsPerSlot:               6,
 	MinAttestationInclusionDelay: 4,
@@ -242,3 +255,34 @@ func UseDemoBeaconConfig() {
 func OverrideBeaconConfig(c *BeaconChainConfig) {
 	beaconConfig = c
 }
+
+// ParseForkSchedule parses a comma separated list of epoch:version pairs, such as
+// "100:1,200:2", into a fork schedule. Epochs are counted from the genesis epoch.
+func ParseForkSchedule(schedule string) (map[uint64]uint64, error) {
+	forks := make(map[uint64]uint64)
+	if strings.TrimSpace(schedule) == "" {
+		return forks, nil
+	}
+	for _, fork := range strings.Split(schedule, ",") {
+		parts := strings.Split(strings.TrimSpace(fork), ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("fork %q is not of the form epoch:version", fork)
+		}
+		epoch, err := strconv.ParseUint(parts[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse fork epoch %q: %v", parts[0], err)
+		}
+		if epoch == 0 {
+			return nil, fmt.Errorf("fork %q cannot activate at genesis, use the genesis fork version instead", fork)
+		}
+		version, err := strconv.ParseUint(parts[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse fork version %q: %v", parts[1], err)
+		}
+		if _, ok := forks[epoch]; ok {
+			return nil, fmt.Errorf("more than one fork scheduled at epoch %d", epoch)
+		}
+		forks[epoch] = version
+	}
+	return forks, nil
+}
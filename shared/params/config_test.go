@@ -1,6 +1,7 @@
 package params
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -12,3 +13,21 @@ func TestOverrideBeaconConfig(t *testing.T) {
 		t.Errorf("Shardcount in BeaconConfig incorrect. Wanted %d, got %d", 5, c.ShardCount)
 	}
 }
+
+func TestParseForkSchedule(t *testing.T) {
+	forks, err := ParseForkSchedule("10:1, 20:2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(forks, map[uint64]uint64{10: 1, 20: 2}) {
+		t.Errorf("Wanted fork schedule %v, received %v", map[uint64]uint64{10: 1, 20: 2}, forks)
+	}
+	if forks, err := ParseForkSchedule(""); err != nil || len(forks) != 0 {
+		t.Errorf("Wanted empty fork schedule, received %v, %v", forks, err)
+	}
+	for _, schedule := range []string{"10", "a:1", "10:b", "0:1", "10:1,10:2"} {
+		if _, err := ParseForkSchedule(schedule); err == nil {
+			t.Errorf("Expected fork schedule %q to be rejected", schedule)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package ssz
+
+import (
+	"math/bits"
+	"reflect"
+)
+
+var (
+	bitlistType   = reflect.TypeOf(Bitlist{})
+	bitvectorType = reflect.TypeOf(Bitvector{})
+)
+
+// Bitlist is a variable-length list of bits, packed least significant bit
+// first. A delimiting bit is set right after the last bit of the list to mark
+// its length, so a valid bitlist is never empty and its last byte is never zero.
+//
+// The maximum number of bits of a bitlist field is given by its ssz-max tag.
+type Bitlist []byte
+
+// NewBitlist creates a bitlist of n bits which are all unset.
+func NewBitlist(n uint64) Bitlist {
+	b := make(Bitlist, n/8+1)
+	b[n/8] = 1 << (n % 8)
+	return b
+}
+
+// Len returns the number of bits in the list, or 0 if the delimiting bit is missing.
+func (b Bitlist) Len() uint64 {
+	if len(b) == 0 || b[len(b)-1] == 0 {
+		return 0
+	}
+	last := b[len(b)-1]
+	return uint64(len(b)-1)*8 + uint64(bits.Len8(last)) - 1
+}
+
+// BitAt returns whether the bit at index i is set. It returns false if i is
+// out of range.
+func (b Bitlist) BitAt(i uint64) bool {
+	if i >= b.Len() {
+		return false
+	}
+	return b[i/8]&(1<<(i%8)) != 0
+}
+
+// SetBitAt sets or unsets the bit at index i. It does nothing if i is out of range.
+func (b Bitlist) SetBitAt(i uint64, v bool) {
+	if i >= b.Len() {
+		return
+	}
+	if v {
+		b[i/8] |= 1 << (i % 8)
+	} else {
+		b[i/8] &^= 1 << (i % 8)
+	}
+}
+
+// Bytes returns the bits of the list without the delimiting bit, packed into
+// the smallest number of bytes.
+func (b Bitlist) Bytes() []byte {
+	n := b.Len()
+	if n == 0 {
+		return []byte{}
+	}
+	out := make([]byte, (n+7)/8)
+	copy(out, b)
+	if n%8 != 0 {
+		out[len(out)-1] &= 1<<(n%8) - 1
+	}
+	return out
+}
+
+// Bitvector is a fixed-length list of bits, packed least significant bit
+// first. The number of bits of a bitvector field is given by its ssz-size tag.
+type Bitvector []byte
+
+// NewBitvector creates a bitvector of n bits which are all unset.
+func NewBitvector(n uint64) Bitvector {
+	return make(Bitvector, (n+7)/8)
+}
+
+// BitAt returns whether the bit at index i is set. It returns false if i is
+// out of range.
+func (b Bitvector) BitAt(i uint64) bool {
+	if i/8 >= uint64(len(b)) {
+		return false
+	}
+	return b[i/8]&(1<<(i%8)) != 0
+}
+
+// SetBitAt sets or unsets the bit at index i. It does nothing if i is out of range.
+func (b Bitvector) SetBitAt(i uint64, v bool) {
+	if i/8 >= uint64(len(b)) {
+		return
+	}
+	if v {
+		b[i/8] |= 1 << (i % 8)
+	} else {
+		b[i/8] &^= 1 << (i % 8)
+	}
+}
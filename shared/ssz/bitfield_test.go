@@ -0,0 +1,44 @@
+package ssz
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestBitlist(t *testing.T) {
+	b := NewBitlist(10)
+	if !bytes.Equal(b, []byte{0x00, 0x04}) {
+		t.Errorf("NewBitlist(10) returned %#x, expected 0x0004", []byte(b))
+	}
+	if b.Len() != 10 {
+		t.Errorf("Expected length 10, got %d", b.Len())
+	}
+	b.SetBitAt(0, true)
+	b.SetBitAt(9, true)
+	b.SetBitAt(10, true)
+	if !b.BitAt(0) || !b.BitAt(9) || b.BitAt(1) || b.BitAt(10) {
+		t.Errorf("Unexpected bits in %#x", []byte(b))
+	}
+	if !bytes.Equal(b.Bytes(), []byte{0x01, 0x02}) {
+		t.Errorf("Bytes returned %#x, expected 0x0102", b.Bytes())
+	}
+	b.SetBitAt(0, false)
+	if b.BitAt(0) {
+		t.Error("Expected bit 0 to be unset")
+	}
+	if (Bitlist{0x01}).Len() != 0 || (Bitlist{}).Len() != 0 || (Bitlist{0x01, 0x00}).Len() != 0 {
+		t.Error("Expected empty and invalid bitlists to have length 0")
+	}
+}
+
+func TestBitvector(t *testing.T) {
+	b := NewBitvector(10)
+	if len(b) != 2 {
+		t.Fatalf("Expected 2 bytes, got %d", len(b))
+	}
+	b.SetBitAt(9, true)
+	b.SetBitAt(16, true)
+	if !b.BitAt(9) || b.BitAt(8) || b.BitAt(16) {
+		t.Errorf("Unexpected bits in %#x", []byte(b))
+	}
+}
@@ -2,9 +2,9 @@ package ssz
 
 import (
 	"encoding/binary"
-	"errors"
 	"fmt"
 	"io"
+	"math"
 	"reflect"
 )
 
@@ -13,12 +13,91 @@ type Decodable interface {
 	DecodeSSZ(io.Reader) error
 }
 
+// Unmarshaler is implemented by types with generated decoding methods, which
+// Decode prefers over the reflective decoder unless limits are set for the
+// values nested in them.
+type Unmarshaler interface {
+	UnmarshalSSZ([]byte) error
+}
+
+// DefaultMaxDepth is the maximum nesting depth of lists, vectors and
+// containers which a Decoder accepts unless SetMaxDepth is called.
+const DefaultMaxDepth = 32
+
+// readChunkSize is the size of the chunks in which byte slices are read, so
+// that a length prefix can't make the decoder allocate much more memory than
+// the input holds.
+const readChunkSize = 1 << 16
+
 // Decode decodes data read from r and output it into the object pointed by pointer val.
+// It uses a Decoder with the default settings, which doesn't limit the size of
+// the value. Use a Decoder with SetMaxSize to decode values from untrusted input.
 func Decode(r io.Reader, val interface{}) error {
-	return decode(r, val)
+	return NewDecoder(r).Decode(val)
+}
+
+// Decoder decodes a stream of values from an input. Lengths read from the input
+// are checked against the size of the value they are nested in, and against the
+// limits set with SetMaxSize and SetTypeMaxSize, before anything is allocated
+// for them, and byte slices are read in chunks. Errors report the position in
+// the stream at which decoding failed.
+type Decoder struct {
+	r            io.Reader
+	maxSize      uint64
+	maxDepth     int
+	typeMaxSizes map[reflect.Type]uint32
+
+	// pos is the number of bytes read from r, and end the position which the
+	// value being decoded must not be read past.
+	pos     uint64
+	end     uint64
+	depth   int
+	scratch [8]byte
+
+	// errPos is the position of the first error of the value being decoded.
+	errPos uint64
+	failed bool
+}
+
+// NewDecoder returns a decoder which reads from r.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{
+		r:            r,
+		maxDepth:     DefaultMaxDepth,
+		typeMaxSizes: make(map[reflect.Type]uint32),
+		end:          math.MaxUint64,
+	}
 }
 
-func decode(r io.Reader, val interface{}) error {
+// SetMaxSize limits the number of bytes read for each decoded value. A size of
+// 0 means no limit.
+func (d *Decoder) SetMaxSize(size uint64) {
+	d.maxSize = size
+}
+
+// SetMaxDepth limits how deeply lists, vectors and containers may be nested in
+// a decoded value.
+func (d *Decoder) SetMaxDepth(depth int) {
+	d.maxDepth = depth
+}
+
+// SetTypeMaxSize limits the size, as given by their length prefix, of values
+// with the type of val or, if val is a pointer, the type it points to. For
+// example, SetTypeMaxSize([]byte{}, 96) rejects byte slices longer than 96 bytes.
+func (d *Decoder) SetTypeMaxSize(val interface{}, size uint32) {
+	d.typeMaxSizes[baseType(reflect.TypeOf(val))] = size
+}
+
+// limitsNestedValues returns true if limits were set with SetTypeMaxSize or
+// SetMaxDepth, which the generated decoding methods don't check for the values
+// nested in the value they decode.
+func (d *Decoder) limitsNestedValues() bool {
+	return len(d.typeMaxSizes) > 0 || d.maxDepth != DefaultMaxDepth
+}
+
+// Decode decodes the next value from the input and outputs it into the object
+// pointed by pointer val.
+func (d *Decoder) Decode(val interface{}) error {
 	if val == nil {
 		return newDecodeError("cannot decode into nil", nil)
 	}
@@ -31,12 +110,23 @@ func decode(r io.Reader, val interface{}) error {
 	if rval.IsNil() {
 		return newDecodeError("cannot output to pointer of nil", rtyp)
 	}
+	d.depth, d.failed = 0, false
+	d.end = math.MaxUint64
+	if d.maxSize > 0 {
+		d.end = d.pos + d.maxSize
+	}
+	if u, ok := val.(Unmarshaler); ok && !d.limitsNestedValues() {
+		if _, err := decodeGenerated(d, rtyp, u); err != nil {
+			return d.decodeError(err, rval.Elem().Type())
+		}
+		return nil
+	}
 	sszUtils, err := cachedSSZUtils(rval.Elem().Type())
 	if err != nil {
 		return newDecodeError(fmt.Sprint(err), rval.Elem().Type())
 	}
-	if _, err = sszUtils.decoder(r, rval.Elem()); err != nil {
-		return newDecodeError(fmt.Sprint(err), rval.Elem().Type())
+	if _, err = sszUtils.decoder(d, rval.Elem()); err != nil {
+		return d.decodeError(err, rval.Elem().Type())
 	}
 	return nil
 }
@@ -53,7 +143,7 @@ func makeDecoder(typ reflect.Type) (dec decoder, err error) {
 	case kind == reflect.Uint32:
 		return decodeUint32, nil
 	case kind == reflect.Int32:
-		return decodeUint32, nil
+		return decodeInt32, nil
 	case kind == reflect.Uint64:
 		return decodeUint64, nil
 	case kind == reflect.Slice && typ.Elem().Kind() == reflect.Uint8:
@@ -73,9 +163,10 @@ func makeDecoder(typ reflect.Type) (dec decoder, err error) {
 	}
 }
 
-func decodeBool(r io.Reader, val reflect.Value) (uint32, error) {
-	b := make([]byte, 1)
-	if err := readBytes(r, 1, b); err != nil {
+func decodeBool(d *Decoder, val reflect.Value) (uint32, error) {
+	start := d.pos
+	b := d.scratch[:1]
+	if err := d.read(b); err != nil {
 		return 0, err
 	}
 	v := uint8(b[0])
@@ -84,80 +175,88 @@ func decodeBool(r io.Reader, val reflect.Value) (uint32, error) {
 	} else if v == 1 {
 		val.SetBool(true)
 	} else {
-		return 0, fmt.Errorf("expect 0 or 1 for decoding bool but got %d", v)
+		return 0, d.errorAt(start, "expect 0 or 1 for decoding bool but got %d", v)
 	}
 	return 1, nil
 }
 
-func decodeUint8(r io.Reader, val reflect.Value) (uint32, error) {
-	b := make([]byte, 1)
-	if err := readBytes(r, 1, b); err != nil {
+func decodeUint8(d *Decoder, val reflect.Value) (uint32, error) {
+	b := d.scratch[:1]
+	if err := d.read(b); err != nil {
 		return 0, err
 	}
 	val.SetUint(uint64(b[0]))
 	return 1, nil
 }
 
-func decodeUint16(r io.Reader, val reflect.Value) (uint32, error) {
-	b := make([]byte, 2)
-	if err := readBytes(r, 2, b); err != nil {
+func decodeUint16(d *Decoder, val reflect.Value) (uint32, error) {
+	b := d.scratch[:2]
+	if err := d.read(b); err != nil {
 		return 0, err
 	}
 	val.SetUint(uint64(binary.LittleEndian.Uint16(b)))
 	return 2, nil
 }
 
-func decodeUint32(r io.Reader, val reflect.Value) (uint32, error) {
-	b := make([]byte, 4)
-	if err := readBytes(r, 4, b); err != nil {
+func decodeUint32(d *Decoder, val reflect.Value) (uint32, error) {
+	b := d.scratch[:4]
+	if err := d.read(b); err != nil {
 		return 0, err
 	}
 	val.SetUint(uint64(binary.LittleEndian.Uint32(b)))
 	return 4, nil
 }
 
-func decodeUint64(r io.Reader, val reflect.Value) (uint32, error) {
-	b := make([]byte, 8)
-	if err := readBytes(r, 8, b); err != nil {
+func decodeInt32(d *Decoder, val reflect.Value) (uint32, error) {
+	b := d.scratch[:4]
+	if err := d.read(b); err != nil {
+		return 0, err
+	}
+	val.SetInt(int64(int32(binary.LittleEndian.Uint32(b))))
+	return 4, nil
+}
+
+func decodeUint64(d *Decoder, val reflect.Value) (uint32, error) {
+	b := d.scratch[:8]
+	if err := d.read(b); err != nil {
 		return 0, err
 	}
 	val.SetUint(uint64(binary.LittleEndian.Uint64(b)))
 	return 8, nil
 }
 
-func decodeBytes(r io.Reader, val reflect.Value) (uint32, error) {
-	sizeEnc := make([]byte, lengthBytes)
-	if err := readBytes(r, lengthBytes, sizeEnc); err != nil {
+func decodeBytes(d *Decoder, val reflect.Value) (uint32, error) {
+	size, err := d.readLength(val.Type())
+	if err != nil {
 		return 0, err
 	}
-	size := binary.LittleEndian.Uint32(sizeEnc)
 
 	if size == 0 {
 		val.SetBytes([]byte{})
 		return lengthBytes, nil
 	}
 
-	b := make([]byte, size)
-	if err := readBytes(r, int(size), b); err != nil {
+	b, err := d.readAppend(nil, size)
+	if err != nil {
 		return 0, err
 	}
 	val.SetBytes(b)
 	return lengthBytes + size, nil
 }
 
-func decodeByteArray(r io.Reader, val reflect.Value) (uint32, error) {
-	sizeEnc := make([]byte, lengthBytes)
-	if err := readBytes(r, lengthBytes, sizeEnc); err != nil {
+func decodeByteArray(d *Decoder, val reflect.Value) (uint32, error) {
+	start := d.pos
+	size, err := d.readLength(val.Type())
+	if err != nil {
 		return 0, err
 	}
-	size := binary.LittleEndian.Uint32(sizeEnc)
 
 	if size != uint32(val.Len()) {
-		return 0, fmt.Errorf("input byte array size (%d) isn't euqal to output array size (%d)", size, val.Len())
+		return 0, d.errorAt(start, "input byte array size (%d) isn't euqal to output array size (%d)", size, val.Len())
 	}
 
 	slice := val.Slice(0, val.Len()).Interface().([]byte)
-	if err := readBytes(r, int(size), slice); err != nil {
+	if err := d.read(slice); err != nil {
 		return 0, err
 	}
 	return lengthBytes + size, nil
@@ -169,18 +268,22 @@ func makeSliceDecoder(typ reflect.Type) (decoder, error) {
 	if err != nil {
 		return nil, err
 	}
-	decoder := func(r io.Reader, val reflect.Value) (uint32, error) {
-		sizeEnc := make([]byte, lengthBytes)
-		if err := readBytes(r, lengthBytes, sizeEnc); err != nil {
+	decoder := func(d *Decoder, val reflect.Value) (uint32, error) {
+		size, err := d.readLength(typ)
+		if err != nil {
 			return 0, fmt.Errorf("failed to decode header of slice: %v", err)
 		}
-		size := binary.LittleEndian.Uint32(sizeEnc)
 
 		if size == 0 {
 			// We prefer decode into nil, not empty slice
 			return lengthBytes, nil
 		}
 
+		parentEnd, err := d.enter(size)
+		if err != nil {
+			return 0, err
+		}
+		defer d.leave(parentEnd)
 		for i, decodeSize := 0, uint32(0); decodeSize < size; i++ {
 			// Grow slice's capacity if necessary
 			if i >= val.Cap() {
@@ -200,7 +303,7 @@ func makeSliceDecoder(typ reflect.Type) (decoder, error) {
 			}
 
 			// Decode and write into the new element
-			elemDecodeSize, err := elemSSZUtils.decoder(r, val.Index(i))
+			elemDecodeSize, err := elemSSZUtils.decoder(d, val.Index(i))
 			if err != nil {
 				return 0, fmt.Errorf("failed to decode element of slice: %v", err)
 			}
@@ -217,26 +320,30 @@ func makeArrayDecoder(typ reflect.Type) (decoder, error) {
 	if err != nil {
 		return nil, err
 	}
-	decoder := func(r io.Reader, val reflect.Value) (uint32, error) {
-		sizeEnc := make([]byte, lengthBytes)
-		if err := readBytes(r, lengthBytes, sizeEnc); err != nil {
+	decoder := func(d *Decoder, val reflect.Value) (uint32, error) {
+		size, err := d.readLength(typ)
+		if err != nil {
 			return 0, fmt.Errorf("failed to decode header of slice: %v", err)
 		}
-		size := binary.LittleEndian.Uint32(sizeEnc)
 
+		parentEnd, err := d.enter(size)
+		if err != nil {
+			return 0, err
+		}
+		defer d.leave(parentEnd)
 		i, decodeSize := 0, uint32(0)
 		for ; i < val.Len() && decodeSize < size; i++ {
-			elemDecodeSize, err := elemSSZUtils.decoder(r, val.Index(i))
+			elemDecodeSize, err := elemSSZUtils.decoder(d, val.Index(i))
 			if err != nil {
 				return 0, fmt.Errorf("failed to decode element of slice: %v", err)
 			}
 			decodeSize += elemDecodeSize
 		}
 		if i < val.Len() {
-			return 0, errors.New("input is too short")
+			return 0, d.errorAt(d.pos, "input is too short")
 		}
 		if decodeSize < size {
-			return 0, errors.New("input is too long")
+			return 0, d.errorAt(d.pos, "input is too long")
 		}
 		return lengthBytes + size, nil
 	}
@@ -248,31 +355,35 @@ func makeStructDecoder(typ reflect.Type) (decoder, error) {
 	if err != nil {
 		return nil, err
 	}
-	decoder := func(r io.Reader, val reflect.Value) (uint32, error) {
-		sizeEnc := make([]byte, lengthBytes)
-		if err := readBytes(r, lengthBytes, sizeEnc); err != nil {
+	decoder := func(d *Decoder, val reflect.Value) (uint32, error) {
+		size, err := d.readLength(typ)
+		if err != nil {
 			return 0, fmt.Errorf("failed to decode header of struct: %v", err)
 		}
-		size := binary.LittleEndian.Uint32(sizeEnc)
 
 		if size == 0 {
 			return lengthBytes, nil
 		}
 
+		parentEnd, err := d.enter(size)
+		if err != nil {
+			return 0, err
+		}
+		defer d.leave(parentEnd)
 		i, decodeSize := 0, uint32(0)
 		for ; i < len(fields) && decodeSize < size; i++ {
 			f := fields[i]
-			fieldDecodeSize, err := f.sszUtils.decoder(r, val.Field(f.index))
+			fieldDecodeSize, err := f.sszUtils.decoder(d, val.Field(f.index))
 			if err != nil {
 				return 0, fmt.Errorf("failed to decode field of slice: %v", err)
 			}
 			decodeSize += fieldDecodeSize
 		}
 		if i < len(fields) {
-			return 0, errors.New("input is too short")
+			return 0, d.errorAt(d.pos, "input is too short")
 		}
 		if decodeSize < size {
-			return 0, errors.New("input is too long")
+			return 0, d.errorAt(d.pos, "input is too long")
 		}
 		return lengthBytes + size, nil
 	}
@@ -290,9 +401,9 @@ func makePtrDecoder(typ reflect.Type) (decoder, error) {
 	// - We assume we will only encode/decode pointer of array, slice or struct.
 	// - The encoding for nil pointer shall be 0x00000000.
 
-	decoder := func(r io.Reader, val reflect.Value) (uint32, error) {
+	decoder := func(d *Decoder, val reflect.Value) (uint32, error) {
 		newVal := reflect.New(elemType)
-		elemDecodeSize, err := elemSSZUtils.decoder(r, newVal.Elem())
+		elemDecodeSize, err := elemSSZUtils.decoder(d, newVal.Elem())
 		if err != nil {
 			return 0, fmt.Errorf("failed to decode to object pointed by pointer: %v", err)
 		}
@@ -304,30 +415,137 @@ func makePtrDecoder(typ reflect.Type) (decoder, error) {
 	return decoder, nil
 }
 
-func readBytes(r io.Reader, size int, b []byte) error {
-	if size != len(b) {
-		return fmt.Errorf("output buffer size is %d while expected read size is %d", len(b), size)
+// baseType returns the type which typ points to, if it is a pointer type.
+func baseType(typ reflect.Type) reflect.Type {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
 	}
-	readLen, err := r.Read(b)
-	if readLen != size {
-		return fmt.Errorf("can only read %d bytes while expected to read %d bytes", readLen, size)
+	return typ
+}
+
+// read fills b with the next bytes of the input.
+func (d *Decoder) read(b []byte) error {
+	start := d.pos
+	if uint64(len(b)) > d.end-d.pos {
+		return d.errorAt(start, "reading %d bytes exceeds the %d bytes available", len(b), d.end-d.pos)
 	}
+	n, err := io.ReadFull(d.r, b)
+	d.pos += uint64(n)
 	if err != nil {
-		return fmt.Errorf("failed to read from input: %v", err)
+		return d.readError(start, len(b), err)
 	}
 	return nil
 }
 
+// readAppend appends the next n bytes of the input to buf. The bytes are read
+// in chunks, so that at most about twice the bytes actually read are allocated
+// when the input ends early.
+func (d *Decoder) readAppend(buf []byte, n uint32) ([]byte, error) {
+	start := d.pos
+	if uint64(n) > d.end-d.pos {
+		return nil, d.errorAt(start, "reading %d bytes exceeds the %d bytes available", n, d.end-d.pos)
+	}
+	total := len(buf) + int(n)
+	for len(buf) < total {
+		if len(buf) == cap(buf) {
+			newCap := 2 * cap(buf)
+			if newCap < readChunkSize {
+				newCap = readChunkSize
+			}
+			if newCap > total {
+				newCap = total
+			}
+			grown := make([]byte, len(buf), newCap)
+			copy(grown, buf)
+			buf = grown
+		}
+		m, err := io.ReadFull(d.r, buf[len(buf):cap(buf)])
+		buf = buf[:len(buf)+m]
+		d.pos += uint64(m)
+		if err != nil {
+			return nil, d.readError(start, int(n), err)
+		}
+	}
+	return buf, nil
+}
+
+// readLength reads the length prefix of a value of type typ, and checks it
+// against the limit for typ and the bytes available.
+func (d *Decoder) readLength(typ reflect.Type) (uint32, error) {
+	start := d.pos
+	b := d.scratch[:lengthBytes]
+	if err := d.read(b); err != nil {
+		return 0, err
+	}
+	size := binary.LittleEndian.Uint32(b)
+	if max, ok := d.typeMaxSizes[baseType(typ)]; ok && size > max {
+		return 0, d.errorAt(start, "size %d exceeds the maximum size %d of %v", size, max, typ)
+	}
+	if uint64(size) > d.end-d.pos {
+		return 0, d.errorAt(start, "size %d exceeds the %d bytes available", size, d.end-d.pos)
+	}
+	return size, nil
+}
+
+// enter starts decoding the elements or fields of a value of the given size,
+// which must not be read past. It returns the end of the enclosing value,
+// which leave restores.
+func (d *Decoder) enter(size uint32) (uint64, error) {
+	if d.depth >= d.maxDepth {
+		return 0, d.errorAt(d.pos, "value is nested deeper than the maximum depth of %d", d.maxDepth)
+	}
+	d.depth++
+	parentEnd := d.end
+	d.end = d.pos + uint64(size)
+	return parentEnd, nil
+}
+
+func (d *Decoder) leave(parentEnd uint64) {
+	d.depth--
+	d.end = parentEnd
+}
+
+func (d *Decoder) readError(start uint64, size int, err error) error {
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return d.errorAt(start, "can only read %d bytes while expected to read %d bytes", d.pos-start, size)
+	}
+	return d.errorAt(start, "failed to read from input: %v", err)
+}
+
+// errorAt returns an error which occurred at position pos of the input. The
+// position of the first error, which is the innermost one as errors are
+// returned up the decoders, is reported by Decode.
+func (d *Decoder) errorAt(pos uint64, format string, args ...interface{}) error {
+	if !d.failed {
+		d.failed = true
+		d.errPos = pos
+	}
+	return fmt.Errorf(format, args...)
+}
+
+func (d *Decoder) decodeError(err error, typ reflect.Type) *decodeError {
+	if !d.failed {
+		return newDecodeError(fmt.Sprint(err), typ)
+	}
+	return &decodeError{msg: fmt.Sprint(err), typ: typ, pos: d.errPos, hasPos: true}
+}
+
 // decodeError is what gets reported to the decoder user in error case.
 type decodeError struct {
 	msg string
 	typ reflect.Type
+	// pos is the position in the input at which decoding failed, if hasPos is set.
+	pos    uint64
+	hasPos bool
 }
 
 func newDecodeError(msg string, typ reflect.Type) *decodeError {
-	return &decodeError{msg, typ}
+	return &decodeError{msg: msg, typ: typ}
 }
 
 func (err *decodeError) Error() string {
+	if err.hasPos {
+		return fmt.Sprintf("decode error: %s at byte %d for output type %v", err.msg, err.pos, err.typ)
+	}
 	return fmt.Sprintf("decode error: %s for output type %v", err.msg, err.typ)
 }
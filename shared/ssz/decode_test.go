@@ -5,7 +5,10 @@ import (
 	"encoding/hex"
 	"fmt"
 	"reflect"
+	"runtime"
+	"strings"
 	"testing"
+	"testing/iotest"
 )
 
 type decodeTest struct {
@@ -45,6 +48,10 @@ var decodeTests = []decodeTest{
 	{input: "FFFF0000", ptr: new(uint32), value: uint32(65535)},
 	{input: "FFFFFFFF", ptr: new(uint32), value: uint32(4294967295)},
 
+	// int32
+	{input: "01000000", ptr: new(int32), value: int32(1)},
+	{input: "FFFFFFFF", ptr: new(int32), value: int32(-1)},
+
 	// uint64
 	{input: "0000000000000000", ptr: new(uint64), value: uint64(0)},
 	{input: "0100000000000000", ptr: new(uint64), value: uint64(1)},
@@ -148,40 +155,40 @@ var decodeTests = []decodeTest{
 	{input: "00", ptr: new(string), error: "decode error: type string is not serializable for output type string"},
 
 	// error: bool: wrong input value
-	{input: "02", ptr: new(bool), error: "decode error: expect 0 or 1 for decoding bool but got 2 for output type bool"},
+	{input: "02", ptr: new(bool), error: "decode error: expect 0 or 1 for decoding bool but got 2 at byte 0 for output type bool"},
 
 	// error: uint16: wrong header
-	{input: "00", ptr: new(uint16), error: "decode error: can only read 1 bytes while expected to read 2 bytes for output type uint16"},
+	{input: "00", ptr: new(uint16), error: "decode error: can only read 1 bytes while expected to read 2 bytes at byte 0 for output type uint16"},
 
 	// error: bytes: wrong input
-	{input: "01000000", ptr: new([]byte), error: "decode error: can only read 0 bytes while expected to read 1 bytes for output type []uint8"},
+	{input: "01000000", ptr: new([]byte), error: "decode error: can only read 0 bytes while expected to read 1 bytes at byte 4 for output type []uint8"},
 
 	// error: slice: wrong header
-	{input: "010000", ptr: new([]uint16), error: "decode error: failed to decode header of slice: can only read 3 bytes while expected to read 4 bytes for output type []uint16"},
+	{input: "010000", ptr: new([]uint16), error: "decode error: failed to decode header of slice: can only read 3 bytes while expected to read 4 bytes at byte 0 for output type []uint16"},
 
 	// error: slice: wrong input
-	{input: "01000000", ptr: new([]uint16), error: "decode error: failed to decode element of slice: can only read 0 bytes while expected to read 2 bytes for output type []uint16"},
+	{input: "01000000", ptr: new([]uint16), error: "decode error: failed to decode element of slice: reading 2 bytes exceeds the 1 bytes available at byte 4 for output type []uint16"},
 
 	// error: byte array: wrong input
-	{input: "01000000 01", ptr: new([2]byte), error: "decode error: input byte array size (1) isn't euqal to output array size (2) for output type [2]uint8"},
+	{input: "01000000 01", ptr: new([2]byte), error: "decode error: input byte array size (1) isn't euqal to output array size (2) at byte 0 for output type [2]uint8"},
 
 	// error: array: input too short
-	{input: "02000000 0100", ptr: new([2]uint16), error: "decode error: input is too short for output type [2]uint16"},
+	{input: "02000000 0100", ptr: new([2]uint16), error: "decode error: input is too short at byte 6 for output type [2]uint16"},
 
 	// error: array: input too long
-	{input: "04000000 0100 0200", ptr: new([1]uint16), error: "decode error: input is too long for output type [1]uint16"},
+	{input: "04000000 0100 0200", ptr: new([1]uint16), error: "decode error: input is too long at byte 6 for output type [1]uint16"},
 
 	// error: struct: wrong header
-	{input: "010000", ptr: new(simpleStruct), error: "decode error: failed to decode header of struct: can only read 3 bytes while expected to read 4 bytes for output type ssz.simpleStruct"},
+	{input: "010000", ptr: new(simpleStruct), error: "decode error: failed to decode header of struct: can only read 3 bytes while expected to read 4 bytes at byte 0 for output type ssz.simpleStruct"},
 
 	// error: struct: wrong input
-	{input: "03000000 01 02", ptr: new(simpleStruct), error: "decode error: failed to decode field of slice: can only read 0 bytes while expected to read 1 bytes for output type ssz.simpleStruct"},
+	{input: "03000000 01 02", ptr: new(simpleStruct), error: "decode error: failed to decode field of slice: can only read 0 bytes while expected to read 1 bytes at byte 6 for output type ssz.simpleStruct"},
 
 	// error: struct: input too short
-	{input: "02000000 0200", ptr: new(simpleStruct), error: "decode error: input is too short for output type ssz.simpleStruct"},
+	{input: "02000000 0200", ptr: new(simpleStruct), error: "decode error: input is too short at byte 6 for output type ssz.simpleStruct"},
 
 	// error: struct: input too long
-	{input: "04000000 0200 01 01", ptr: new(simpleStruct), error: "decode error: input is too long for output type ssz.simpleStruct"},
+	{input: "04000000 0200 01 01", ptr: new(simpleStruct), error: "decode error: input is too long at byte 7 for output type ssz.simpleStruct"},
 }
 
 func runTests(t *testing.T, decode func([]byte, interface{}) error) {
@@ -220,3 +227,105 @@ func TestDecodeWithByteReader(t *testing.T) {
 		return Decode(bytes.NewReader(input), into)
 	})
 }
+
+func TestDecoder_OneByteReader(t *testing.T) {
+	runTests(t, func(input []byte, into interface{}) error {
+		return NewDecoder(iotest.OneByteReader(bytes.NewReader(input))).Decode(into)
+	})
+}
+
+func TestDecoder_Stream(t *testing.T) {
+	input, err := hex.DecodeString(stripSpace("03000000 0200 01 03000000 0400 03 02"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := NewDecoder(bytes.NewReader(input))
+	want := []simpleStruct{{B: 2, A: 1}, {B: 4, A: 3}}
+	for i := range want {
+		var s simpleStruct
+		if err := d.Decode(&s); err != nil {
+			t.Fatalf("Could not decode value %d: %v", i, err)
+		}
+		if s != want[i] {
+			t.Errorf("Value %d is %v, expected %v", i, s, want[i])
+		}
+	}
+	// Positions count from the start of the stream.
+	wantErr := "decode error: expect 0 or 1 for decoding bool but got 2 at byte 14 for output type bool"
+	var b bool
+	if err := d.Decode(&b); fmt.Sprint(err) != wantErr {
+		t.Errorf("Expected error %q, received %v", wantErr, err)
+	}
+}
+
+func TestDecoder_Limits(t *testing.T) {
+	tests := []struct {
+		input string
+		setup func(d *Decoder)
+		ptr   interface{}
+		error string
+	}{
+		{
+			input: "06000000 010203040506",
+			setup: func(d *Decoder) { d.SetMaxSize(9) },
+			ptr:   new([]byte),
+			error: "decode error: size 6 exceeds the 5 bytes available at byte 0 for output type []uint8",
+		},
+		{
+			input: "06000000 010203040506",
+			setup: func(d *Decoder) { d.SetTypeMaxSize([]byte{}, 4) },
+			ptr:   new([]byte),
+			error: "decode error: size 6 exceeds the maximum size 4 of []uint8 at byte 0 for output type []uint8",
+		},
+		{
+			input: "0E000000 03000000 0200 01 03000000 0400 03",
+			setup: func(d *Decoder) { d.SetTypeMaxSize(&simpleStruct{}, 2) },
+			ptr:   new([]*simpleStruct),
+			error: "decode error: failed to decode element of slice: failed to decode to object pointed by pointer: " +
+				"failed to decode header of struct: size 3 exceeds the maximum size 2 of ssz.simpleStruct at byte 4 for output type []*ssz.simpleStruct",
+		},
+		{
+			// The length of an element exceeds the length of the enclosing slice.
+			input: "08000000 FFFFFFFF 00000000",
+			setup: func(d *Decoder) {},
+			ptr:   new([][]byte),
+			error: "decode error: failed to decode element of slice: size 4294967295 exceeds the 4 bytes available at byte 4 for output type [][]uint8",
+		},
+		{
+			input: "18000000 08000000 0100 0200 0300 0400 08000000 0500 0600 0700 0800",
+			setup: func(d *Decoder) { d.SetMaxDepth(1) },
+			ptr:   new([][]uint16),
+			error: "decode error: failed to decode element of slice: " +
+				"value is nested deeper than the maximum depth of 1 at byte 8 for output type [][]uint16",
+		},
+	}
+	for i, test := range tests {
+		input, err := hex.DecodeString(stripSpace(test.input))
+		if err != nil {
+			t.Fatalf("test %d: invalid hex input %q", i, test.input)
+		}
+		d := NewDecoder(bytes.NewReader(input))
+		test.setup(d)
+		if err := d.Decode(test.ptr); fmt.Sprint(err) != test.error {
+			t.Errorf("test %d: decode error mismatch\ngot  %v\nwant %v", i, err, test.error)
+		}
+	}
+}
+
+func TestDecode_LengthPrefixDoesNotAllocate(t *testing.T) {
+	input, err := hex.DecodeString(stripSpace("FFFFFFFF 01020304"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	var b []byte
+	err = Decode(bytes.NewReader(input), &b)
+	runtime.ReadMemStats(&after)
+	if err == nil || !strings.Contains(err.Error(), "can only read 4 bytes while expected to read 4294967295 bytes") {
+		t.Errorf("Expected a short read error, received %v", err)
+	}
+	if allocated := after.TotalAlloc - before.TotalAlloc; allocated > 4*readChunkSize {
+		t.Errorf("Decoding a %d byte input allocated %d bytes", len(input), allocated)
+	}
+}
@@ -19,5 +19,45 @@ address:
 	use byte slice of length 20 instead
 hash:
 	use byte slice of length 32 instead if the hash is 32 bytes long, for example
+
+Pointers to types with MarshalSSZ, UnmarshalSSZ and HashTreeRoot methods generated
+by tools/sszgen, such as the beacon chain protos, are encoded, decoded and tree-hashed
+with those methods instead of reflection, including when nested in other values.
+
+A Decoder decodes a stream of values, rejecting values which exceed its maximum
+size or depth, or the maximum size set for their type, before allocating memory
+for them. The fuzz package holds a go-fuzz target and corpus for the decoder.
+
+Encode, Decode and TreeHash implement the length-prefixed serialization and the
+tree-hash which beacon blocks and states currently commit to. Marshal, Unmarshal
+and MerkleRoot implement the current spec, where fixed-size vectors have no
+length prefix, variable-size parts of containers and lists are located by offsets,
+and hash tree roots are computed with SHA-256 and mix in the length of lists.
+They also support bitlists and bitvectors, with the Bitlist and Bitvector types.
+The length of vectors and bitvectors, and the limit of lists and bitlists, are
+given by ssz-size and ssz-max struct tags:
+
+	type Attestation struct {
+		AggregationBits Bitlist   `ssz-max:"4096"`
+		CustodyBits     Bitvector `ssz-size:"64"`
+		Signature       []byte    `ssz-size:"96"`
+		Roots           [][]byte  `ssz-size:"?,32" ssz-max:"16"`
+	}
+
+GeneralizedIndex returns the generalized index of the node at a field path in the
+tree of MerkleRoot, such as "validator_registry[42].exit_epoch". MerkleProof and
+MerkleMultiproof prove the nodes with given generalized indices against the root,
+which VerifyMerkleProof and VerifyMerkleMultiproof check. Since the root is the
+one of MerkleRoot, these proofs don't verify against the TreeHash roots which
+blocks commit to, such as the state root of a beacon block. TreeHashProof proves
+the value at a path against the TreeHash root instead, and VerifyTreeHashProof
+checks such a proof from the type of the value and the path alone, reading the
+lengths of the lists on the path from the proof.
+
+ToJSON and ToYAML render values for humans, with bytes as 0x-prefixed hex strings
+and integers as decimal numbers, and FromJSON and FromYAML parse them back.
+TreeHashRoots and MerkleRoots list the root of each subtree of a value. The
+tools/ssz-convert tool converts the types registered with RegisterType between
+SSZ and these renderings.
 */
 package ssz
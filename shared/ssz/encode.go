@@ -16,6 +16,12 @@ type Encodable interface {
 	EncodeSSZSize() (uint32, error)
 }
 
+// Marshaler is implemented by types with generated encoding methods, which
+// Encode prefers over the reflective encoder.
+type Marshaler interface {
+	MarshalSSZ() ([]byte, error)
+}
+
 // Encode encodes val and output the result into w.
 func Encode(w io.Writer, val interface{}) error {
 	eb := &encbuf{}
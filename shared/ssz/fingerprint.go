@@ -0,0 +1,132 @@
+package ssz
+
+import (
+	"encoding/binary"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// fingerprint is a keyed 128-bit digest of a value which the tree-hash cache
+// compares to find out whether a list element changed since it was last hashed.
+// Every word is mixed into both lanes with a bijective step, so two values of
+// the same shape which differ in a single word never share a fingerprint.
+type fingerprint [2]uint64
+
+func (f fingerprint) mix(word uint64) fingerprint {
+	f[0] = (f[0] ^ word) * 0x9e3779b97f4a7c15
+	f[0] ^= f[0] >> 31
+	f[1] = (f[1] ^ word) * 0xc2b2ae3d27d4eb4f
+	f[1] ^= f[1] >> 29
+	return f
+}
+
+func (f fingerprint) mixBytes(b []byte) fingerprint {
+	f = f.mix(uint64(len(b)))
+	for ; len(b) >= 8; b = b[8:] {
+		f = f.mix(binary.LittleEndian.Uint64(b))
+	}
+	if len(b) > 0 {
+		var word [8]byte
+		copy(word[:], b)
+		f = f.mix(binary.LittleEndian.Uint64(word[:]))
+	}
+	return f
+}
+
+// fingerprinter mixes every field of a value into the fingerprint.
+type fingerprinter func(reflect.Value, fingerprint) fingerprint
+
+var (
+	fingerprinterCacheMutex sync.RWMutex
+	fingerprinterCache      = make(map[reflect.Type]fingerprinter)
+)
+
+// cachedFingerprinter returns the fingerprinter of the type, creating it on first use.
+func cachedFingerprinter(typ reflect.Type) (fingerprinter, error) {
+	fingerprinterCacheMutex.RLock()
+	fp := fingerprinterCache[typ]
+	fingerprinterCacheMutex.RUnlock()
+	if fp != nil {
+		return fp, nil
+	}
+	fp, err := makeFingerprinter(typ)
+	if err != nil {
+		return nil, err
+	}
+	fingerprinterCacheMutex.Lock()
+	fingerprinterCache[typ] = fp
+	fingerprinterCacheMutex.Unlock()
+	return fp, nil
+}
+
+func makeFingerprinter(typ reflect.Type) (fingerprinter, error) {
+	kind := typ.Kind()
+	switch {
+	case kind == reflect.Bool:
+		return func(val reflect.Value, f fingerprint) fingerprint {
+			if val.Bool() {
+				return f.mix(1)
+			}
+			return f.mix(0)
+		}, nil
+	case kind == reflect.Uint8 || kind == reflect.Uint16 || kind == reflect.Uint32 || kind == reflect.Uint64:
+		return func(val reflect.Value, f fingerprint) fingerprint {
+			return f.mix(val.Uint())
+		}, nil
+	case kind == reflect.Int32:
+		return func(val reflect.Value, f fingerprint) fingerprint {
+			return f.mix(uint64(val.Int()))
+		}, nil
+	case kind == reflect.Slice && typ.Elem().Kind() == reflect.Uint8:
+		return func(val reflect.Value, f fingerprint) fingerprint {
+			return f.mixBytes(val.Bytes())
+		}, nil
+	case kind == reflect.Slice || kind == reflect.Array:
+		elemFingerprinter, err := makeFingerprinter(typ.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return func(val reflect.Value, f fingerprint) fingerprint {
+			f = f.mix(uint64(val.Len()))
+			for i := 0; i < val.Len(); i++ {
+				f = elemFingerprinter(val.Index(i), f)
+			}
+			return f
+		}, nil
+	case kind == reflect.Struct:
+		var fields []int
+		var fieldFingerprinters []fingerprinter
+		for i := 0; i < typ.NumField(); i++ {
+			if strings.Contains(typ.Field(i).Name, "XXX") {
+				continue
+			}
+			fieldFingerprinter, err := makeFingerprinter(typ.Field(i).Type)
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, i)
+			fieldFingerprinters = append(fieldFingerprinters, fieldFingerprinter)
+		}
+		return func(val reflect.Value, f fingerprint) fingerprint {
+			for j, i := range fields {
+				f = fieldFingerprinters[j](val.Field(i), f)
+			}
+			return f
+		}, nil
+	case kind == reflect.Ptr:
+		elemFingerprinter, err := makeFingerprinter(typ.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return func(val reflect.Value, f fingerprint) fingerprint {
+			if val.IsNil() {
+				return f.mix(0)
+			}
+			return elemFingerprinter(val.Elem(), f.mix(1))
+		}, nil
+	default:
+		return nil, fmt.Errorf("type %v is not fingerprintable", typ)
+	}
+}
@@ -0,0 +1,84 @@
+// Package fuzz defines a go-fuzz target for the SSZ decoder. Inputs are
+// decoded into beacon chain types, which use the generated decoding methods,
+// and into types which use the reflective decoder. To run it:
+//
+//   go-fuzz-build github.com/prysmaticlabs/prysm/shared/ssz/fuzz
+//   go-fuzz -bin=fuzz-fuzz.zip -workdir=shared/ssz/fuzz
+//
+// The corpus directory holds the inputs the fuzzer starts from, and the
+// inputs it found to increase coverage.
+package fuzz
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/ssz"
+)
+
+// node is a recursive type, whose values can be nested arbitrarily deep.
+type node struct {
+	Value    uint64
+	Data     []byte
+	Children []*node
+}
+
+// values returns pointers to new values of each fuzzed type.
+func values() []interface{} {
+	return []interface{}{
+		&pb.BeaconBlock{},
+		&pb.Attestation{},
+		&pb.AttesterSlashing{},
+		&pb.ProposerSlashing{},
+		&pb.Deposit{},
+		&pb.VoluntaryExit{},
+		&node{},
+		&[][]byte{},
+		&[]uint64{},
+		&[4][32]byte{},
+	}
+}
+
+// Fuzz decodes data into each fuzzed type, with the size of data as the
+// maximum size. A value which decodes must encode to an input that decodes
+// and encodes to the same input again, otherwise Fuzz panics. It returns 1 if
+// data decoded into any type, which makes go-fuzz prefer it when mutating the
+// corpus.
+func Fuzz(data []byte) int {
+	decoded := 0
+	for _, val := range values() {
+		d := ssz.NewDecoder(bytes.NewReader(data))
+		d.SetMaxSize(uint64(len(data)))
+		if err := d.Decode(val); err != nil {
+			continue
+		}
+		decoded = 1
+
+		enc, err := encode(val)
+		if err != nil {
+			panic(fmt.Sprintf("could not encode decoded %T: %v", val, err))
+		}
+		again := reflect.New(reflect.TypeOf(val).Elem()).Interface()
+		if err := ssz.Decode(bytes.NewReader(enc), again); err != nil {
+			panic(fmt.Sprintf("could not decode encoded %T: %v", val, err))
+		}
+		encAgain, err := encode(again)
+		if err != nil {
+			panic(fmt.Sprintf("could not encode decoded %T: %v", val, err))
+		}
+		if !bytes.Equal(enc, encAgain) {
+			panic(fmt.Sprintf("encoding of %T changed from %#x to %#x", val, enc, encAgain))
+		}
+	}
+	return decoded
+}
+
+func encode(val interface{}) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	if err := ssz.Encode(buf, val); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
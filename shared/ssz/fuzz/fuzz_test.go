@@ -0,0 +1,55 @@
+package fuzz
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func readCorpus(t *testing.T) map[string][]byte {
+	paths, err := filepath.Glob("corpus/*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) == 0 {
+		t.Fatal("Corpus is empty")
+	}
+	corpus := make(map[string][]byte)
+	for _, path := range paths {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		corpus[filepath.Base(path)] = data
+	}
+	return corpus
+}
+
+func TestFuzz_Corpus(t *testing.T) {
+	for name, data := range readCorpus(t) {
+		if Fuzz(data) != 1 {
+			t.Errorf("Corpus input %s did not decode into any type", name)
+		}
+	}
+}
+
+// TestFuzz_Mutations runs the mutations most likely to break the decoder on
+// every corpus input: truncating it, and overwriting each byte and each
+// possible length prefix. Fuzz panics if a mutated input decodes incorrectly.
+func TestFuzz_Mutations(t *testing.T) {
+	for _, data := range readCorpus(t) {
+		for i := 0; i < len(data); i++ {
+			Fuzz(data[:i])
+
+			mutated := append([]byte{}, data...)
+			mutated[i] ^= 0xff
+			Fuzz(mutated)
+
+			if i+4 <= len(data) {
+				mutated = append([]byte{}, data...)
+				copy(mutated[i:], []byte{0xff, 0xff, 0xff, 0xff})
+				Fuzz(mutated)
+			}
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package ssz
+
+import (
+	"encoding/binary"
+	"fmt"
+	"reflect"
+)
+
+// generatedCode is implemented by the types for which tools/sszgen generated
+// encoding, decoding and tree-hash methods, such as the beacon chain protos.
+type generatedCode interface {
+	Marshaler
+	Unmarshaler
+	HashRoot
+}
+
+var generatedCodeType = reflect.TypeOf((*generatedCode)(nil)).Elem()
+
+// hasGeneratedCode returns true for pointer types with generated methods. The
+// generated methods handle nil receivers the same way as the reflective path
+// handles nil pointers.
+func hasGeneratedCode(typ reflect.Type) bool {
+	return typ.Kind() == reflect.Ptr && typ.Implements(generatedCodeType)
+}
+
+// makeGeneratedSSZUtils wraps the generated methods of typ so that they are
+// also used when typ is nested in a value handled by the reflective path. The
+// generated decoding method only checks the sizes of the nested values against
+// the enclosing value, so the reflective decoder is used instead when the
+// Decoder has limits for nested values.
+func makeGeneratedSSZUtils(typ reflect.Type) (*sszUtils, error) {
+	reflectiveDecoder, err := makePtrDecoder(typ)
+	if err != nil {
+		return nil, err
+	}
+	encoder := func(val reflect.Value, w *encbuf) error {
+		enc, err := val.Interface().(Marshaler).MarshalSSZ()
+		if err != nil {
+			return err
+		}
+		w.str = append(w.str, enc...)
+		return nil
+	}
+	encodeSizer := func(val reflect.Value) (uint32, error) {
+		enc, err := val.Interface().(Marshaler).MarshalSSZ()
+		if err != nil {
+			return 0, err
+		}
+		return uint32(len(enc)), nil
+	}
+	decoder := func(d *Decoder, val reflect.Value) (uint32, error) {
+		if d.limitsNestedValues() {
+			return reflectiveDecoder(d, val)
+		}
+		newVal := reflect.New(typ.Elem())
+		size, err := decodeGenerated(d, typ, newVal.Interface().(Unmarshaler))
+		if err != nil {
+			return 0, fmt.Errorf("failed to decode to object pointed by pointer: %v", err)
+		}
+		if size > lengthBytes {
+			val.Set(newVal)
+		} // Else we leave val to its default value which is nil.
+		return size, nil
+	}
+	hasher := func(val reflect.Value) ([]byte, error) {
+		root, err := val.Interface().(HashRoot).HashTreeRoot()
+		if err != nil {
+			return nil, err
+		}
+		return root[:], nil
+	}
+	return &sszUtils{
+		encoder:     encoder,
+		encodeSizer: encodeSizer,
+		decoder:     decoder,
+		hasher:      hasher,
+	}, nil
+}
+
+// decodeGenerated reads the length-prefixed encoding of a container of type typ
+// from d and decodes it with the generated method. It returns the number of bytes read.
+func decodeGenerated(d *Decoder, typ reflect.Type, u Unmarshaler) (uint32, error) {
+	start := d.pos
+	size, err := d.readLength(typ)
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode header of struct: %v", err)
+	}
+	parentEnd, err := d.enter(size)
+	if err != nil {
+		return 0, err
+	}
+	defer d.leave(parentEnd)
+	enc := make([]byte, lengthBytes)
+	binary.LittleEndian.PutUint32(enc, size)
+	if enc, err = d.readAppend(enc, size); err != nil {
+		return 0, err
+	}
+	if err := u.UnmarshalSSZ(enc); err != nil {
+		return 0, d.errorAt(start, "%v", err)
+	}
+	return lengthBytes + size, nil
+}
@@ -2,12 +2,12 @@ package ssz
 
 import (
 	"bytes"
-	"encoding/binary"
 	"fmt"
 	"reflect"
 
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/ssz/sszutil"
 )
 
 const hashLengthBytes = 32
@@ -18,6 +18,12 @@ type Hashable interface {
 	TreeHashSSZ() ([32]byte, error)
 }
 
+// HashRoot is implemented by types with generated tree-hash methods, which
+// TreeHash prefers over the reflective hasher.
+type HashRoot interface {
+	HashTreeRoot() ([32]byte, error)
+}
+
 // TreeHash calculates tree-hash result for input value.
 func TreeHash(val interface{}) ([32]byte, error) {
 	if val == nil {
@@ -37,6 +43,44 @@ func TreeHash(val interface{}) ([32]byte, error) {
 	return paddedOutput, nil
 }
 
+// SigningRoot calculates the tree-hash of a container without its last field,
+// which holds the signature over that root. Blocks, voluntary exits and deposit
+// inputs are signed and verified over their signing root, so their signature
+// does not have to be cleared first.
+func SigningRoot(val interface{}) ([32]byte, error) {
+	if val == nil {
+		return [32]byte{}, newHashError("untyped nil is not supported", nil)
+	}
+	rval := reflect.ValueOf(val)
+	if rval.Kind() == reflect.Ptr {
+		if rval.IsNil() {
+			return [32]byte{}, newHashError("nil pointer is not supported", rval.Type())
+		}
+		rval = rval.Elem()
+	}
+	if rval.Kind() != reflect.Struct {
+		return [32]byte{}, newHashError("signing root is only defined for structs", rval.Type())
+	}
+	sszUtilsCacheMutex.Lock()
+	fields, err := structFields(rval.Type())
+	sszUtilsCacheMutex.Unlock()
+	if err != nil {
+		return [32]byte{}, newHashError(fmt.Sprint(err), rval.Type())
+	}
+	if len(fields) == 0 {
+		return [32]byte{}, newHashError("struct has no signature field", rval.Type())
+	}
+	concatElemHash := make([]byte, 0)
+	for _, f := range fields[:len(fields)-1] {
+		elemHash, err := f.sszUtils.hasher(rval.Field(f.index))
+		if err != nil {
+			return [32]byte{}, newHashError(fmt.Sprintf("failed to hash field %s: %v", f.name, err), rval.Type())
+		}
+		concatElemHash = append(concatElemHash, elemHash...)
+	}
+	return hashutil.Hash(concatElemHash), nil
+}
+
 type hashError struct {
 	msg string
 	typ reflect.Type
@@ -162,63 +206,8 @@ func makePtrHasher(typ reflect.Type) (hasher, error) {
 	return hasher, nil
 }
 
-// merkelHash implements a merkle-tree style hash algorithm.
-//
-// Please refer to the official spec for details:
-// https://github.com/ethereum/eth2.0-specs/blob/master/specs/simple-serialize.md#tree-hash
-//
-// The overall idea is:
-// 1. Create a bunch of bytes chunk (each has a size of sszChunkSize) from the input hash list.
-// 2. Treat each bytes chunk as the leaf of a binary tree.
-// 3. For every pair of leaves, we set their parent's value using the hash value of the concatenation of the two leaves.
-//    The original two leaves are then removed.
-// 4. Keep doing step 3 until there's only one node left in the tree (the root).
-// 5. Return the hash of the concatenation of the root and the data length encoding.
-//
-// Time complexity is O(n) given input list of size n.
+// merkleHash implements the merkle-tree style hash of a list of element hashes.
+// See sszutil.MerkleHash for details.
 func merkleHash(list [][]byte) ([]byte, error) {
-	// Assume len(list) < 2^64
-	dataLenEnc := make([]byte, hashLengthBytes)
-	binary.LittleEndian.PutUint64(dataLenEnc, uint64(len(list)))
-
-	var chunkz [][]byte
-	emptyChunk := make([]byte, sszChunkSize)
-
-	if len(list) == 0 {
-		chunkz = make([][]byte, 1)
-		chunkz[0] = emptyChunk
-	} else if len(list[0]) < sszChunkSize {
-
-		itemsPerChunk := sszChunkSize / len(list[0])
-		chunkz = make([][]byte, 0)
-		for i := 0; i < len(list); i += itemsPerChunk {
-			chunk := make([]byte, 0)
-			j := i + itemsPerChunk
-			if j > len(list) {
-				j = len(list)
-			}
-			// Every chunk should have sszChunkSize bytes except that the last one could have less bytes
-			for _, elemHash := range list[i:j] {
-				chunk = append(chunk, elemHash...)
-			}
-			chunkz = append(chunkz, chunk)
-		}
-	} else {
-		chunkz = list
-	}
-
-	for len(chunkz) > 1 {
-		if len(chunkz)%2 == 1 {
-			chunkz = append(chunkz, emptyChunk)
-		}
-		hashedChunkz := make([][]byte, 0)
-		for i := 0; i < len(chunkz); i += 2 {
-			hashedChunk := hashutil.Hash(append(chunkz[i], chunkz[i+1]...))
-			hashedChunkz = append(hashedChunkz, hashedChunk[:])
-		}
-		chunkz = hashedChunkz
-	}
-
-	result := hashutil.Hash(append(chunkz[0], dataLenEnc...))
-	return result[:], nil
+	return sszutil.MerkleHash(list)
 }
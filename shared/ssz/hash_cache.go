@@ -0,0 +1,288 @@
+package ssz
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+// TreeHashCache computes the same tree-hash as TreeHash but remembers the
+// element hashes and the intermediate merkle nodes of every list it visits.
+// On each call every list element is walked once to compute a fingerprint of it,
+// which neither encodes nor allocates, and only the elements whose fingerprint
+// changed since the previous call are rehashed, along with the merkle branches
+// above them. Appending to or truncating a list keeps the hashes of the elements
+// it still holds, and only extends or trims the merkle tree along its right edge,
+// so adding a validator to the registry does not rehash the others. The cost of
+// repeatedly hashing a large value that changes little between calls, such as the
+// beacon state, is therefore dominated by the size of the change, with a cheap
+// linear walk of the lists on top.
+//
+// Lists are identified by their position in the hashed value, so a cache should
+// only be used for values of the same type. It is safe for concurrent use.
+type TreeHashCache struct {
+	lock  sync.Mutex
+	key   fingerprint
+	lists map[string]*listCache
+}
+
+// listCache holds the element fingerprints, the element hashes and all
+// the layers of the merkle tree of a single list.
+type listCache struct {
+	fingerprints []fingerprint
+	hashes       [][]byte
+	// layers[0] holds the chunks packed from the element hashes and the
+	// last layer holds the root of the tree.
+	layers [][][]byte
+}
+
+// NewTreeHashCache creates an empty tree-hash cache.
+func NewTreeHashCache() *TreeHashCache {
+	c := &TreeHashCache{
+		lists: make(map[string]*listCache),
+	}
+	// A random key keeps the fingerprints unpredictable. Without one they
+	// still tell apart any two values which differ in a single word.
+	var key [16]byte
+	if _, err := io.ReadFull(rand.Reader, key[:]); err == nil {
+		c.key = fingerprint{binary.LittleEndian.Uint64(key[:8]), binary.LittleEndian.Uint64(key[8:])}
+	}
+	return c
+}
+
+// TreeHash calculates the tree-hash result for the input value, reusing
+// the hashes of list elements which did not change since the last call.
+func (c *TreeHashCache) TreeHash(val interface{}) ([32]byte, error) {
+	if val == nil {
+		return [32]byte{}, newHashError("untyped nil is not supported", nil)
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	rval := reflect.ValueOf(val)
+	output, err := c.hash(rval, rval.Type().String())
+	if err != nil {
+		return [32]byte{}, newHashError(fmt.Sprint(err), rval.Type())
+	}
+	return bytesutil.ToBytes32(output), nil
+}
+
+func (c *TreeHashCache) hash(val reflect.Value, path string) ([]byte, error) {
+	typ := val.Type()
+	utils, err := cachedSSZUtils(typ)
+	if err != nil {
+		return nil, err
+	}
+	switch kind := typ.Kind(); {
+	case (kind == reflect.Slice || kind == reflect.Array) && typ.Elem().Kind() != reflect.Uint8:
+		return c.hashList(val, path)
+	case kind == reflect.Struct:
+		return c.hashStruct(val, path)
+	case kind == reflect.Ptr:
+		if val.IsNil() {
+			return utils.hasher(val)
+		}
+		return c.hash(val.Elem(), path)
+	default:
+		return utils.hasher(val)
+	}
+}
+
+func (c *TreeHashCache) hashStruct(val reflect.Value, path string) ([]byte, error) {
+	typ := val.Type()
+	concatElemHash := make([]byte, 0)
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		if strings.Contains(f.Name, "XXX") {
+			continue
+		}
+		elemHash, err := c.hash(val.Field(i), path+"."+f.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash field of struct: %v", err)
+		}
+		concatElemHash = append(concatElemHash, elemHash...)
+	}
+	result := hashutil.Hash(concatElemHash)
+	return result[:], nil
+}
+
+func (c *TreeHashCache) hashList(val reflect.Value, path string) ([]byte, error) {
+	elemSSZUtils, err := cachedSSZUtils(val.Type().Elem())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ssz utils: %v", err)
+	}
+	elemFingerprinter, err := cachedFingerprinter(val.Type().Elem())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fingerprinter: %v", err)
+	}
+	cache, ok := c.lists[path]
+	if !ok {
+		cache = &listCache{}
+		c.lists[path] = cache
+	}
+	prevLen := len(cache.hashes)
+	cache.resize(val.Len())
+
+	var dirty []int
+	for i := 0; i < val.Len(); i++ {
+		elem := val.Index(i)
+		fp := elemFingerprinter(elem, c.key)
+		if cache.hashes[i] != nil && fp == cache.fingerprints[i] {
+			continue
+		}
+		elemHash, err := elemSSZUtils.hasher(elem)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash element of slice/array: %v", err)
+		}
+		cache.fingerprints[i] = fp
+		cache.hashes[i] = elemHash
+		dirty = append(dirty, i)
+	}
+
+	switch {
+	case cache.layers == nil || prevLen == 0 || val.Len() == 0:
+		cache.layers = buildMerkleLayers(packChunks(cache.hashes))
+	case prevLen != val.Len():
+		// The chunk of the last element kept from the previous call may have gained
+		// or lost elements, and the nodes above it gained or lost their right sibling.
+		last := prevLen
+		if val.Len() < last {
+			last = val.Len()
+		}
+		cache.resizeMerkleLayers()
+		cache.updateMerkleLayers(append(dirty, last-1))
+	case len(dirty) > 0:
+		cache.updateMerkleLayers(dirty)
+	}
+	dataLenEnc := make([]byte, hashLengthBytes)
+	binary.LittleEndian.PutUint64(dataLenEnc, uint64(len(cache.hashes)))
+	root := cache.layers[len(cache.layers)-1][0]
+	result := hashutil.Hash(append(append([]byte{}, root...), dataLenEnc...))
+	return result[:], nil
+}
+
+// itemsPerChunk returns how many element hashes merkleHash packs into one chunk.
+func itemsPerChunk(hashes [][]byte) int {
+	if len(hashes) == 0 || len(hashes[0]) >= sszChunkSize {
+		return 1
+	}
+	return sszChunkSize / len(hashes[0])
+}
+
+// packChunks packs the element hashes into chunks the same way merkleHash does.
+func packChunks(hashes [][]byte) [][]byte {
+	if len(hashes) == 0 {
+		return [][]byte{make([]byte, sszChunkSize)}
+	}
+	perChunk := itemsPerChunk(hashes)
+	chunks := make([][]byte, 0, (len(hashes)+perChunk-1)/perChunk)
+	for i := 0; i < len(hashes); i += perChunk {
+		chunks = append(chunks, packChunk(hashes, i/perChunk, perChunk))
+	}
+	return chunks
+}
+
+func packChunk(hashes [][]byte, index int, perChunk int) []byte {
+	j := (index + 1) * perChunk
+	if j > len(hashes) {
+		j = len(hashes)
+	}
+	chunk := make([]byte, 0)
+	for _, elemHash := range hashes[index*perChunk : j] {
+		chunk = append(chunk, elemHash...)
+	}
+	return chunk
+}
+
+// buildMerkleLayers computes every layer of the merkle tree above the given chunks.
+func buildMerkleLayers(chunks [][]byte) [][][]byte {
+	layers := [][][]byte{chunks}
+	for layer := chunks; len(layer) > 1; {
+		parents := make([][]byte, (len(layer)+1)/2)
+		for i := range parents {
+			parents[i] = hashPair(layer, 2*i)
+		}
+		layers = append(layers, parents)
+		layer = parents
+	}
+	return layers
+}
+
+// resize grows or shrinks the cached list to n elements, keeping the fingerprints
+// and the hashes of the elements it already holds. Added elements have no hash yet.
+func (l *listCache) resize(n int) {
+	if n <= len(l.hashes) {
+		l.fingerprints = l.fingerprints[:n]
+		l.hashes = l.hashes[:n]
+		return
+	}
+	l.fingerprints = append(l.fingerprints, make([]fingerprint, n-len(l.fingerprints))...)
+	l.hashes = append(l.hashes, make([][]byte, n-len(l.hashes))...)
+}
+
+// resizeMerkleLayers resizes every layer of the merkle tree to the number of chunks
+// of the resized list, adding or removing layers at the top. The nodes it adds are
+// empty and must be computed by updateMerkleLayers.
+func (l *listCache) resizeMerkleLayers() {
+	perChunk := itemsPerChunk(l.hashes)
+	size := (len(l.hashes) + perChunk - 1) / perChunk
+	layers := make([][][]byte, 0, len(l.layers))
+	for depth := 0; ; depth++ {
+		var layer [][]byte
+		if depth < len(l.layers) {
+			layer = l.layers[depth]
+		}
+		if len(layer) >= size {
+			layer = layer[:size]
+		} else {
+			layer = append(layer, make([][]byte, size-len(layer))...)
+		}
+		layers = append(layers, layer)
+		if size == 1 {
+			break
+		}
+		size = (size + 1) / 2
+	}
+	l.layers = layers
+}
+
+// updateMerkleLayers repacks the chunks holding the given dirty element
+// indices and recomputes the merkle nodes on their path to the root.
+func (l *listCache) updateMerkleLayers(dirty []int) {
+	perChunk := itemsPerChunk(l.hashes)
+	indices := make(map[int]bool)
+	for _, i := range dirty {
+		indices[i/perChunk] = true
+	}
+	for i := range indices {
+		l.layers[0][i] = packChunk(l.hashes, i, perChunk)
+	}
+	for depth := 1; depth < len(l.layers); depth++ {
+		parents := make(map[int]bool, len(indices))
+		for i := range indices {
+			parents[i/2] = true
+		}
+		for i := range parents {
+			l.layers[depth][i] = hashPair(l.layers[depth-1], 2*i)
+		}
+		indices = parents
+	}
+}
+
+// hashPair hashes the node at index i of a layer with its sibling, padding
+// the layer with an empty chunk if the sibling does not exist.
+func hashPair(layer [][]byte, i int) []byte {
+	right := make([]byte, sszChunkSize)
+	if i+1 < len(layer) {
+		right = layer[i+1]
+	}
+	h := hashutil.Hash(append(append([]byte{}, layer[i]...), right...))
+	return h[:]
+}
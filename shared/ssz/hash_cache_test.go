@@ -0,0 +1,172 @@
+package ssz
+
+import (
+	"testing"
+)
+
+type cachedRecord struct {
+	Pubkey  []byte
+	Balance uint64
+	Exited  bool
+}
+
+type cachedRegistry struct {
+	Slot     uint64
+	Records  []*cachedRecord
+	Balances []uint64
+	Roots    [][]byte
+}
+
+func newCachedRegistry(n int) *cachedRegistry {
+	r := &cachedRegistry{}
+	for i := 0; i < n; i++ {
+		r.Records = append(r.Records, &cachedRecord{Pubkey: []byte{byte(i), byte(i >> 8)}, Balance: uint64(i)})
+		r.Balances = append(r.Balances, uint64(i))
+		r.Roots = append(r.Roots, []byte{byte(i)})
+	}
+	return r
+}
+
+func TestTreeHashCache_MatchesTreeHash(t *testing.T) {
+	runHashTests(t, func(val interface{}) ([32]byte, error) {
+		return NewTreeHashCache().TreeHash(val)
+	})
+}
+
+func TestTreeHashCache_MatchesTreeHashAfterMutations(t *testing.T) {
+	cache := NewTreeHashCache()
+	r := newCachedRegistry(37)
+	mutations := []func(){
+		func() {},
+		func() { r.Slot++ },
+		func() { r.Records[0].Balance = 100 },
+		func() { r.Records[36].Exited = true },
+		func() { r.Balances[17] = 5 },
+		func() { r.Balances[0], r.Balances[35] = 7, 9 },
+		func() { r.Roots[3] = []byte{'A'} },
+		func() { r.Records[5].Pubkey[1] = 'F' },
+		func() { r.Records[6].Pubkey = append(r.Records[6].Pubkey, 0) },
+		func() { r.Records[7] = nil },
+		func() { r.Records = append(r.Records, &cachedRecord{Pubkey: []byte{'B'}}) },
+		func() { r.Balances = r.Balances[:20] },
+		func() { r.Records[10] = &cachedRecord{Pubkey: []byte{'C'}} },
+		func() { r.Roots = nil },
+		func() { r.Roots = [][]byte{{1}} },
+		func() {
+			for i := 0; i < 40; i++ {
+				r.Records = append(r.Records, &cachedRecord{Pubkey: []byte{'D', byte(i)}})
+				r.Balances = append(r.Balances, uint64(i))
+			}
+		},
+		func() { r.Balances = append(r.Balances, 1, 2, 3) },
+		func() { r.Records = r.Records[:1] },
+		func() { r.Balances = r.Balances[:5] },
+		func() { r.Records = append(r.Records, &cachedRecord{Pubkey: []byte{'E'}}) },
+		func() { r.Roots = append(r.Roots, []byte{2}, []byte{3}, []byte{4}) },
+	}
+	for i, mutate := range mutations {
+		mutate()
+		want, err := TreeHash(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := cache.TreeHash(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("mutation %d: cached hash %#x does not match tree hash %#x", i, got, want)
+		}
+	}
+}
+
+func TestTreeHashCache_OnlyRehashesChangedElements(t *testing.T) {
+	cache := NewTreeHashCache()
+	r := newCachedRegistry(64)
+	if _, err := cache.TreeHash(r); err != nil {
+		t.Fatal(err)
+	}
+	list := cache.lists["*ssz.cachedRegistry.Records"]
+	if list == nil {
+		t.Fatal("Expected records list to be cached")
+	}
+	unchanged := list.hashes[1]
+	r.Records[0].Balance++
+	if _, err := cache.TreeHash(r); err != nil {
+		t.Fatal(err)
+	}
+	if &list.hashes[1][0] != &unchanged[0] {
+		t.Error("Expected unchanged element hash to be reused")
+	}
+}
+
+func TestTreeHashCache_AppendKeepsElementHashes(t *testing.T) {
+	cache := NewTreeHashCache()
+	r := newCachedRegistry(64)
+	if _, err := cache.TreeHash(r); err != nil {
+		t.Fatal(err)
+	}
+	list := cache.lists["*ssz.cachedRegistry.Records"]
+	unchanged := list.hashes[1]
+	unchangedNode := list.layers[1][0]
+	r.Records = append(r.Records, &cachedRecord{Pubkey: []byte{'A'}})
+	got, err := cache.TreeHash(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := TreeHash(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("Cached hash %#x does not match tree hash %#x", got, want)
+	}
+	if &list.hashes[1][0] != &unchanged[0] {
+		t.Error("Expected the hash of an element kept by the append to be reused")
+	}
+	if &list.layers[1][0][0] != &unchangedNode[0] {
+		t.Error("Expected a merkle node away from the appended element to be reused")
+	}
+}
+
+func BenchmarkTreeHashCache_RegistryAppend(b *testing.B) {
+	cache := NewTreeHashCache()
+	r := newCachedRegistry(16384)
+	if _, err := cache.TreeHash(r); err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		r.Records = append(r.Records, &cachedRecord{Pubkey: []byte{byte(i), byte(i >> 8)}})
+		if _, err := cache.TreeHash(r); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkTreeHash_Registry(b *testing.B) {
+	r := newCachedRegistry(16384)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		r.Records[i%len(r.Records)].Balance++
+		if _, err := TreeHash(r); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkTreeHashCache_Registry(b *testing.B) {
+	cache := NewTreeHashCache()
+	r := newCachedRegistry(16384)
+	if _, err := cache.TreeHash(r); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		r.Records[i%len(r.Records)].Balance++
+		if _, err := cache.TreeHash(r); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
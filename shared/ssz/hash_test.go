@@ -218,3 +218,59 @@ func TestMerkleHash(t *testing.T) {
 		return merkleHash(val)
 	})
 }
+
+type signedStruct struct {
+	Slot      uint64
+	Root      []byte
+	Signature []byte
+}
+
+type unsignedStruct struct {
+	Slot uint64
+	Root []byte
+}
+
+func TestSigningRoot(t *testing.T) {
+	signed := &signedStruct{Slot: 5, Root: []byte{'A'}, Signature: []byte{'S', 'I', 'G'}}
+	root, err := SigningRoot(signed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := TreeHash(unsignedStruct{Slot: 5, Root: []byte{'A'}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != want {
+		t.Errorf("SigningRoot returned %#x, expected tree-hash without signature %#x", root, want)
+	}
+
+	signed.Signature = []byte{'O', 'T', 'H', 'E', 'R'}
+	otherRoot, err := SigningRoot(*signed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if otherRoot != root {
+		t.Errorf("Expected signing root to ignore the signature, got %#x and %#x", root, otherRoot)
+	}
+	if !bytes.Equal(signed.Signature, []byte("OTHER")) {
+		t.Errorf("SigningRoot modified the signature to %q", signed.Signature)
+	}
+}
+
+func TestSigningRoot_InvalidInput(t *testing.T) {
+	var nilStruct *signedStruct
+	tests := []struct {
+		val   interface{}
+		error string
+	}{
+		{val: nil, error: "hash error: untyped nil is not supported for input type <nil>"},
+		{val: nilStruct, error: "hash error: nil pointer is not supported for input type *ssz.signedStruct"},
+		{val: uint64(1), error: "hash error: signing root is only defined for structs for input type uint64"},
+		{val: struct{}{}, error: "hash error: struct has no signature field for input type struct {}"},
+	}
+	for i, test := range tests {
+		if _, err := SigningRoot(test.val); fmt.Sprint(err) != test.error {
+			t.Errorf("test %d: error mismatch\ngot   %v\nwant  %v", i, err, test.error)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package ssz
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+)
+
+const offsetBytes = 4
+
+// Marshal serializes val with the layout of the current SSZ spec, which other
+// clients use to exchange data. Unlike Encode, fixed-size values have no length
+// prefix, and the variable-size fields of a container or elements of a list are
+// located by offsets which follow its fixed-size parts. See specType for how Go
+// types and their ssz-size and ssz-max tags map to SSZ types.
+//
+// Marshal does not use the methods generated by tools/sszgen, which implement
+// the length-prefixed layout of Encode.
+func Marshal(val interface{}) ([]byte, error) {
+	if val == nil {
+		return nil, newEncodeError("untyped nil is not supported", nil)
+	}
+	rval := reflect.ValueOf(val)
+	t, err := cachedSpecType(rval.Type())
+	if err != nil {
+		return nil, newEncodeError(fmt.Sprint(err), rval.Type())
+	}
+	out, err := t.marshal(rval, make([]byte, 0, t.size))
+	if err != nil {
+		return nil, newEncodeError(fmt.Sprint(err), rval.Type())
+	}
+	return out, nil
+}
+
+// indirect returns the value a pointer type points to, or its zero value
+// for a nil pointer.
+func (t *specType) indirect(val reflect.Value) reflect.Value {
+	if !t.ptr {
+		return val
+	}
+	if val.IsNil() {
+		return reflect.Zero(t.typ.Elem())
+	}
+	return val.Elem()
+}
+
+func (t *specType) marshal(val reflect.Value, buf []byte) ([]byte, error) {
+	return t.marshalValue(t.indirect(val), buf)
+}
+
+func (t *specType) marshalValue(val reflect.Value, buf []byte) ([]byte, error) {
+	switch t.kind {
+	case specBasic:
+		return appendBasic(buf, val, t.size), nil
+	case specBitvector:
+		if val.Len() != t.size {
+			return nil, fmt.Errorf("bitvector of %d bits must have %d bytes, got %d", t.length, t.size, val.Len())
+		}
+		return append(buf, val.Bytes()...), nil
+	case specBitlist:
+		b := Bitlist(val.Bytes())
+		if len(b) == 0 || b[len(b)-1] == 0 {
+			return nil, errors.New("bitlist has no delimiting bit")
+		}
+		if t.limit > 0 && b.Len() > t.limit {
+			return nil, fmt.Errorf("bitlist has %d bits, exceeding its limit of %d", b.Len(), t.limit)
+		}
+		return append(buf, b...), nil
+	case specVector, specList:
+		n := val.Len()
+		if t.kind == specVector && uint64(n) != t.length {
+			return nil, fmt.Errorf("vector must have %d elements, got %d", t.length, n)
+		}
+		if t.kind == specList && t.limit > 0 && uint64(n) > t.limit {
+			return nil, fmt.Errorf("list has %d elements, exceeding its limit of %d", n, t.limit)
+		}
+		if val.Kind() == reflect.Slice && t.elem.typ.Kind() == reflect.Uint8 {
+			return append(buf, val.Bytes()...), nil
+		}
+		return marshalParts(t, buf, n, func(i int) (*specType, reflect.Value) {
+			return t.elem, val.Index(i)
+		})
+	case specContainer:
+		return marshalParts(t, buf, len(t.fields), func(i int) (*specType, reflect.Value) {
+			return t.fields[i].typ, val.Field(t.fields[i].index)
+		})
+	default:
+		return nil, fmt.Errorf("type %v is not serializable", t.typ)
+	}
+}
+
+// marshalParts serializes the n elements of a vector or list, or fields of a
+// container, returned by part. The fixed-size parts are serialized in place,
+// and the variable-size parts are replaced by offsets to their serializations,
+// which follow the fixed-size parts.
+func marshalParts(parent *specType, buf []byte, n int, part func(i int) (*specType, reflect.Value)) ([]byte, error) {
+	start := len(buf)
+	var variable, offsetPositions []int
+	var err error
+	for i := 0; i < n; i++ {
+		t, v := part(i)
+		if t.fixed {
+			if buf, err = t.marshal(v, buf); err != nil {
+				return nil, partError(parent, i, err)
+			}
+			continue
+		}
+		variable = append(variable, i)
+		offsetPositions = append(offsetPositions, len(buf))
+		buf = append(buf, make([]byte, offsetBytes)...)
+	}
+	for j, i := range variable {
+		offset := len(buf) - start
+		if offset > math.MaxUint32 {
+			return nil, fmt.Errorf("offset %d exceeds the maximum offset", offset)
+		}
+		binary.LittleEndian.PutUint32(buf[offsetPositions[j]:], uint32(offset))
+		t, v := part(i)
+		if buf, err = t.marshal(v, buf); err != nil {
+			return nil, partError(parent, i, err)
+		}
+	}
+	return buf, nil
+}
+
+// partError adds the index of the element of a vector or list, or the name of
+// the field of a container, where an error occurred.
+func partError(parent *specType, i int, err error) error {
+	if parent.kind == specContainer {
+		return fmt.Errorf("%s: %v", parent.fields[i].name, err)
+	}
+	return fmt.Errorf("[%d]: %v", i, err)
+}
+
+func appendBasic(buf []byte, val reflect.Value, size int) []byte {
+	var v uint64
+	switch val.Kind() {
+	case reflect.Bool:
+		if val.Bool() {
+			v = 1
+		}
+	case reflect.Int32:
+		v = uint64(uint32(val.Int()))
+	default:
+		v = val.Uint()
+	}
+	for i := 0; i < size; i++ {
+		buf = append(buf, byte(v>>(8*uint(i))))
+	}
+	return buf
+}
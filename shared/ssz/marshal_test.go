@@ -0,0 +1,263 @@
+package ssz
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type specFixedStruct struct {
+	A uint8
+	B uint64
+	C uint32
+}
+
+type specVariableStruct struct {
+	A uint16
+	B []uint16 `ssz-max:"1024"`
+	C uint8
+}
+
+type specBitsStruct struct {
+	Bits   Bitlist               `ssz-max:"10"`
+	Flags  Bitvector             `ssz-size:"10"`
+	Nested []*specVariableStruct `ssz-max:"4"`
+	Roots  [][]byte              `ssz-size:"?,32" ssz-max:"8"`
+}
+
+var specBitsValue = &specBitsStruct{
+	Bits:  Bitlist{0x0d},
+	Flags: Bitvector{0x01, 0x02},
+	Nested: []*specVariableStruct{
+		{A: 1, B: []uint16{}, C: 2},
+		{B: []uint16{}},
+	},
+	Roots: [][]byte{bytes.Repeat([]byte{0x11}, 32), bytes.Repeat([]byte{0x22}, 32)},
+}
+
+type specTest struct {
+	val          interface{}
+	output, root string
+}
+
+// The serializations and roots were computed with an independent
+// implementation of the spec. Notice: spaces in the strings will be ignored.
+var specTests = []specTest{
+	{
+		val:    true,
+		output: "01",
+		root:   "0100000000000000000000000000000000000000000000000000000000000000",
+	},
+	{
+		val:    uint16(0x1234),
+		output: "3412",
+		root:   "3412000000000000000000000000000000000000000000000000000000000000",
+	},
+	{
+		val:    [3]uint16{1, 2, 3},
+		output: "0100 0200 0300",
+		root:   "0100020003000000000000000000000000000000000000000000000000000000",
+	},
+	{
+		val:    []uint32{1, 2},
+		output: "01000000 02000000",
+		root:   "97d19ff28cdef4d6bca53a74363d5dd6bea62eea0c5687c96755217c8a385d27",
+	},
+	{
+		val:    []uint64{},
+		output: "",
+		root:   "f5a5fd42d16a20302798ef6ed309979b43003d2320d9f0e8ea9831a92759fb4b",
+	},
+	{
+		val:    [][]byte{{1, 2}, {3}},
+		output: "08000000 0a000000 0102 03",
+		root:   "f6f8a25829f918c799185c4f65c5f0fb1907dbb7507a5cbfa80a714344baf303",
+	},
+	{
+		val:    specFixedStruct{A: 0xab, B: 0xaabbccdd00112233, C: 0x12345678},
+		output: "ab 33221100ddccbbaa 78563412",
+		root:   "ad4e3e1f3337621f04c2d9962cae7c6cab505f10bbaadcba914504254944be58",
+	},
+	{
+		val:    &specVariableStruct{A: 0xabcd, B: []uint16{1, 2, 3}, C: 0xff},
+		output: "cdab 07000000 ff 0100 0200 0300",
+		root:   "14ebb4f45cf02de1b87d66f3c1b8e1cea6958c82b37fe81265c8edbff8d07e8c",
+	},
+	{
+		val: specBitsValue,
+		output: "0e000000 0102 0f000000 25000000 0d" +
+			"08000000 0f000000 0100 07000000 02 0000 07000000 00" +
+			strings.Repeat("11", 32) + strings.Repeat("22", 32),
+		root: "78b8aeb6bbac858ac3b8b2e3b4a48f51ead5fa43506e4a3c03e86ed63aab79a0",
+	},
+}
+
+func TestMarshal(t *testing.T) {
+	for i, test := range specTests {
+		output, err := Marshal(test.val)
+		if err != nil {
+			t.Errorf("test %d: unexpected error: %v\nvalue %#v", i, err, test.val)
+			continue
+		}
+		if !bytes.Equal(output, unhex(test.output)) {
+			t.Errorf("test %d: output mismatch:\ngot   %x\nwant  %s\nvalue %#v",
+				i, output, stripSpace(test.output), test.val)
+		}
+	}
+}
+
+func TestUnmarshal_RoundTrip(t *testing.T) {
+	for i, test := range specTests {
+		typ := reflect.TypeOf(test.val)
+		decoded := reflect.New(typ)
+		if err := Unmarshal(unhex(test.output), decoded.Interface()); err != nil {
+			t.Errorf("test %d: unexpected error: %v\ntype %v", i, err, typ)
+			continue
+		}
+		output, err := Marshal(decoded.Elem().Interface())
+		if err != nil {
+			t.Errorf("test %d: could not marshal decoded value: %v", i, err)
+			continue
+		}
+		if !bytes.Equal(output, unhex(test.output)) {
+			t.Errorf("test %d: round trip mismatch:\ngot   %x\nwant  %s", i, output, stripSpace(test.output))
+		}
+	}
+
+	decoded := &specVariableStruct{}
+	if err := Unmarshal(unhex("cdab 07000000 ff 0100 0200 0300"), decoded); err != nil {
+		t.Fatal(err)
+	}
+	want := &specVariableStruct{A: 0xabcd, B: []uint16{1, 2, 3}, C: 0xff}
+	if !reflect.DeepEqual(decoded, want) {
+		t.Errorf("Unmarshal returned %+v, expected %+v", decoded, want)
+	}
+}
+
+func TestMarshal_NilPointerIsZeroValue(t *testing.T) {
+	output, err := Marshal(&specBitsStruct{
+		Bits:   Bitlist{0x01},
+		Flags:  NewBitvector(10),
+		Nested: []*specVariableStruct{nil},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := unhex("0e000000 0000 0f000000 1a000000 01 04000000 0000 07000000 00")
+	if !bytes.Equal(output, want) {
+		t.Errorf("Marshal returned %x, expected %x", output, want)
+	}
+}
+
+func TestMarshal_InvalidValues(t *testing.T) {
+	tests := []struct {
+		val   interface{}
+		error string
+	}{
+		{
+			val:   &specBitsStruct{Bits: Bitlist{}, Flags: NewBitvector(10)},
+			error: "Bits: bitlist has no delimiting bit",
+		},
+		{
+			val:   &specBitsStruct{Bits: NewBitlist(11), Flags: NewBitvector(10)},
+			error: "Bits: bitlist has 11 bits, exceeding its limit of 10",
+		},
+		{
+			val:   &specBitsStruct{Bits: NewBitlist(1), Flags: NewBitvector(8)},
+			error: "Flags: bitvector of 10 bits must have 2 bytes, got 1",
+		},
+		{
+			val:   &specBitsStruct{Bits: NewBitlist(1), Flags: NewBitvector(10), Roots: [][]byte{{1}}},
+			error: "Roots: [0]: vector must have 32 elements, got 1",
+		},
+		{
+			val: &specBitsStruct{
+				Bits:   NewBitlist(1),
+				Flags:  NewBitvector(10),
+				Nested: make([]*specVariableStruct, 5),
+			},
+			error: "Nested: list has 5 elements, exceeding its limit of 4",
+		},
+	}
+	for i, test := range tests {
+		_, err := Marshal(test.val)
+		want := fmt.Sprintf("encode error: %s for input type %T", test.error, test.val)
+		if fmt.Sprint(err) != want {
+			t.Errorf("test %d: error mismatch\ngot   %v\nwant  %v", i, err, want)
+		}
+	}
+}
+
+func TestUnmarshal_InvalidInput(t *testing.T) {
+	tests := []struct {
+		input string
+		ptr   interface{}
+		error string
+	}{
+		{input: "0102", ptr: new(uint32), error: "expected 4 bytes, got 2"},
+		{input: "02", ptr: new(bool), error: "invalid boolean 2"},
+		{input: "010203", ptr: new([]uint16), error: "3 bytes is not a multiple of the element size 2"},
+		{
+			input: "cdab 08000000 ff 0100",
+			ptr:   new(specVariableStruct),
+			error: "first offset 8 does not match the end of the fixed-size parts at 7",
+		},
+		{
+			input: "cdab 07000000",
+			ptr:   new(specVariableStruct),
+			error: "expected at least 7 bytes, got 6",
+		},
+		{
+			input: "08000000 07000000 0102",
+			ptr:   new([][]byte),
+			error: "offset 7 at position 4 is out of bounds",
+		},
+		{
+			input: "08000000 0b000000 0102",
+			ptr:   new([][]byte),
+			error: "offset 11 at position 4 is out of bounds",
+		},
+		{input: "03000000", ptr: new([][]byte), error: "invalid first offset 3"},
+		{
+			// The first offset gives the number of elements, which must not be
+			// trusted before it is checked against the size of the input.
+			input: "fcffffff 00000000",
+			ptr:   new([][]byte),
+			error: "first offset 4294967292 is beyond the 8 bytes",
+		},
+		{
+			input: "0e000000 0100 0f000000 23000000 01 14000000 14000000 14000000 14000000 14000000",
+			ptr:   new(specBitsStruct),
+			error: "Nested: list has 5 elements, exceeding its limit of 4",
+		},
+		{
+			input: "0e000000 0104 0f000000 0f000000 01",
+			ptr:   new(specBitsStruct),
+			error: "Flags: bitvector of 10 bits has bits set beyond its length",
+		},
+		{
+			input: "0e000000 0100 0f000000 0f000000 00",
+			ptr:   new(specBitsStruct),
+			error: "Bits: bitlist has no delimiting bit",
+		},
+		{
+			input: "0e000000 0100 10000000 10000000 0010",
+			ptr:   new(specBitsStruct),
+			error: "Bits: bitlist has 12 bits, exceeding its limit of 10",
+		},
+		{
+			input: "0e000000 0100 0f000000 0f000000 01 1111",
+			ptr:   new(specBitsStruct),
+			error: "Roots: 2 bytes is not a multiple of the element size 32",
+		},
+	}
+	for i, test := range tests {
+		err := Unmarshal(unhex(test.input), test.ptr)
+		want := fmt.Sprintf("decode error: %s for output type %v", test.error, reflect.TypeOf(test.ptr).Elem())
+		if fmt.Sprint(err) != want {
+			t.Errorf("test %d: error mismatch\ngot   %v\nwant  %v", i, err, want)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package ssz
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+const bytesPerChunk = 32
+
+// zeroHashes[i] is the root of a tree of depth i whose leaves are all zero chunks.
+var zeroHashes [65][32]byte
+
+func init() {
+	for i := 1; i < len(zeroHashes); i++ {
+		zeroHashes[i] = sha256Pair(zeroHashes[i-1], zeroHashes[i-1])
+	}
+}
+
+// MerkleRoot computes the hash tree root of val as defined by the current SSZ
+// spec, which uses SHA-256 and pads lists to the next power of two of their
+// limit before mixing in their length. It differs from TreeHash, which
+// implements the earlier tree-hash that beacon blocks and states commit to.
+func MerkleRoot(val interface{}) ([32]byte, error) {
+	if val == nil {
+		return [32]byte{}, newHashError("untyped nil is not supported", nil)
+	}
+	rval := reflect.ValueOf(val)
+	t, err := cachedSpecType(rval.Type())
+	if err != nil {
+		return [32]byte{}, newHashError(fmt.Sprint(err), rval.Type())
+	}
+	root, err := t.merkleRoot(rval)
+	if err != nil {
+		return [32]byte{}, newHashError(fmt.Sprint(err), rval.Type())
+	}
+	return root, nil
+}
+
+func (t *specType) merkleRoot(val reflect.Value) ([32]byte, error) {
+	val = t.indirect(val)
+	if t.kind == specBasic {
+		var chunk [32]byte
+		copy(chunk[:], appendBasic(nil, val, t.size))
+		return chunk, nil
+	}
+	chunks, limit, err := t.chunks(val)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	root, err := merkleize(chunks, limit)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	if t.kind == specList || t.kind == specBitlist {
+		return mixInLength(root, t.listLength(val)), nil
+	}
+	return root, nil
+}
+
+// chunks returns the leaves of the Merkle tree of a composite value, and the
+// number of leaves the tree is padded to. The length of lists is mixed in
+// after merkleizing the leaves. Basic elements are packed into chunks, other
+// elements and fields are replaced by their roots.
+func (t *specType) chunks(val reflect.Value) ([][32]byte, uint64, error) {
+	switch t.kind {
+	case specBitvector:
+		if val.Len() != t.size {
+			return nil, 0, fmt.Errorf("bitvector of %d bits must have %d bytes, got %d", t.length, t.size, val.Len())
+		}
+		return pack(val.Bytes()), bitChunks(t.length), nil
+	case specBitlist:
+		b := Bitlist(val.Bytes())
+		if len(b) == 0 || b[len(b)-1] == 0 {
+			return nil, 0, errors.New("bitlist has no delimiting bit")
+		}
+		limit := bitChunks(b.Len())
+		if t.limit > 0 {
+			limit = bitChunks(t.limit)
+		}
+		return pack(b.Bytes()), limit, nil
+	case specVector, specList:
+		return t.sequenceChunks(val)
+	case specContainer:
+		roots := make([][32]byte, len(t.fields))
+		for i, f := range t.fields {
+			root, err := f.typ.merkleRoot(val.Field(f.index))
+			if err != nil {
+				return nil, 0, partError(t, i, err)
+			}
+			roots[i] = root
+		}
+		return roots, uint64(len(roots)), nil
+	default:
+		return nil, 0, fmt.Errorf("type %v is not serializable", t.typ)
+	}
+}
+
+func (t *specType) sequenceChunks(val reflect.Value) ([][32]byte, uint64, error) {
+	n := uint64(val.Len())
+	if t.kind == specVector && n != t.length {
+		return nil, 0, fmt.Errorf("vector must have %d elements, got %d", t.length, n)
+	}
+	limit := n
+	switch {
+	case t.kind == specVector:
+		limit = t.length
+	case t.limit > 0:
+		limit = t.limit
+	}
+
+	if t.isBasicSequence() {
+		enc, err := t.marshalValue(val, nil)
+		if err != nil {
+			return nil, 0, err
+		}
+		return pack(enc), (limit*uint64(t.elem.size) + bytesPerChunk - 1) / bytesPerChunk, nil
+	}
+	chunks := make([][32]byte, n)
+	for i := range chunks {
+		root, err := t.elem.merkleRoot(val.Index(i))
+		if err != nil {
+			return nil, 0, partError(t, i, err)
+		}
+		chunks[i] = root
+	}
+	return chunks, limit, nil
+}
+
+// listLength returns the number of elements of a list, or bits of a bitlist.
+func (t *specType) listLength(val reflect.Value) uint64 {
+	if t.kind == specBitlist {
+		return Bitlist(val.Bytes()).Len()
+	}
+	return uint64(val.Len())
+}
+
+// pack splits serialized basic values into chunks, right-padding the last one
+// with zeros.
+func pack(b []byte) [][32]byte {
+	chunks := make([][32]byte, (len(b)+bytesPerChunk-1)/bytesPerChunk)
+	for i := range chunks {
+		copy(chunks[i][:], b[i*bytesPerChunk:])
+	}
+	return chunks
+}
+
+// bitChunks returns the number of chunks needed to pack n bits.
+func bitChunks(n uint64) uint64 {
+	return (n + 8*bytesPerChunk - 1) / (8 * bytesPerChunk)
+}
+
+// merkleize returns the root of a binary Merkle tree whose leaves are chunks,
+// padded with zero chunks to the next power of two of limit. Zero subtrees are
+// not hashed, so the limit of a list may be far larger than its length.
+func merkleize(chunks [][32]byte, limit uint64) ([32]byte, error) {
+	if uint64(len(chunks)) > limit {
+		return [32]byte{}, fmt.Errorf("%d chunks exceed the limit of %d", len(chunks), limit)
+	}
+	depth := treeDepth(limit)
+	if len(chunks) == 0 {
+		return zeroHashes[depth], nil
+	}
+	layer := chunks
+	for d := 0; d < depth; d++ {
+		next := make([][32]byte, (len(layer)+1)/2)
+		for i := range next {
+			right := zeroHashes[d]
+			if 2*i+1 < len(layer) {
+				right = layer[2*i+1]
+			}
+			next[i] = sha256Pair(layer[2*i], right)
+		}
+		layer = next
+	}
+	return layer[0], nil
+}
+
+// treeDepth returns the depth of a tree with limit leaves, padded to the next
+// power of two.
+func treeDepth(limit uint64) int {
+	depth := 0
+	for depth < 64 && uint64(1)<<uint(depth) < limit {
+		depth++
+	}
+	return depth
+}
+
+// mixInLength hashes the root of a list with its length.
+func mixInLength(root [32]byte, length uint64) [32]byte {
+	var chunk [32]byte
+	binary.LittleEndian.PutUint64(chunk[:], length)
+	return sha256Pair(root, chunk)
+}
+
+func sha256Pair(a [32]byte, b [32]byte) [32]byte {
+	return sha256.Sum256(append(a[:], b[:]...))
+}
@@ -0,0 +1,64 @@
+package ssz
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestMerkleRoot(t *testing.T) {
+	for i, test := range specTests {
+		root, err := MerkleRoot(test.val)
+		if err != nil {
+			t.Errorf("test %d: unexpected error: %v\nvalue %#v", i, err, test.val)
+			continue
+		}
+		if !bytes.Equal(root[:], unhex(test.root)) {
+			t.Errorf("test %d: root mismatch:\ngot   %x\nwant  %s\nvalue %#v", i, root, test.root, test.val)
+		}
+	}
+}
+
+func TestMerkleRoot_PadsListToLimit(t *testing.T) {
+	b := make([]uint16, 100)
+	for i := range b {
+		b[i] = uint16(i)
+	}
+	root, err := MerkleRoot(&specVariableStruct{A: 7, B: b, C: 9})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := unhex("fa65ed40a8ca92d6456ffa2cb2a3256b29b6af3ad4c98773ac88701c4f43718e")
+	if !bytes.Equal(root[:], want) {
+		t.Errorf("MerkleRoot returned %x, expected %x", root, want)
+	}
+}
+
+func TestMerkleRoot_MixesInLength(t *testing.T) {
+	// Trailing zero elements change the length but not the packed chunks.
+	short, err := MerkleRoot([]uint64{1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	long, err := MerkleRoot([]uint64{1, 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if short == long {
+		t.Error("Expected lists of different lengths to have different roots")
+	}
+}
+
+func TestMerkleize_ZeroHashes(t *testing.T) {
+	chunk := [32]byte{1}
+	root, err := merkleize([][32]byte{chunk}, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := sha256Pair(sha256Pair(chunk, [32]byte{}), sha256Pair([32]byte{}, [32]byte{}))
+	if root != want {
+		t.Errorf("merkleize returned %x, expected %x", root, want)
+	}
+	if _, err := merkleize(make([][32]byte, 3), 2); err == nil {
+		t.Error("Expected an error for chunks exceeding the limit")
+	}
+}
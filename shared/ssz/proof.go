@@ -0,0 +1,454 @@
+package ssz
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// lengthPath is the path element which addresses the length of a list.
+const lengthPath = "__len__"
+
+// GeneralizedIndex returns the generalized index, in the Merkle tree computed
+// by MerkleRoot, of the node at path in val. The root has index 1 and the
+// children of the node with index i have indices 2i and 2i+1.
+//
+// A path is a sequence of field names separated by dots, each optionally
+// followed by list or vector indices in brackets, such as
+// "validator_registry[42].exit_epoch". Field names match the Go name of the
+// field, ignoring case and underscores. The element __len__ addresses the
+// length of a list. The node of an element of a list or vector of basic
+// values, or of a bit of a bitlist or bitvector, is the chunk which holds it.
+//
+// Generalized indices depend on the value, not only on its type, as the tree
+// of a list without an ssz-max tag is as deep as its length requires.
+func GeneralizedIndex(val interface{}, path string) (uint64, error) {
+	if val == nil {
+		return 0, newHashError("untyped nil is not supported", nil)
+	}
+	rval := reflect.ValueOf(val)
+	t, err := cachedSpecType(rval.Type())
+	if err != nil {
+		return 0, newHashError(fmt.Sprint(err), rval.Type())
+	}
+	elems, err := parsePath(path)
+	if err != nil {
+		return 0, newHashError(fmt.Sprint(err), rval.Type())
+	}
+	index, err := t.generalizedIndex(rval, elems)
+	if err != nil {
+		return 0, newHashError(fmt.Sprintf("path %q: %v", path, err), rval.Type())
+	}
+	return index, nil
+}
+
+// MerkleNode returns the node with the given generalized index in the Merkle
+// tree of val.
+func MerkleNode(val interface{}, index uint64) ([32]byte, error) {
+	t, rval, err := proofType(val)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	node, err := newProver(t, rval).node(index)
+	if err != nil {
+		return [32]byte{}, newHashError(fmt.Sprint(err), rval.Type())
+	}
+	return node, nil
+}
+
+// MerkleProof returns the branch which proves the node with the given
+// generalized index against the root of val, ordered from the sibling of the
+// node up to the child of the root.
+//
+// The root is the one computed by MerkleRoot, not the TreeHash root which
+// beacon blocks commit to, so a proof of a field of a beacon state does not
+// verify against the StateRootHash32 of a block. TreeHashProof proves values
+// against that root.
+func MerkleProof(val interface{}, index uint64) ([][32]byte, error) {
+	if index == 0 {
+		return nil, newHashError("generalized index must be positive", reflect.TypeOf(val))
+	}
+	return MerkleMultiproof(val, []uint64{index})
+}
+
+// MerkleMultiproof returns the nodes which prove all the nodes with the given
+// generalized indices against the root of val. These are the siblings of the
+// nodes and of their ancestors which can't be computed from the nodes
+// themselves, ordered by decreasing generalized index. As with MerkleProof,
+// the nodes are proved against the MerkleRoot of val and not its TreeHash root.
+func MerkleMultiproof(val interface{}, indices []uint64) ([][32]byte, error) {
+	t, rval, err := proofType(val)
+	if err != nil {
+		return nil, err
+	}
+	helpers, err := helperIndices(indices)
+	if err != nil {
+		return nil, newHashError(fmt.Sprint(err), rval.Type())
+	}
+	p := newProver(t, rval)
+	proof := make([][32]byte, len(helpers))
+	for i, index := range helpers {
+		if proof[i], err = p.node(index); err != nil {
+			return nil, newHashError(fmt.Sprint(err), rval.Type())
+		}
+	}
+	return proof, nil
+}
+
+// VerifyMerkleProof reports whether proof, as returned by MerkleProof, proves
+// that leaf is the node with the given generalized index in the tree with
+// the given root.
+func VerifyMerkleProof(root [32]byte, leaf [32]byte, index uint64, proof [][32]byte) bool {
+	if index == 0 || len(proof) != bits.Len64(index)-1 {
+		return false
+	}
+	node := leaf
+	for _, sibling := range proof {
+		if index&1 == 1 {
+			node = sha256Pair(sibling, node)
+		} else {
+			node = sha256Pair(node, sibling)
+		}
+		index >>= 1
+	}
+	return node == root
+}
+
+// VerifyMerkleMultiproof reports whether proof, as returned by
+// MerkleMultiproof, proves that leaves are the nodes with the given
+// generalized indices in the tree with the given root.
+func VerifyMerkleMultiproof(root [32]byte, leaves [][32]byte, indices []uint64, proof [][32]byte) bool {
+	if len(leaves) != len(indices) {
+		return false
+	}
+	helpers, err := helperIndices(indices)
+	if err != nil || len(proof) != len(helpers) {
+		return false
+	}
+	nodes := make(map[uint64][32]byte, len(leaves)+len(proof))
+	for i, index := range indices {
+		nodes[index] = leaves[i]
+	}
+	for i, index := range helpers {
+		nodes[index] = proof[i]
+	}
+	keys := make([]uint64, 0, len(nodes))
+	for index := range nodes {
+		keys = append(keys, index)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] > keys[j] })
+	// Parents are appended after their children, so that each node is
+	// hashed with its sibling once both are known.
+	for i := 0; i < len(keys); i++ {
+		index := keys[i]
+		if index <= 1 {
+			continue
+		}
+		if _, ok := nodes[index/2]; ok {
+			continue
+		}
+		left, okLeft := nodes[index&^1]
+		right, okRight := nodes[index|1]
+		if okLeft && okRight {
+			nodes[index/2] = sha256Pair(left, right)
+			keys = append(keys, index/2)
+		}
+	}
+	computed, ok := nodes[1]
+	return ok && computed == root
+}
+
+func proofType(val interface{}) (*specType, reflect.Value, error) {
+	if val == nil {
+		return nil, reflect.Value{}, newHashError("untyped nil is not supported", nil)
+	}
+	rval := reflect.ValueOf(val)
+	t, err := cachedSpecType(rval.Type())
+	if err != nil {
+		return nil, reflect.Value{}, newHashError(fmt.Sprint(err), rval.Type())
+	}
+	return t, rval, nil
+}
+
+// helperIndices returns the generalized indices of the nodes which are
+// needed to prove the nodes with the given indices, in decreasing order.
+func helperIndices(indices []uint64) ([]uint64, error) {
+	if len(indices) == 0 {
+		return nil, errors.New("no generalized indices to prove")
+	}
+	paths := make(map[uint64]bool)
+	for _, index := range indices {
+		if index == 0 {
+			return nil, errors.New("generalized index must be positive")
+		}
+		for i := index; i > 1; i /= 2 {
+			paths[i] = true
+		}
+	}
+	var helpers []uint64
+	seen := make(map[uint64]bool)
+	for _, index := range indices {
+		for i := index; i > 1; i /= 2 {
+			sibling := i ^ 1
+			if !paths[sibling] && !seen[sibling] {
+				seen[sibling] = true
+				helpers = append(helpers, sibling)
+			}
+		}
+	}
+	sort.Slice(helpers, func(i, j int) bool { return helpers[i] > helpers[j] })
+	return helpers, nil
+}
+
+// pathElem is an element of a path: a field name, an index, or the length
+// of a list.
+type pathElem struct {
+	field  string
+	index  uint64
+	isItem bool
+	isLen  bool
+}
+
+func parsePath(path string) ([]pathElem, error) {
+	if path == "" {
+		return nil, nil
+	}
+	var elems []pathElem
+	for _, part := range strings.Split(path, ".") {
+		name := part
+		if i := strings.IndexByte(part, '['); i >= 0 {
+			name = part[:i]
+		}
+		switch {
+		case name == lengthPath:
+			elems = append(elems, pathElem{isLen: true})
+		case name != "":
+			elems = append(elems, pathElem{field: name})
+		case len(elems) > 0:
+			return nil, fmt.Errorf("empty field name in path %q", path)
+		}
+		rest := part[len(name):]
+		for rest != "" {
+			end := strings.IndexByte(rest, ']')
+			if rest[0] != '[' || end < 0 {
+				return nil, fmt.Errorf("invalid index in path %q", path)
+			}
+			index, err := strconv.ParseUint(rest[1:end], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid index in path %q: %v", path, err)
+			}
+			elems = append(elems, pathElem{index: index, isItem: true})
+			rest = rest[end+1:]
+		}
+	}
+	return elems, nil
+}
+
+// fieldIndex returns the position of the field named name in a container.
+func (t *specType) fieldIndex(name string) (int, bool) {
+	normalized := strings.Replace(name, "_", "", -1)
+	for i, f := range t.fields {
+		if strings.EqualFold(strings.Replace(f.name, "_", "", -1), normalized) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func (t *specType) generalizedIndex(val reflect.Value, path []pathElem) (uint64, error) {
+	index := uint64(1)
+	for i, elem := range path {
+		if t == nil || t.kind == specBasic {
+			return 0, errors.New("path continues below a basic value")
+		}
+		val = t.indirect(val)
+		var depth int
+		var position uint64
+		var next *specType
+		var nextVal reflect.Value
+		switch {
+		case elem.isLen:
+			if t.kind != specList && t.kind != specBitlist {
+				return 0, fmt.Errorf("%v is not a list", t.typ)
+			}
+			if i != len(path)-1 {
+				return 0, errors.New("path continues below the length of a list")
+			}
+			depth, position = 1, 1
+		case !elem.isItem:
+			if t.kind != specContainer {
+				return 0, fmt.Errorf("%v has no field %s", t.typ, elem.field)
+			}
+			field, ok := t.fieldIndex(elem.field)
+			if !ok {
+				return 0, fmt.Errorf("%v has no field %s", t.typ, elem.field)
+			}
+			depth, position = treeDepth(uint64(len(t.fields))), uint64(field)
+			next, nextVal = t.fields[field].typ, val.Field(t.fields[field].index)
+		default:
+			var err error
+			if depth, position, err = t.itemPosition(val, elem.index); err != nil {
+				return 0, err
+			}
+			if t.kind == specVector || t.kind == specList {
+				if t.elem.kind != specBasic {
+					next, nextVal = t.elem, val.Index(int(elem.index))
+				}
+			}
+		}
+		if bits.Len64(index)+depth > 64 {
+			return 0, errors.New("generalized index exceeds 64 bits")
+		}
+		index = index<<uint(depth) | position
+		t, val = next, nextVal
+	}
+	return index, nil
+}
+
+// itemPosition returns the depth, below a vector or list, of the chunk
+// holding the element or bit with the given index, and its position at that
+// depth. The data of a list is the left child of its root.
+func (t *specType) itemPosition(val reflect.Value, index uint64) (int, uint64, error) {
+	var length uint64
+	switch t.kind {
+	case specVector, specBitvector:
+		length = t.length
+	case specList, specBitlist:
+		length = t.listLength(val)
+	default:
+		return 0, 0, fmt.Errorf("%v is not a list or vector", t.typ)
+	}
+	if index >= length {
+		return 0, 0, fmt.Errorf("index %d is out of range for length %d", index, length)
+	}
+	_, limit, err := t.chunks(val)
+	if err != nil {
+		return 0, 0, err
+	}
+	position := index
+	switch {
+	case t.kind == specBitvector || t.kind == specBitlist:
+		position = index / (8 * bytesPerChunk)
+	case t.elem.kind == specBasic:
+		position = index * uint64(t.elem.size) / bytesPerChunk
+	}
+	depth := treeDepth(limit)
+	if t.kind == specList || t.kind == specBitlist {
+		return depth + 1, position, nil
+	}
+	return depth, position, nil
+}
+
+// prover computes nodes of the tree of a value. It keeps the layers of the
+// trees of the values it descends into, so that the nodes of a multiproof
+// are computed with a single pass over each value on their paths.
+type prover struct {
+	t   *specType
+	val reflect.Value
+	// trees holds the layers of the tree of the leaves of each value, before
+	// mixing in the length of lists, by the generalized index of its root.
+	trees map[uint64][][][32]byte
+}
+
+func newProver(t *specType, val reflect.Value) *prover {
+	return &prover{t: t, val: val, trees: make(map[uint64][][][32]byte)}
+}
+
+// node returns the node with generalized index g.
+func (p *prover) node(g uint64) ([32]byte, error) {
+	if g == 0 {
+		return [32]byte{}, errors.New("generalized index must be positive")
+	}
+	if g == 1 {
+		return p.t.merkleRoot(p.val)
+	}
+	return p.subtreeNode(p.t, p.t.indirect(p.val), 1, g)
+}
+
+// subtreeNode returns the node with generalized index g in the tree of val,
+// whose root has generalized index root in the tree of the proven value.
+func (p *prover) subtreeNode(t *specType, val reflect.Value, root uint64, g uint64) ([32]byte, error) {
+	if t.kind == specBasic {
+		return [32]byte{}, fmt.Errorf("node %d is below a basic value", g)
+	}
+	depth := bits.Len64(g) - 1
+	if t.kind == specList || t.kind == specBitlist {
+		// The root of a list hashes the root of its data with its length.
+		if g>>uint(depth-1) == 3 {
+			if depth > 1 {
+				return [32]byte{}, fmt.Errorf("node %d is below the length of a list", g)
+			}
+			var chunk [32]byte
+			copy(chunk[:], appendBasic(nil, reflect.ValueOf(t.listLength(val)), 8))
+			return chunk, nil
+		}
+		depth--
+		g = 1<<uint(depth) | g&(1<<uint(depth)-1)
+		root *= 2
+	}
+	layers, err := p.layers(t, val, root)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	dataDepth := len(layers) - 1
+	if depth <= dataDepth {
+		level := dataDepth - depth
+		i := g - 1<<uint(depth)
+		if i < uint64(len(layers[level])) {
+			return layers[level][i], nil
+		}
+		return zeroHashes[level], nil
+	}
+
+	// The node is in the tree of an element or field.
+	below := uint(depth - dataDepth)
+	leaf := g>>below - 1<<uint(dataDepth)
+	sub := 1<<below | g&(1<<below-1)
+	leafRoot := root<<uint(dataDepth) | leaf
+	switch {
+	case t.kind == specContainer && leaf < uint64(len(t.fields)):
+		f := t.fields[leaf]
+		return p.subtreeNode(f.typ, f.typ.indirect(val.Field(f.index)), leafRoot, sub)
+	case (t.kind == specVector || t.kind == specList) && t.elem.kind != specBasic && leaf < uint64(val.Len()):
+		return p.subtreeNode(t.elem, t.elem.indirect(val.Index(int(leaf))), leafRoot, sub)
+	default:
+		return [32]byte{}, fmt.Errorf("node %d is below a leaf", g)
+	}
+}
+
+// layers returns the layers of the tree of the leaves of val, from the
+// leaves up to the root, leaving out the zero subtrees on the right.
+func (p *prover) layers(t *specType, val reflect.Value, root uint64) ([][][32]byte, error) {
+	if layers, ok := p.trees[root]; ok {
+		return layers, nil
+	}
+	chunks, limit, err := t.chunks(val)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(chunks)) > limit {
+		return nil, fmt.Errorf("%d chunks exceed the limit of %d", len(chunks), limit)
+	}
+	depth := treeDepth(limit)
+	layers := make([][][32]byte, depth+1)
+	layers[0] = chunks
+	for d := 0; d < depth; d++ {
+		layer := layers[d]
+		next := make([][32]byte, (len(layer)+1)/2)
+		for i := range next {
+			right := zeroHashes[d]
+			if 2*i+1 < len(layer) {
+				right = layer[2*i+1]
+			}
+			next[i] = sha256Pair(layer[2*i], right)
+		}
+		layers[d+1] = next
+	}
+	p.trees[root] = layers
+	return layers, nil
+}
@@ -0,0 +1,250 @@
+package ssz
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// proofValue is a specBitsStruct with a list of uint64 values spanning
+// several chunks.
+var proofValue = &specBitsStruct{
+	Bits:  Bitlist{0x0d},
+	Flags: Bitvector{0x01, 0x02},
+	Nested: []*specVariableStruct{
+		{A: 1, B: []uint16{}, C: 2},
+		{A: 3, B: []uint16{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19}, C: 4},
+	},
+	Roots: [][]byte{bytes.Repeat([]byte{0x11}, 32), bytes.Repeat([]byte{0x22}, 32)},
+}
+
+func TestGeneralizedIndex(t *testing.T) {
+	tests := []struct {
+		path  string
+		index uint64
+	}{
+		{path: "", index: 1},
+		// The four fields of specBitsStruct are the leaves of a tree of depth 2.
+		{path: "bits", index: 4},
+		{path: "Flags", index: 5},
+		{path: "nested", index: 6},
+		{path: "roots", index: 7},
+		// The length of a list is the right child of its root.
+		{path: "bits.__len__", index: 9},
+		{path: "nested.__len__", index: 13},
+		// Nested has a limit of 4 elements, below the left child of its root.
+		{path: "nested[0]", index: 6<<3 | 0},
+		{path: "nested[1]", index: 6<<3 | 1},
+		{path: "nested[1].c", index: (6<<3|1)<<2 | 2},
+		// B has a limit of 1024 uint16 values, packed into 64 chunks.
+		{path: "nested[1].b[17]", index: ((6<<3|1)<<2|1)<<7 | 1},
+		{path: "roots[1]", index: 7<<4 | 1},
+		// The bits of Flags fit in a single chunk.
+		{path: "flags[9]", index: 5},
+		{path: "bits[2]", index: 4 << 1},
+	}
+	for _, test := range tests {
+		index, err := GeneralizedIndex(proofValue, test.path)
+		if err != nil {
+			t.Errorf("Could not get generalized index of %q: %v", test.path, err)
+			continue
+		}
+		if index != test.index {
+			t.Errorf("Generalized index of %q is %d, expected %d", test.path, index, test.index)
+		}
+	}
+}
+
+func TestGeneralizedIndex_InvalidPath(t *testing.T) {
+	tests := []struct {
+		path  string
+		error string
+	}{
+		{path: "missing", error: "has no field missing"},
+		{path: "nested[2]", error: "index 2 is out of range for length 2"},
+		{path: "flags.a", error: "ssz.Bitvector has no field a"},
+		{path: "nested[0].c.a", error: "path continues below a basic value"},
+		{path: "flags[3].a", error: "path continues below a basic value"},
+		{path: "roots.__len__.a", error: "path continues below the length of a list"},
+		{path: "nested[0].__len__", error: "is not a list"},
+		{path: "flags.__len__", error: "ssz.Bitvector is not a list"},
+		{path: "nested[0].c[0]", error: "path continues below a basic value"},
+		{path: "nested[0][0]", error: "is not a list or vector"},
+		{path: "nested[x]", error: "invalid index"},
+		{path: "nested..a", error: "empty field name"},
+	}
+	for _, test := range tests {
+		_, err := GeneralizedIndex(proofValue, test.path)
+		if err == nil || !strings.Contains(err.Error(), test.error) {
+			t.Errorf("Expected error containing %q for path %q, received %v", test.error, test.path, err)
+		}
+	}
+}
+
+func TestMerkleNode(t *testing.T) {
+	tests := []struct {
+		path string
+		val  interface{}
+	}{
+		{path: "", val: proofValue},
+		{path: "roots[1]", val: [32]byte{0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22,
+			0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22,
+			0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22}},
+		{path: "nested[1].b[17]", val: [16]uint16{16, 17, 18, 19}},
+		{path: "nested[1]", val: proofValue.Nested[1]},
+		{path: "nested[0].a", val: proofValue.Nested[0].A},
+		{path: "nested[0].c", val: proofValue.Nested[0].C},
+		{path: "bits.__len__", val: uint64(3)},
+		{path: "nested.__len__", val: uint64(2)},
+	}
+	for _, test := range tests {
+		index, err := GeneralizedIndex(proofValue, test.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		node, err := MerkleNode(proofValue, index)
+		if err != nil {
+			t.Errorf("Could not get node %q: %v", test.path, err)
+			continue
+		}
+		// Tags of the fields don't apply to the values, so compare with the
+		// roots of values whose types don't need them.
+		want, err := MerkleRoot(test.val)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if node != want {
+			t.Errorf("Node %q is %#x, expected %#x", test.path, node, want)
+		}
+	}
+
+	// Nodes in the padding of a list are roots of zero subtrees.
+	node, err := MerkleNode(proofValue, 6<<3|3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if node != zeroHashes[0] {
+		t.Errorf("Padding node is %#x, expected zero", node)
+	}
+	if _, err := MerkleNode(proofValue, (6<<3|3)<<1); err == nil {
+		t.Error("Expected an error for a node below the padding of a list")
+	}
+}
+
+func TestMerkleProof(t *testing.T) {
+	root, err := MerkleRoot(proofValue)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{"bits", "flags[3]", "nested[1].b", "nested[1].b[19]", "nested[0].c", "roots[1]", "roots.__len__"} {
+		index, err := GeneralizedIndex(proofValue, path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		leaf, err := MerkleNode(proofValue, index)
+		if err != nil {
+			t.Fatal(err)
+		}
+		proof, err := MerkleProof(proofValue, index)
+		if err != nil {
+			t.Fatalf("Could not get proof of %q: %v", path, err)
+		}
+		if !VerifyMerkleProof(root, leaf, index, proof) {
+			t.Errorf("Proof of %q did not verify", path)
+		}
+		if VerifyMerkleProof(root, leaf, index^1, proof) {
+			t.Errorf("Proof of %q verified for the wrong index", path)
+		}
+		leaf[0] ^= 1
+		if VerifyMerkleProof(root, leaf, index, proof) {
+			t.Errorf("Proof of %q verified for the wrong leaf", path)
+		}
+	}
+}
+
+func TestMerkleProof_ValidatorExitEpoch(t *testing.T) {
+	state := &pb.BeaconState{Slot: 100}
+	for i := 0; i < 50; i++ {
+		state.ValidatorRegistry = append(state.ValidatorRegistry, &pb.Validator{
+			Pubkey:    []byte{byte(i)},
+			ExitEpoch: uint64(1000 + i),
+		})
+	}
+	root, err := MerkleRoot(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	index, err := GeneralizedIndex(state, "validator_registry[42].exit_epoch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf, err := MerkleNode(state, index)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := MerkleRoot(uint64(1042))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if leaf != want {
+		t.Errorf("Exit epoch node is %#x, expected %#x", leaf, want)
+	}
+	proof, err := MerkleProof(state, index)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !VerifyMerkleProof(root, leaf, index, proof) {
+		t.Error("Proof of the exit epoch did not verify")
+	}
+}
+
+func TestMerkleMultiproof(t *testing.T) {
+	root, err := MerkleRoot(proofValue)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var indices []uint64
+	var leaves [][32]byte
+	for _, path := range []string{"nested[1].b[3]", "nested[1].b[18]", "nested[0].c", "nested[1].c", "roots[1]", "bits.__len__"} {
+		index, err := GeneralizedIndex(proofValue, path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		leaf, err := MerkleNode(proofValue, index)
+		if err != nil {
+			t.Fatal(err)
+		}
+		indices = append(indices, index)
+		leaves = append(leaves, leaf)
+	}
+	proof, err := MerkleMultiproof(proofValue, indices)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !VerifyMerkleMultiproof(root, leaves, indices, proof) {
+		t.Error("Multiproof did not verify")
+	}
+	if VerifyMerkleMultiproof(root, leaves, indices, proof[1:]) {
+		t.Error("Multiproof verified with a missing node")
+	}
+	leaves[2][0] ^= 1
+	if VerifyMerkleMultiproof(root, leaves, indices, proof) {
+		t.Error("Multiproof verified for the wrong leaf")
+	}
+}
+
+func TestHelperIndices(t *testing.T) {
+	helpers, err := helperIndices([]uint64{9, 14})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The branches of 9 and 14 are 8, 5, 3 and 15, 6, 2, of which 3 and 2 are
+	// on the paths of the other index.
+	want := []uint64{15, 8, 6, 5}
+	if !reflect.DeepEqual(helpers, want) {
+		t.Errorf("Helper indices are %v, expected %v", helpers, want)
+	}
+}
@@ -0,0 +1,528 @@
+package ssz
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/go-yaml/yaml"
+)
+
+var (
+	registryLock sync.RWMutex
+	registry     = make(map[string]reflect.Type)
+)
+
+// RegisterType registers the type of val, or the type it points to, under
+// name, so that tools can create values of it with NewRegisteredValue.
+func RegisterType(name string, val interface{}) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+	registry[name] = baseType(reflect.TypeOf(val))
+}
+
+// NewRegisteredValue returns a pointer to a new zero value of the type
+// registered under name.
+func NewRegisteredValue(name string) (interface{}, error) {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+	typ, ok := registry[name]
+	if !ok {
+		return nil, fmt.Errorf("no type is registered as %s", name)
+	}
+	return reflect.New(typ).Interface(), nil
+}
+
+// RegisteredTypes returns the names of the registered types in sorted order.
+func RegisteredTypes() []string {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// object is the rendering of a struct, which keeps the order of its fields.
+type object []objectField
+
+type objectField struct {
+	name  string
+	value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o object) MarshalJSON() ([]byte, error) {
+	buf := bytes.NewBufferString("{")
+	for i, f := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, err := json.Marshal(f.name)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(f.value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (o object) MarshalYAML() (interface{}, error) {
+	items := make(yaml.MapSlice, len(o))
+	for i, f := range o {
+		items[i] = yaml.MapItem{Key: f.name, Value: f.value}
+	}
+	return items, nil
+}
+
+// ToJSON renders val as indented JSON. Structs are rendered as objects with
+// their fields in order, named as in the proto definitions, byte slices and
+// arrays, bitlists and bitvectors as 0x-prefixed hex strings, and integers as
+// decimal numbers. A nil pointer is rendered as null.
+func ToJSON(val interface{}) ([]byte, error) {
+	rendered, err := render(val)
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(rendered, "", "  ")
+}
+
+// ToYAML renders val as YAML, in the same way as ToJSON.
+func ToYAML(val interface{}) ([]byte, error) {
+	rendered, err := render(val)
+	if err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(rendered)
+}
+
+// FromJSON parses the JSON rendering of a value, as produced by ToJSON, into
+// the value val points to. Fields which are missing keep their zero value,
+// unknown fields are an error.
+func FromJSON(data []byte, val interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var parsed interface{}
+	if err := dec.Decode(&parsed); err != nil {
+		return fmt.Errorf("could not parse JSON: %v", err)
+	}
+	return assignRendered(parsed, val)
+}
+
+// FromYAML parses the YAML rendering of a value, as produced by ToYAML, into
+// the value val points to.
+func FromYAML(data []byte, val interface{}) error {
+	var parsed interface{}
+	if err := yaml.Unmarshal(data, &parsed); err != nil {
+		return fmt.Errorf("could not parse YAML: %v", err)
+	}
+	return assignRendered(parsed, val)
+}
+
+func render(val interface{}) (interface{}, error) {
+	if val == nil {
+		return nil, newEncodeError("untyped nil is not supported", nil)
+	}
+	rval := reflect.ValueOf(val)
+	rendered, err := renderValue(rval, "")
+	if err != nil {
+		return nil, newEncodeError(fmt.Sprint(err), rval.Type())
+	}
+	return rendered, nil
+}
+
+func renderValue(val reflect.Value, path string) (interface{}, error) {
+	switch val.Kind() {
+	case reflect.Bool:
+		return val.Bool(), nil
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return val.Uint(), nil
+	case reflect.Int32:
+		// Proto enums are int32.
+		return val.Int(), nil
+	case reflect.Ptr:
+		if val.IsNil() {
+			return nil, nil
+		}
+		return renderValue(val.Elem(), path)
+	case reflect.Slice, reflect.Array:
+		if val.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, val.Len())
+			reflect.Copy(reflect.ValueOf(b), val)
+			return "0x" + hex.EncodeToString(b), nil
+		}
+		items := make([]interface{}, val.Len())
+		for i := range items {
+			item, err := renderValue(val.Index(i), fmt.Sprintf("%s[%d]", path, i))
+			if err != nil {
+				return nil, err
+			}
+			items[i] = item
+		}
+		return items, nil
+	case reflect.Struct:
+		var o object
+		for _, f := range renderedFields(val.Type()) {
+			value, err := renderValue(val.Field(f.index), joinPath(path, f.name))
+			if err != nil {
+				return nil, err
+			}
+			o = append(o, objectField{name: f.name, value: value})
+		}
+		return o, nil
+	default:
+		return nil, fmt.Errorf("%s: type %v is not supported", pathName(path), val.Type())
+	}
+}
+
+type renderedField struct {
+	index int
+	name  string
+}
+
+// renderedFields returns the fields of a struct which are rendered, with the
+// names of the proto fields they were generated from, or their Go names in
+// snake case.
+func renderedFields(typ reflect.Type) []renderedField {
+	var fields []renderedField
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		if f.PkgPath != "" || strings.Contains(f.Name, "XXX") {
+			continue
+		}
+		fields = append(fields, renderedField{index: i, name: renderedName(f)})
+	}
+	return fields
+}
+
+func renderedName(f reflect.StructField) string {
+	for _, option := range strings.Split(f.Tag.Get("protobuf"), ",") {
+		if strings.HasPrefix(option, "name=") {
+			return strings.TrimPrefix(option, "name=")
+		}
+	}
+	var name []rune
+	for i, r := range f.Name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				name = append(name, '_')
+			}
+			r = unicode.ToLower(r)
+		}
+		name = append(name, r)
+	}
+	return string(name)
+}
+
+func joinPath(path string, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func pathName(path string) string {
+	if path == "" {
+		return "value"
+	}
+	return path
+}
+
+func assignRendered(parsed interface{}, val interface{}) error {
+	rval := reflect.ValueOf(val)
+	if rval.Kind() != reflect.Ptr || rval.IsNil() {
+		return newDecodeError("can only decode into pointer types", reflect.TypeOf(val))
+	}
+	if err := assignValue(parsed, rval.Elem(), ""); err != nil {
+		return newDecodeError(fmt.Sprint(err), rval.Type())
+	}
+	return nil
+}
+
+func assignValue(parsed interface{}, val reflect.Value, path string) error {
+	switch val.Kind() {
+	case reflect.Bool:
+		b, ok := parsed.(bool)
+		if !ok {
+			return fmt.Errorf("%s: expected a boolean, received %v", pathName(path), parsed)
+		}
+		val.SetBool(b)
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := parseUint(parsed, uint(val.Type().Bits()))
+		if err != nil {
+			return fmt.Errorf("%s: %v", pathName(path), err)
+		}
+		val.SetUint(n)
+	case reflect.Int32:
+		n, err := parseInt32(parsed)
+		if err != nil {
+			return fmt.Errorf("%s: %v", pathName(path), err)
+		}
+		val.SetInt(n)
+	case reflect.Ptr:
+		if parsed == nil {
+			val.Set(reflect.Zero(val.Type()))
+			return nil
+		}
+		elem := reflect.New(val.Type().Elem())
+		if err := assignValue(parsed, elem.Elem(), path); err != nil {
+			return err
+		}
+		val.Set(elem)
+	case reflect.Slice, reflect.Array:
+		if val.Type().Elem().Kind() == reflect.Uint8 {
+			return assignBytes(parsed, val, path)
+		}
+		items, ok := parsed.([]interface{})
+		if !ok {
+			return fmt.Errorf("%s: expected a list, received %v", pathName(path), parsed)
+		}
+		if val.Kind() == reflect.Array {
+			if len(items) != val.Len() {
+				return fmt.Errorf("%s: expected %d items, received %d", pathName(path), val.Len(), len(items))
+			}
+		} else {
+			val.Set(reflect.MakeSlice(val.Type(), len(items), len(items)))
+		}
+		for i, item := range items {
+			if err := assignValue(item, val.Index(i), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Struct:
+		return assignStruct(parsed, val, path)
+	default:
+		return fmt.Errorf("%s: type %v is not supported", pathName(path), val.Type())
+	}
+	return nil
+}
+
+func assignBytes(parsed interface{}, val reflect.Value, path string) error {
+	s, ok := parsed.(string)
+	if !ok || !strings.HasPrefix(s, "0x") {
+		return fmt.Errorf("%s: expected a 0x-prefixed hex string, received %v", pathName(path), parsed)
+	}
+	b, err := hex.DecodeString(s[2:])
+	if err != nil {
+		return fmt.Errorf("%s: invalid hex string: %v", pathName(path), err)
+	}
+	if val.Kind() == reflect.Array {
+		if len(b) != val.Len() {
+			return fmt.Errorf("%s: expected %d bytes, received %d", pathName(path), val.Len(), len(b))
+		}
+	} else {
+		val.Set(reflect.MakeSlice(val.Type(), len(b), len(b)))
+	}
+	reflect.Copy(val, reflect.ValueOf(b))
+	return nil
+}
+
+func assignStruct(parsed interface{}, val reflect.Value, path string) error {
+	// JSON objects are parsed into maps with string keys, YAML mappings into
+	// maps with keys of any type.
+	values := make(map[string]interface{})
+	switch m := parsed.(type) {
+	case map[string]interface{}:
+		values = m
+	case map[interface{}]interface{}:
+		for k, v := range m {
+			name, ok := k.(string)
+			if !ok {
+				return fmt.Errorf("%s: field name %v is not a string", pathName(path), k)
+			}
+			values[name] = v
+		}
+	default:
+		return fmt.Errorf("%s: expected an object, received %v", pathName(path), parsed)
+	}
+	fields := renderedFields(val.Type())
+	known := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		known[f.name] = true
+		v, ok := values[f.name]
+		if !ok {
+			continue
+		}
+		if err := assignValue(v, val.Field(f.index), joinPath(path, f.name)); err != nil {
+			return err
+		}
+	}
+	for name := range values {
+		if !known[name] {
+			return fmt.Errorf("%s: unknown field %s of %v", pathName(path), name, val.Type())
+		}
+	}
+	return nil
+}
+
+func parseUint(parsed interface{}, bits uint) (uint64, error) {
+	var n uint64
+	var err error
+	switch v := parsed.(type) {
+	case json.Number:
+		n, err = strconv.ParseUint(string(v), 10, 64)
+	case string:
+		n, err = strconv.ParseUint(v, 10, 64)
+	case int:
+		if v < 0 {
+			return 0, fmt.Errorf("expected an unsigned integer, received %d", v)
+		}
+		n = uint64(v)
+	case uint64:
+		n = v
+	default:
+		return 0, fmt.Errorf("expected an unsigned integer, received %v", parsed)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("expected an unsigned integer, received %v", parsed)
+	}
+	if bits < 64 && n >= 1<<bits {
+		return 0, fmt.Errorf("%d overflows uint%d", n, bits)
+	}
+	return n, nil
+}
+
+func parseInt32(parsed interface{}) (int64, error) {
+	var n int64
+	var err error
+	switch v := parsed.(type) {
+	case json.Number:
+		n, err = strconv.ParseInt(string(v), 10, 32)
+	case int:
+		n = int64(v)
+	default:
+		return 0, fmt.Errorf("expected an integer, received %v", parsed)
+	}
+	if err != nil || n < math.MinInt32 || n > math.MaxInt32 {
+		return 0, fmt.Errorf("expected a 32-bit integer, received %v", parsed)
+	}
+	return n, nil
+}
+
+// SubtreeRoot is the root of a composite value nested in another value.
+type SubtreeRoot struct {
+	// Path locates the value, in the syntax of GeneralizedIndex.
+	Path string
+	Root [32]byte
+}
+
+// TreeHashRoots returns the tree-hash, computed by TreeHash, of val and of
+// each struct, list and vector nested in it, in depth-first order. Byte
+// slices and arrays are not listed, as their roots are the leaves of the
+// values holding them.
+func TreeHashRoots(val interface{}) ([]SubtreeRoot, error) {
+	if val == nil {
+		return nil, newHashError("untyped nil is not supported", nil)
+	}
+	var roots []SubtreeRoot
+	err := walkSubtrees(reflect.ValueOf(val), "", func(path string, v reflect.Value) error {
+		root, err := TreeHash(v.Interface())
+		if err != nil {
+			return err
+		}
+		roots = append(roots, SubtreeRoot{Path: path, Root: root})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return roots, nil
+}
+
+// MerkleRoots returns the hash tree root, computed by MerkleRoot, of val and
+// the nodes of each struct, list and vector nested in it, in the same order
+// as TreeHashRoots. The nodes are roots of the tags of the fields holding the
+// values, which may differ from the roots of the values on their own.
+func MerkleRoots(val interface{}) ([]SubtreeRoot, error) {
+	t, rval, err := proofType(val)
+	if err != nil {
+		return nil, err
+	}
+	p := newProver(t, rval)
+	var roots []SubtreeRoot
+	err = walkSubtrees(rval, "", func(path string, v reflect.Value) error {
+		elems, err := parsePath(path)
+		if err != nil {
+			return err
+		}
+		index, err := t.generalizedIndex(rval, elems)
+		if err != nil {
+			return err
+		}
+		root, err := p.node(index)
+		if err != nil {
+			return err
+		}
+		roots = append(roots, SubtreeRoot{Path: path, Root: root})
+		return nil
+	})
+	if err != nil {
+		return nil, newHashError(fmt.Sprint(err), rval.Type())
+	}
+	return roots, nil
+}
+
+// walkSubtrees calls fn with val and each non-nil struct, list and vector
+// nested in it which is not a sequence of bytes.
+func walkSubtrees(val reflect.Value, path string, fn func(path string, val reflect.Value) error) error {
+	switch val.Kind() {
+	case reflect.Ptr:
+		if val.IsNil() {
+			return nil
+		}
+		if val.Elem().Kind() != reflect.Struct {
+			return walkSubtrees(val.Elem(), path, fn)
+		}
+		// Generated methods are defined on pointers, so pass structs as
+		// pointers.
+		if err := fn(path, val); err != nil {
+			return err
+		}
+		return walkFields(val.Elem(), path, fn)
+	case reflect.Struct:
+		if err := fn(path, val); err != nil {
+			return err
+		}
+		return walkFields(val, path, fn)
+	case reflect.Slice, reflect.Array:
+		if val.Type().Elem().Kind() == reflect.Uint8 {
+			return nil
+		}
+		if err := fn(path, val); err != nil {
+			return err
+		}
+		for i := 0; i < val.Len(); i++ {
+			if err := walkSubtrees(val.Index(i), fmt.Sprintf("%s[%d]", path, i), fn); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func walkFields(val reflect.Value, path string, fn func(path string, val reflect.Value) error) error {
+	for _, f := range renderedFields(val.Type()) {
+		if err := walkSubtrees(val.Field(f.index), joinPath(path, f.name), fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,232 @@
+package ssz
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+var renderBlock = &pb.BeaconBlock{
+	Slot:             18446744073709551615,
+	ParentRootHash32: []byte{0x01, 0x02},
+	RandaoReveal:     []byte{},
+	Eth1Data: &pb.Eth1Data{
+		DepositRootHash32: []byte{0xab},
+		BlockHash32:       []byte{0xcd, 0xef},
+	},
+	Body: &pb.BeaconBlockBody{
+		Attestations: []*pb.Attestation{
+			{AggregationBitfield: []byte{0x03}, Data: &pb.AttestationData{Slot: 7, Shard: 2}},
+		},
+		VoluntaryExits: []*pb.VoluntaryExit{{Epoch: 3, ValidatorIndex: 9}},
+	},
+}
+
+func TestToJSON(t *testing.T) {
+	enc, err := ToJSON(&pb.Eth1Data{DepositRootHash32: []byte{0xab}, BlockHash32: []byte{0xcd, 0xef}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{
+  "deposit_root_hash32": "0xab",
+  "block_hash32": "0xcdef"
+}`
+	if string(enc) != want {
+		t.Errorf("Rendered JSON is\n%s\nexpected\n%s", enc, want)
+	}
+
+	enc, err = ToJSON(specBitsValue)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{`"bits": "0x0d"`, `"flags": "0x0102"`, `"a": 1`, `"b": []`, `"c": 2`, `"roots": [`} {
+		if !strings.Contains(string(enc), s) {
+			t.Errorf("Rendered JSON does not contain %s:\n%s", s, enc)
+		}
+	}
+}
+
+func TestToYAML(t *testing.T) {
+	enc, err := ToYAML(&pb.VoluntaryExit{Epoch: 3, ValidatorIndex: 9, Signature: []byte{0x12}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "epoch: 3\nvalidator_index: 9\nsignature: \"0x12\"\n"
+	if string(enc) != want {
+		t.Errorf("Rendered YAML is\n%s\nexpected\n%s", enc, want)
+	}
+}
+
+func TestRender_RoundTrip(t *testing.T) {
+	formats := []struct {
+		name   string
+		render func(interface{}) ([]byte, error)
+		parse  func([]byte, interface{}) error
+	}{
+		{name: "JSON", render: ToJSON, parse: FromJSON},
+		{name: "YAML", render: ToYAML, parse: FromYAML},
+	}
+	for _, format := range formats {
+		for _, val := range []interface{}{renderBlock, specBitsValue} {
+			enc, err := format.render(val)
+			if err != nil {
+				t.Fatalf("Could not render %s: %v", format.name, err)
+			}
+			parsed := reflect.New(reflect.TypeOf(val).Elem())
+			if err := format.parse(enc, parsed.Interface()); err != nil {
+				t.Fatalf("Could not parse %s: %v\n%s", format.name, err, enc)
+			}
+			// Empty slices are parsed as such, while nil slices are rendered as
+			// empty lists, so compare the renderings.
+			again, err := format.render(parsed.Interface())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(enc, again) {
+				t.Errorf("%s rendering changed after parsing:\n%s\n%s", format.name, enc, again)
+			}
+		}
+	}
+
+	enc, err := ToJSON(renderBlock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block := &pb.BeaconBlock{}
+	if err := FromJSON(enc, block); err != nil {
+		t.Fatal(err)
+	}
+	want, err := TreeHash(renderBlock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := TreeHash(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Error("Parsed block has a different root than the original block")
+	}
+}
+
+func TestFromJSON_Errors(t *testing.T) {
+	tests := []struct {
+		input string
+		error string
+	}{
+		{input: `{"slot": -1}`, error: "slot: expected an unsigned integer"},
+		{input: `{"slot": 18446744073709551616}`, error: "slot: expected an unsigned integer"},
+		{input: `{"signature": "12"}`, error: "signature: expected a 0x-prefixed hex string"},
+		{input: `{"signature": "0xzz"}`, error: "signature: invalid hex string"},
+		{input: `{"eth1_data": {"block_hash": "0x"}}`, error: "eth1_data: unknown field block_hash"},
+		{input: `{"body": {"attestations": [{"data": {"shard": true}}]}}`, error: "body.attestations[0].data.shard: expected an unsigned integer"},
+		{input: `{"body": {"deposits": {}}}`, error: "body.deposits: expected a list"},
+		{input: `[]`, error: "value: expected an object"},
+	}
+	for _, test := range tests {
+		err := FromJSON([]byte(test.input), &pb.BeaconBlock{})
+		if err == nil || !strings.Contains(err.Error(), test.error) {
+			t.Errorf("Expected error containing %q for %s, received %v", test.error, test.input, err)
+		}
+	}
+
+	var small struct{ A uint8 }
+	if err := FromYAML([]byte("a: 256"), &small); err == nil || !strings.Contains(err.Error(), "256 overflows uint8") {
+		t.Errorf("Expected an overflow error, received %v", err)
+	}
+	var array struct{ A [2]byte }
+	if err := FromYAML([]byte("a: \"0x010203\""), &array); err == nil || !strings.Contains(err.Error(), "expected 2 bytes, received 3") {
+		t.Errorf("Expected a length error, received %v", err)
+	}
+}
+
+func TestRegisterType(t *testing.T) {
+	RegisterType("TestBlock", &pb.BeaconBlock{})
+	val, err := NewRegisteredValue("TestBlock")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := val.(*pb.BeaconBlock); !ok {
+		t.Errorf("Registered value is a %T, expected a *pb.BeaconBlock", val)
+	}
+	found := false
+	for _, name := range RegisteredTypes() {
+		found = found || name == "TestBlock"
+	}
+	if !found {
+		t.Error("TestBlock is not in the registered types")
+	}
+	if _, err := NewRegisteredValue("Missing"); err == nil {
+		t.Error("Expected an error for a type which is not registered")
+	}
+}
+
+func TestTreeHashRoots(t *testing.T) {
+	roots, err := TreeHashRoots(renderBlock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var paths []string
+	for _, root := range roots {
+		paths = append(paths, root.Path)
+	}
+	want := []string{"", "eth1_data", "body", "body.attestations", "body.attestations[0]",
+		"body.attestations[0].data", "body.proposer_slashings", "body.attester_slashings",
+		"body.deposits", "body.voluntary_exits", "body.voluntary_exits[0]"}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("Subtree paths are %v, expected %v", paths, want)
+	}
+	for _, root := range []struct {
+		index int
+		val   interface{}
+	}{
+		{index: 0, val: renderBlock},
+		{index: 2, val: renderBlock.Body},
+		{index: 10, val: renderBlock.Body.VoluntaryExits[0]},
+	} {
+		want, err := TreeHash(root.val)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if roots[root.index].Root != want {
+			t.Errorf("Root of %q is %#x, expected %#x", roots[root.index].Path, roots[root.index].Root, want)
+		}
+	}
+}
+
+func TestMerkleRoots(t *testing.T) {
+	roots, err := MerkleRoots(proofValue)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if roots[0].Path != "" {
+		t.Fatalf("First subtree is %q, expected the root", roots[0].Path)
+	}
+	want, err := MerkleRoot(proofValue)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if roots[0].Root != want {
+		t.Errorf("Root is %#x, expected %#x", roots[0].Root, want)
+	}
+	// The roots of the subtrees are the nodes of the tree of the value.
+	for _, root := range roots {
+		index, err := GeneralizedIndex(proofValue, root.Path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		node, err := MerkleNode(proofValue, index)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if root.Root != node {
+			t.Errorf("Root of %q is %#x, expected %#x", root.Path, root.Root, node)
+		}
+	}
+	if len(roots) != 7 {
+		t.Errorf("Listed %d subtrees, expected 7", len(roots))
+	}
+}
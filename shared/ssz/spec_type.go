@@ -0,0 +1,219 @@
+package ssz
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// specKind is the SSZ type a Go type is mapped to by Marshal, Unmarshal and MerkleRoot.
+type specKind int
+
+const (
+	specBasic specKind = iota
+	specVector
+	specList
+	specContainer
+	specBitvector
+	specBitlist
+)
+
+// specType describes how a Go type, together with the ssz-size and ssz-max
+// tags of the field holding it, is serialized and merkleized by the current SSZ spec:
+//
+//	bool, uint8, uint16, uint32, uint64    basic types (int32 proto enums are uint32)
+//	[N]T, or []T with ssz-size:"N"         Vector[T, N]
+//	[]T, with an optional ssz-max:"N"      List[T, N]
+//	struct                                 Container, of its exported fields
+//	*T                                     T, a nil pointer is the zero value of T
+//	Bitvector with ssz-size:"N"            Bitvector[N]
+//	Bitlist, with an optional ssz-max:"N"  Bitlist[N]
+//
+// A list without a limit is merkleized as if its limit was its length. Tags of
+// nested slices, such as [][]byte, list the size or limit of each dimension
+// separated by commas, with "?" for none, for example ssz-size:"?,32".
+type specType struct {
+	kind specKind
+	typ  reflect.Type
+	ptr  bool
+	// fixed is true for fixed-size types, whose serialized size is size.
+	fixed bool
+	size  int
+	// length is the number of elements of a vector, or of bits of a bitvector.
+	length uint64
+	// limit is the maximum number of elements of a list, or of bits of a bitlist,
+	// where 0 means unlimited.
+	limit  uint64
+	elem   *specType
+	fields []specField
+}
+
+type specField struct {
+	index int
+	name  string
+	typ   *specType
+}
+
+// isBasicSequence reports whether t is a vector or a list of basic elements,
+// which are packed into chunks rather than merkleized element by element.
+func (t *specType) isBasicSequence() bool {
+	return (t.kind == specVector || t.kind == specList) && t.elem.kind == specBasic
+}
+
+type specTypeKey struct {
+	typ           reflect.Type
+	sizes, limits string
+}
+
+var (
+	specTypeCacheLock sync.Mutex
+	specTypeCache     = make(map[specTypeKey]*specType)
+)
+
+// cachedSpecType returns the description of typ for a value without tags.
+func cachedSpecType(typ reflect.Type) (*specType, error) {
+	specTypeCacheLock.Lock()
+	defer specTypeCacheLock.Unlock()
+	return specTypeOf(typ, nil, nil)
+}
+
+// specTypeOf describes typ given the remaining dimensions of the ssz-size and
+// ssz-max tags of its field. The caller must hold specTypeCacheLock.
+func specTypeOf(typ reflect.Type, sizes []string, limits []string) (*specType, error) {
+	key := specTypeKey{typ, strings.Join(sizes, ","), strings.Join(limits, ",")}
+	if t, ok := specTypeCache[key]; ok {
+		return t, nil
+	}
+	t, err := makeSpecType(typ, sizes, limits)
+	if err != nil {
+		return nil, err
+	}
+	specTypeCache[key] = t
+	return t, nil
+}
+
+func makeSpecType(typ reflect.Type, sizes []string, limits []string) (*specType, error) {
+	size, sizes, err := popDimension(sizes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ssz-size tag: %v", err)
+	}
+	limit, limits, err := popDimension(limits)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ssz-max tag: %v", err)
+	}
+
+	switch kind := typ.Kind(); {
+	case typ == bitvectorType:
+		if size == 0 {
+			return nil, fmt.Errorf("type %v requires an ssz-size tag", typ)
+		}
+		return &specType{kind: specBitvector, typ: typ, fixed: true, size: int((size + 7) / 8), length: size}, nil
+	case typ == bitlistType:
+		return &specType{kind: specBitlist, typ: typ, limit: limit}, nil
+	case kind == reflect.Bool || kind == reflect.Uint8:
+		return &specType{kind: specBasic, typ: typ, fixed: true, size: 1}, nil
+	case kind == reflect.Uint16:
+		return &specType{kind: specBasic, typ: typ, fixed: true, size: 2}, nil
+	case kind == reflect.Uint32 || kind == reflect.Int32:
+		return &specType{kind: specBasic, typ: typ, fixed: true, size: 4}, nil
+	case kind == reflect.Uint64:
+		return &specType{kind: specBasic, typ: typ, fixed: true, size: 8}, nil
+	case kind == reflect.Array:
+		if size != 0 && size != uint64(typ.Len()) {
+			return nil, fmt.Errorf("ssz-size %d does not match length of %v", size, typ)
+		}
+		return makeSequenceType(specVector, typ, uint64(typ.Len()), 0, sizes, limits)
+	case kind == reflect.Slice && size != 0:
+		return makeSequenceType(specVector, typ, size, 0, sizes, limits)
+	case kind == reflect.Slice:
+		return makeSequenceType(specList, typ, 0, limit, sizes, limits)
+	case kind == reflect.Struct:
+		return makeContainerType(typ)
+	case kind == reflect.Ptr:
+		// The tags of a pointer field apply to the type it points to.
+		elem, err := makeSpecType(typ.Elem(), joinDimension(size, sizes), joinDimension(limit, limits))
+		if err != nil {
+			return nil, err
+		}
+		t := *elem
+		t.typ = typ
+		t.ptr = true
+		return &t, nil
+	default:
+		return nil, fmt.Errorf("type %v is not supported", typ)
+	}
+}
+
+func makeSequenceType(kind specKind, typ reflect.Type, length uint64, limit uint64, sizes []string, limits []string) (*specType, error) {
+	elem, err := specTypeOf(typ.Elem(), sizes, limits)
+	if err != nil {
+		return nil, err
+	}
+	t := &specType{kind: kind, typ: typ, length: length, limit: limit, elem: elem}
+	if kind == specVector && elem.fixed {
+		t.fixed = true
+		t.size = int(length) * elem.size
+	}
+	return t, nil
+}
+
+func makeContainerType(typ reflect.Type) (*specType, error) {
+	t := &specType{kind: specContainer, typ: typ, fixed: true}
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		// Unexported fields and the XXX fields of protos are not serialized.
+		if f.PkgPath != "" || strings.Contains(f.Name, "XXX") {
+			continue
+		}
+		fieldType, err := specTypeOf(f.Type, splitTag(f.Tag.Get("ssz-size")), splitTag(f.Tag.Get("ssz-max")))
+		if err != nil {
+			return nil, fmt.Errorf("field %s of %v: %v", f.Name, typ, err)
+		}
+		t.fields = append(t.fields, specField{index: i, name: f.Name, typ: fieldType})
+		t.fixed = t.fixed && fieldType.fixed
+		t.size += fieldType.size
+	}
+	if !t.fixed {
+		t.size = 0
+	}
+	return t, nil
+}
+
+func splitTag(tag string) []string {
+	if tag == "" {
+		return nil
+	}
+	return strings.Split(tag, ",")
+}
+
+// popDimension parses the first dimension of a tag, where 0 stands for "?".
+func popDimension(dims []string) (uint64, []string, error) {
+	if len(dims) == 0 {
+		return 0, nil, nil
+	}
+	if dims[0] == "?" {
+		return 0, dims[1:], nil
+	}
+	n, err := strconv.ParseUint(dims[0], 10, 64)
+	if err != nil {
+		return 0, nil, err
+	}
+	if n == 0 {
+		return 0, nil, errors.New("dimension must be positive, use ? for none")
+	}
+	return n, dims[1:], nil
+}
+
+func joinDimension(n uint64, dims []string) []string {
+	if n == 0 && len(dims) == 0 {
+		return nil
+	}
+	first := "?"
+	if n != 0 {
+		first = strconv.FormatUint(n, 10)
+	}
+	return append([]string{first}, dims...)
+}
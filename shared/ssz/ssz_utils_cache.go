@@ -2,7 +2,6 @@ package ssz
 
 import (
 	"fmt"
-	"io"
 	"reflect"
 	"strings"
 	"sync"
@@ -11,9 +10,9 @@ import (
 type encoder func(reflect.Value, *encbuf) error
 
 // Notice: we are not exactly following the spec which requires a decoder to return new index in the input buffer.
-// Our io.Reader is already capable of tracking its latest read location, so we decide to return the decoded byte size
+// Our Decoder is already capable of tracking its latest read location, so we decide to return the decoded byte size
 // instead. This makes our implementation look cleaner.
-type decoder func(io.Reader, reflect.Value) (uint32, error)
+type decoder func(*Decoder, reflect.Value) (uint32, error)
 
 type encodeSizer func(reflect.Value) (uint32, error)
 
@@ -75,6 +74,9 @@ func cachedSSZUtilsNoAcquireLock(typ reflect.Type) (*sszUtils, error) {
 }
 
 func generateSSZUtilsForType(typ reflect.Type) (utils *sszUtils, err error) {
+	if hasGeneratedCode(typ) {
+		return makeGeneratedSSZUtils(typ)
+	}
 	utils = new(sszUtils)
 	if utils.encoder, utils.encodeSizer, err = makeEncoder(typ); err != nil {
 		return nil, err
@@ -0,0 +1,143 @@
+package sszutil
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Decoder reads SSZ encodings from a buffer. It remembers the first error
+// that occurs, after which every read returns a zero value.
+type Decoder struct {
+	buf []byte
+	pos int
+	err error
+}
+
+// NewDecoder creates a decoder reading from b.
+func NewDecoder(b []byte) *Decoder {
+	return &Decoder{buf: b}
+}
+
+// Bool reads a bool, which must be encoded as 0 or 1.
+func (d *Decoder) Bool() bool {
+	b := d.read(1)
+	if b == nil {
+		return false
+	}
+	switch b[0] {
+	case 0:
+		return false
+	case 1:
+		return true
+	default:
+		d.setErr(fmt.Errorf("expect 0 or 1 for decoding bool but got %d", b[0]))
+		return false
+	}
+}
+
+// Uint32 reads a little-endian uint32.
+func (d *Decoder) Uint32() uint32 {
+	b := d.read(4)
+	if b == nil {
+		return 0
+	}
+	return binary.LittleEndian.Uint32(b)
+}
+
+// Uint64 reads a little-endian uint64.
+func (d *Decoder) Uint64() uint64 {
+	b := d.read(8)
+	if b == nil {
+		return 0
+	}
+	return binary.LittleEndian.Uint64(b)
+}
+
+// Bytes reads a length-prefixed byte slice. The returned slice is a copy
+// and is empty, not nil, if the encoded slice is empty.
+func (d *Decoder) Bytes() []byte {
+	size := d.Uint32()
+	b := d.read(int(size))
+	if b == nil {
+		return nil
+	}
+	out := make([]byte, size)
+	copy(out, b)
+	return out
+}
+
+// IsNil reports whether the next value is an empty container, the encoding
+// of a nil pointer, and consumes its length prefix if so. It also returns true
+// once an error has occurred so that decoding stops.
+func (d *Decoder) IsNil() bool {
+	if d.err != nil {
+		return true
+	}
+	if len(d.buf)-d.pos < lengthBytes {
+		d.setErr(fmt.Errorf("can only read %d bytes while expected to read %d bytes", len(d.buf)-d.pos, lengthBytes))
+		return true
+	}
+	if binary.LittleEndian.Uint32(d.buf[d.pos:]) != 0 {
+		return false
+	}
+	d.pos += lengthBytes
+	return true
+}
+
+// Begin reads the length prefix of a list or a container and returns the
+// offset at which it ends, which must be passed to More and End.
+func (d *Decoder) Begin() int {
+	size := d.Uint32()
+	if d.err != nil {
+		return d.pos
+	}
+	if uint64(size) > uint64(len(d.buf)-d.pos) {
+		d.setErr(fmt.Errorf("size %d exceeds the %d remaining input bytes", size, len(d.buf)-d.pos))
+		return d.pos
+	}
+	return d.pos + int(size)
+}
+
+// More reports whether there are elements left to read before end.
+func (d *Decoder) More(end int) bool {
+	return d.err == nil && d.pos < end
+}
+
+// End checks that the elements read since the matching Begin exactly
+// fill the length given by its prefix.
+func (d *Decoder) End(end int) {
+	if d.err == nil && d.pos != end {
+		d.setErr(fmt.Errorf("elements end at offset %d instead of the declared offset %d", d.pos, end))
+	}
+}
+
+// Finish returns the first error that occurred, or an error if the input
+// was not entirely consumed.
+func (d *Decoder) Finish() error {
+	if d.err != nil {
+		return d.err
+	}
+	if d.pos != len(d.buf) {
+		return fmt.Errorf("input is too long: %d bytes left after decoding", len(d.buf)-d.pos)
+	}
+	return nil
+}
+
+func (d *Decoder) read(size int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if size < 0 || len(d.buf)-d.pos < size {
+		d.setErr(fmt.Errorf("can only read %d bytes while expected to read %d bytes", len(d.buf)-d.pos, size))
+		return nil
+	}
+	b := d.buf[d.pos : d.pos+size]
+	d.pos += size
+	return b
+}
+
+func (d *Decoder) setErr(err error) {
+	if d.err == nil {
+		d.err = err
+	}
+}
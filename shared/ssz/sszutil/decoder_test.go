@@ -0,0 +1,98 @@
+package sszutil
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDecoder_RoundTrip(t *testing.T) {
+	e := &Encoder{}
+	offset := e.Begin()
+	e.Bool(true)
+	e.Uint32(7)
+	e.Uint64(1 << 40)
+	e.Bytes([]byte{1, 2, 3})
+	e.Nil()
+	e.End(offset)
+	enc, err := e.Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := NewDecoder(enc)
+	end := d.Begin()
+	if !d.More(end) {
+		t.Fatal("Expected container to have fields")
+	}
+	if !d.Bool() {
+		t.Error("Expected true")
+	}
+	if v := d.Uint32(); v != 7 {
+		t.Errorf("Expected 7, got %d", v)
+	}
+	if v := d.Uint64(); v != 1<<40 {
+		t.Errorf("Expected %d, got %d", uint64(1<<40), v)
+	}
+	if b := d.Bytes(); !bytes.Equal(b, []byte{1, 2, 3}) {
+		t.Errorf("Expected bytes 010203, got %#x", b)
+	}
+	if !d.IsNil() {
+		t.Error("Expected nil pointer")
+	}
+	d.End(end)
+	if err := d.Finish(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDecoder_InvalidInput(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  []byte
+		decode func(d *Decoder)
+	}{
+		{
+			name:   "truncated uint64",
+			input:  []byte{1, 2, 3},
+			decode: func(d *Decoder) { d.Uint64() },
+		},
+		{
+			name:   "bool out of range",
+			input:  []byte{2},
+			decode: func(d *Decoder) { d.Bool() },
+		},
+		{
+			name:   "bytes length exceeds input",
+			input:  []byte{0xFF, 0xFF, 0xFF, 0xFF, 1},
+			decode: func(d *Decoder) { d.Bytes() },
+		},
+		{
+			name:  "container length exceeds input",
+			input: []byte{9, 0, 0, 0, 1},
+			decode: func(d *Decoder) {
+				d.Begin()
+			},
+		},
+		{
+			name:  "fields overrun container",
+			input: []byte{1, 0, 0, 0, 1, 2, 3, 4},
+			decode: func(d *Decoder) {
+				end := d.Begin()
+				d.Uint32()
+				d.End(end)
+			},
+		},
+		{
+			name:   "trailing input",
+			input:  []byte{1, 0, 0, 0, 5},
+			decode: func(d *Decoder) { d.Uint32() },
+		},
+	}
+	for _, tt := range tests {
+		d := NewDecoder(tt.input)
+		tt.decode(d)
+		if err := d.Finish(); err == nil {
+			t.Errorf("%s: expected an error", tt.name)
+		}
+	}
+}
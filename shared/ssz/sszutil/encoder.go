@@ -0,0 +1,91 @@
+// Package sszutil implements the SSZ encoding, decoding and tree-hashing
+// primitives used by the code generated with tools/sszgen. The generated
+// methods live in the beacon chain proto packages, so this package must not
+// depend on them, directly or through packages such as shared/hashutil.
+package sszutil
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+const lengthBytes = 4
+
+// Encoder appends SSZ encodings to a buffer. It remembers the first error
+// that occurs, which is returned by Result.
+type Encoder struct {
+	buf []byte
+	err error
+}
+
+// Bool appends the encoding of a bool.
+func (e *Encoder) Bool(v bool) {
+	if v {
+		e.buf = append(e.buf, 1)
+	} else {
+		e.buf = append(e.buf, 0)
+	}
+}
+
+// Uint32 appends the little-endian encoding of a uint32.
+func (e *Encoder) Uint32(v uint32) {
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, v)
+	e.buf = append(e.buf, b...)
+}
+
+// Uint64 appends the little-endian encoding of a uint64.
+func (e *Encoder) Uint64(v uint64) {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, v)
+	e.buf = append(e.buf, b...)
+}
+
+// Bytes appends the length-prefixed encoding of a byte slice.
+func (e *Encoder) Bytes(b []byte) {
+	if uint64(len(b)) > math.MaxUint32 {
+		e.setErr(errors.New("bytes oversize"))
+		return
+	}
+	e.Uint32(uint32(len(b)))
+	e.buf = append(e.buf, b...)
+}
+
+// Nil appends the encoding of a nil pointer, which is an empty length prefix.
+func (e *Encoder) Nil() {
+	e.Uint32(0)
+}
+
+// Begin reserves the length prefix of a list or a container and returns
+// its offset, which must be passed to End once the elements are appended.
+func (e *Encoder) Begin() int {
+	offset := len(e.buf)
+	e.Uint32(0)
+	return offset
+}
+
+// End fills in the length prefix reserved at offset with the size of
+// everything appended since.
+func (e *Encoder) End(offset int) {
+	size := len(e.buf) - offset - lengthBytes
+	if uint64(size) > math.MaxUint32 {
+		e.setErr(errors.New("list or container oversize"))
+		return
+	}
+	binary.LittleEndian.PutUint32(e.buf[offset:offset+lengthBytes], uint32(size))
+}
+
+// Result returns the encoded bytes, or the first error that occurred.
+func (e *Encoder) Result() ([]byte, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+func (e *Encoder) setErr(err error) {
+	if e.err == nil {
+		e.err = err
+	}
+}
@@ -0,0 +1,167 @@
+package sszutil
+
+import (
+	"encoding/binary"
+
+	"golang.org/x/crypto/sha3"
+)
+
+const hashLengthBytes = 32
+const sszChunkSize = 128
+
+// NilRoot is the tree-hash of a nil pointer, the hash of its encoding.
+var NilRoot = Hash(make([]byte, lengthBytes))
+
+// Hash returns the Keccak-256 hash of data, the same as hashutil.Hash.
+func Hash(data []byte) [32]byte {
+	var hash [32]byte
+	h := sha3.NewLegacyKeccak256()
+	// #nosec G104
+	h.Write(data)
+	h.Sum(hash[:0])
+	return hash
+}
+
+// Hasher collects the tree-hashes of the fields of a container or of the
+// elements of a list. It remembers the first error that occurs.
+type Hasher struct {
+	leaves [][]byte
+	err    error
+}
+
+// Bool adds a bool, which hashes to its encoding.
+func (h *Hasher) Bool(v bool) {
+	e := &Encoder{}
+	e.Bool(v)
+	h.leaves = append(h.leaves, e.buf)
+}
+
+// Uint32 adds a uint32, which hashes to its encoding.
+func (h *Hasher) Uint32(v uint32) {
+	e := &Encoder{}
+	e.Uint32(v)
+	h.leaves = append(h.leaves, e.buf)
+}
+
+// Uint64 adds a uint64, which hashes to its encoding.
+func (h *Hasher) Uint64(v uint64) {
+	e := &Encoder{}
+	e.Uint64(v)
+	h.leaves = append(h.leaves, e.buf)
+}
+
+// Bytes adds a byte slice, which hashes to the hash of its encoding.
+func (h *Hasher) Bytes(b []byte) {
+	e := &Encoder{}
+	e.Bytes(b)
+	enc, err := e.Result()
+	if err != nil {
+		h.setErr(err)
+		return
+	}
+	root := Hash(enc)
+	h.leaves = append(h.leaves, root[:])
+}
+
+// Root adds the tree-hash of a container, as returned by its HashTreeRoot method.
+func (h *Hasher) Root(root [32]byte, err error) {
+	if err != nil {
+		h.setErr(err)
+		return
+	}
+	h.leaves = append(h.leaves, root[:])
+}
+
+// List adds the merkle root of the list whose elements were added to l.
+func (h *Hasher) List(l *Hasher) {
+	if l.err != nil {
+		h.setErr(l.err)
+		return
+	}
+	root, err := MerkleHash(l.leaves)
+	if err != nil {
+		h.setErr(err)
+		return
+	}
+	h.leaves = append(h.leaves, root)
+}
+
+// Sum returns the tree-hash of the container whose fields were added to h,
+// the hash of the concatenated field hashes.
+func (h *Hasher) Sum() ([32]byte, error) {
+	if h.err != nil {
+		return [32]byte{}, h.err
+	}
+	var concat []byte
+	for _, leaf := range h.leaves {
+		concat = append(concat, leaf...)
+	}
+	return Hash(concat), nil
+}
+
+func (h *Hasher) setErr(err error) {
+	if h.err == nil {
+		h.err = err
+	}
+}
+
+// MerkleHash implements a merkle-tree style hash algorithm.
+//
+// Please refer to the official spec for details:
+// https://github.com/ethereum/eth2.0-specs/blob/master/specs/simple-serialize.md#tree-hash
+//
+// The overall idea is:
+// 1. Create a bunch of bytes chunk (each has a size of sszChunkSize) from the input hash list.
+// 2. Treat each bytes chunk as the leaf of a binary tree.
+// 3. For every pair of leaves, we set their parent's value using the hash value of the concatenation of the two leaves.
+//    The original two leaves are then removed.
+// 4. Keep doing step 3 until there's only one node left in the tree (the root).
+// 5. Return the hash of the concatenation of the root and the data length encoding.
+//
+// Time complexity is O(n) given input list of size n.
+func MerkleHash(list [][]byte) ([]byte, error) {
+	// Assume len(list) < 2^64
+	dataLenEnc := make([]byte, hashLengthBytes)
+	binary.LittleEndian.PutUint64(dataLenEnc, uint64(len(list)))
+
+	var chunkz [][]byte
+	emptyChunk := make([]byte, sszChunkSize)
+
+	if len(list) == 0 {
+		chunkz = make([][]byte, 1)
+		chunkz[0] = emptyChunk
+	} else if len(list[0]) < sszChunkSize {
+
+		itemsPerChunk := sszChunkSize / len(list[0])
+		chunkz = make([][]byte, 0)
+		for i := 0; i < len(list); i += itemsPerChunk {
+			chunk := make([]byte, 0)
+			j := i + itemsPerChunk
+			if j > len(list) {
+				j = len(list)
+			}
+			// Every chunk should have sszChunkSize bytes except that the last one could have less bytes
+			for _, elemHash := range list[i:j] {
+				chunk = append(chunk, elemHash...)
+			}
+			chunkz = append(chunkz, chunk)
+		}
+	} else {
+		chunkz = list
+	}
+
+	for len(chunkz) > 1 {
+		if len(chunkz)%2 == 1 {
+			chunkz = append(chunkz, emptyChunk)
+		}
+		hashedChunkz := make([][]byte, 0)
+		for i := 0; i < len(chunkz); i += 2 {
+			hashedChunk := Hash(append(chunkz[i], chunkz[i+1]...))
+			hashedChunkz = append(hashedChunkz, hashedChunk[:])
+		}
+		chunkz = hashedChunkz
+	}
+
+	result := Hash(append(chunkz[0], dataLenEnc...))
+	return result[:], nil
+}
@@ -0,0 +1,462 @@
+package ssz
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+// TreeHashStep is one level of a proof against a TreeHash root. The node
+// proven by the levels below is hashed between Prefix and Suffix, which gives
+// the node of this level.
+type TreeHashStep struct {
+	Prefix []byte
+	Suffix []byte
+}
+
+// TreeHashProof returns the tree-hash of the value at path in val, and the
+// steps which prove it against the TreeHash root of val, ordered from the leaf
+// up to the root. These are the roots which beacon blocks commit to, such as
+// the state root of a block.
+//
+// Paths have the syntax of GeneralizedIndex. The tree-hash of a basic value
+// is its encoding, so the leaf of a path such as
+// "validator_registry[42].exit_epoch" is the little-endian exit epoch. The
+// leaf of the __len__ of a list is its length, as mixed into its root.
+func TreeHashProof(val interface{}, path string) ([]byte, []TreeHashStep, error) {
+	if val == nil {
+		return nil, nil, newHashError("untyped nil is not supported", nil)
+	}
+	rval := reflect.ValueOf(val)
+	elems, err := parsePath(path)
+	if err != nil {
+		return nil, nil, newHashError(fmt.Sprint(err), rval.Type())
+	}
+	leaf, proof, err := treeHashProof(rval, elems)
+	if err != nil {
+		return nil, nil, newHashError(fmt.Sprintf("path %q: %v", path, err), rval.Type())
+	}
+	return leaf, proof, nil
+}
+
+// VerifyTreeHashProof reports whether proof, as returned by TreeHashProof,
+// proves that leaf is the tree-hash of the value at path in a value of the
+// type of val with the given TreeHash root. Only the type of val is used, so
+// it may be a nil pointer such as (*pb.BeaconState)(nil).
+//
+// The tree of a list is as deep as its length requires, so the verifier reads
+// the length of each list on the path from the proof, where it is covered by
+// the root, and checks that every step holds the node at the position which
+// the path gives in a list of that length.
+func VerifyTreeHashProof(val interface{}, path string, root [32]byte, leaf []byte, proof []TreeHashStep) bool {
+	if val == nil {
+		return false
+	}
+	elems, err := parsePath(path)
+	if err != nil {
+		return false
+	}
+	if err := checkTreeHashProof(reflect.TypeOf(val), elems, leaf, proof); err != nil {
+		return false
+	}
+	node := leaf
+	for _, step := range proof {
+		h := hashutil.Hash(append(append(append([]byte{}, step.Prefix...), node...), step.Suffix...))
+		node = h[:]
+	}
+	return bytes.Equal(node, root[:])
+}
+
+// treeHashFields returns the fields of a struct which are tree-hashed.
+func treeHashFields(typ reflect.Type) ([]field, error) {
+	sszUtilsCacheMutex.Lock()
+	defer sszUtilsCacheMutex.Unlock()
+	return structFields(typ)
+}
+
+// treeHashFieldIndex returns the position of the field named name, matched
+// in the same way as GeneralizedIndex does.
+func treeHashFieldIndex(fields []field, name string) (int, bool) {
+	normalized := strings.Replace(name, "_", "", -1)
+	for i, f := range fields {
+		if strings.EqualFold(strings.Replace(f.name, "_", "", -1), normalized) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// treeHashSize returns the size of the tree-hash of a value of the type.
+func treeHashSize(typ reflect.Type) int {
+	switch typ.Kind() {
+	case reflect.Bool, reflect.Uint8:
+		return 1
+	case reflect.Uint16:
+		return 2
+	case reflect.Uint32, reflect.Int32:
+		return 4
+	case reflect.Uint64:
+		return 8
+	default:
+		return hashLengthBytes
+	}
+}
+
+// isTreeHashList returns true for the slices and arrays whose elements are
+// merkleized, as opposed to byte sequences which are hashed as a whole.
+func isTreeHashList(typ reflect.Type) bool {
+	kind := typ.Kind()
+	return (kind == reflect.Slice || kind == reflect.Array) && typ.Elem().Kind() != reflect.Uint8
+}
+
+func treeHashProof(val reflect.Value, path []pathElem) ([]byte, []TreeHashStep, error) {
+	// The steps of each element of the path, each ordered from the bottom up.
+	var levels [][]TreeHashStep
+	var leaf []byte
+	for i, elem := range path {
+		for val.Kind() == reflect.Ptr {
+			if val.IsNil() {
+				return nil, nil, errors.New("path continues below a nil pointer")
+			}
+			val = val.Elem()
+		}
+		switch {
+		case elem.isLen:
+			if !isTreeHashList(val.Type()) {
+				return nil, nil, fmt.Errorf("%v is not a list", val.Type())
+			}
+			if i != len(path)-1 {
+				return nil, nil, errors.New("path continues below the length of a list")
+			}
+			hashes, err := elementHashes(val)
+			if err != nil {
+				return nil, nil, err
+			}
+			layers := buildMerkleLayers(packChunks(hashes))
+			leaf = lengthChunk(len(hashes))
+			levels = append(levels, []TreeHashStep{{Prefix: layers[len(layers)-1][0]}})
+		case !elem.isItem:
+			if val.Kind() != reflect.Struct {
+				return nil, nil, fmt.Errorf("%v has no field %s", val.Type(), elem.field)
+			}
+			fields, err := treeHashFields(val.Type())
+			if err != nil {
+				return nil, nil, err
+			}
+			index, ok := treeHashFieldIndex(fields, elem.field)
+			if !ok {
+				return nil, nil, fmt.Errorf("%v has no field %s", val.Type(), elem.field)
+			}
+			step := TreeHashStep{}
+			for j, f := range fields {
+				if j == index {
+					continue
+				}
+				fieldHash, err := f.sszUtils.hasher(val.Field(f.index))
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to hash field %s: %v", f.name, err)
+				}
+				if j < index {
+					step.Prefix = append(step.Prefix, fieldHash...)
+				} else {
+					step.Suffix = append(step.Suffix, fieldHash...)
+				}
+			}
+			levels = append(levels, []TreeHashStep{step})
+			val = val.Field(fields[index].index)
+		default:
+			if !isTreeHashList(val.Type()) {
+				if val.Kind() == reflect.Slice || val.Kind() == reflect.Array {
+					return nil, nil, errors.New("path continues below a byte sequence")
+				}
+				return nil, nil, fmt.Errorf("%v is not a list or vector", val.Type())
+			}
+			if elem.index >= uint64(val.Len()) {
+				return nil, nil, fmt.Errorf("index %d is out of range for length %d", elem.index, val.Len())
+			}
+			hashes, err := elementHashes(val)
+			if err != nil {
+				return nil, nil, err
+			}
+			levels = append(levels, listSteps(hashes, int(elem.index)))
+			val = val.Index(int(elem.index))
+		}
+	}
+	if leaf == nil {
+		utils, err := cachedSSZUtils(val.Type())
+		if err != nil {
+			return nil, nil, err
+		}
+		if leaf, err = utils.hasher(val); err != nil {
+			return nil, nil, err
+		}
+	}
+	var proof []TreeHashStep
+	for i := len(levels) - 1; i >= 0; i-- {
+		proof = append(proof, levels[i]...)
+	}
+	return leaf, proof, nil
+}
+
+func elementHashes(val reflect.Value) ([][]byte, error) {
+	elemSSZUtils, err := cachedSSZUtils(val.Type().Elem())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ssz utils: %v", err)
+	}
+	hashes := make([][]byte, val.Len())
+	for i := range hashes {
+		if hashes[i], err = elemSSZUtils.hasher(val.Index(i)); err != nil {
+			return nil, fmt.Errorf("failed to hash element of slice/array: %v", err)
+		}
+	}
+	return hashes, nil
+}
+
+// listSteps returns the steps which prove the hash of the element at index
+// against the root of the list, from the bottom up. The first step hashes the
+// element together with the other elements of its chunk.
+func listSteps(hashes [][]byte, index int) []TreeHashStep {
+	layers := buildMerkleLayers(packChunks(hashes))
+	perChunk := itemsPerChunk(hashes)
+	size := len(hashes[index])
+	chunk := layers[0][index/perChunk]
+	offset := index % perChunk * size
+	step := TreeHashStep{
+		Prefix: append([]byte{}, chunk[:offset]...),
+		Suffix: append([]byte{}, chunk[offset+size:]...),
+	}
+	position := index / perChunk
+	var steps []TreeHashStep
+	for _, layer := range layers[:len(layers)-1] {
+		if position%2 == 0 {
+			step.Suffix = append(step.Suffix, sibling(layer, position+1)...)
+		} else {
+			step.Prefix = append(append([]byte{}, layer[position-1]...), step.Prefix...)
+		}
+		steps = append(steps, step)
+		step = TreeHashStep{}
+		position /= 2
+	}
+	step.Suffix = append(step.Suffix, lengthChunk(len(hashes))...)
+	return append(steps, step)
+}
+
+// sibling returns the node at index i of a layer, or the empty chunk
+// merkleHash pads the layer with if there is none.
+func sibling(layer [][]byte, i int) []byte {
+	if i < len(layer) {
+		return layer[i]
+	}
+	return make([]byte, sszChunkSize)
+}
+
+func lengthChunk(n int) []byte {
+	chunk := make([]byte, hashLengthBytes)
+	binary.LittleEndian.PutUint64(chunk, uint64(n))
+	return chunk
+}
+
+// checkTreeHashProof checks that the steps of the proof, and the leaf, have
+// the sizes they have in the tree of a value of the type at the given path.
+func checkTreeHashProof(typ reflect.Type, path []pathElem, leaf []byte, proof []TreeHashStep) error {
+	// Check the steps from the root down, in the order of the path.
+	steps := make([]TreeHashStep, len(proof))
+	for i, step := range proof {
+		steps[len(proof)-1-i] = step
+	}
+	next := func(prefix int, suffix int) (TreeHashStep, error) {
+		if len(steps) == 0 {
+			return TreeHashStep{}, errors.New("proof is too short")
+		}
+		step := steps[0]
+		if len(step.Prefix) != prefix || len(step.Suffix) != suffix {
+			return TreeHashStep{}, errors.New("proof step does not match the path")
+		}
+		steps = steps[1:]
+		return step, nil
+	}
+	leafSize := -1
+	for i, elem := range path {
+		for typ.Kind() == reflect.Ptr {
+			typ = typ.Elem()
+		}
+		switch {
+		case elem.isLen:
+			if !isTreeHashList(typ) || i != len(path)-1 {
+				return errors.New("invalid path")
+			}
+			n, err := readLength(leaf)
+			if err != nil {
+				return err
+			}
+			shape := newListShape(typ, n)
+			if _, err := next(shape.rootSize(), 0); err != nil {
+				return err
+			}
+			leafSize = hashLengthBytes
+		case !elem.isItem:
+			if typ.Kind() != reflect.Struct {
+				return errors.New("invalid path")
+			}
+			fields, err := treeHashFields(typ)
+			if err != nil {
+				return err
+			}
+			index, ok := treeHashFieldIndex(fields, elem.field)
+			if !ok {
+				return errors.New("invalid path")
+			}
+			var prefix, suffix int
+			for j, f := range fields {
+				if j < index {
+					prefix += treeHashSize(typ.Field(f.index).Type)
+				} else if j > index {
+					suffix += treeHashSize(typ.Field(f.index).Type)
+				}
+			}
+			if _, err := next(prefix, suffix); err != nil {
+				return err
+			}
+			typ = typ.Field(fields[index].index).Type
+		default:
+			if !isTreeHashList(typ) {
+				return errors.New("invalid path")
+			}
+			if len(steps) == 0 {
+				return errors.New("proof is too short")
+			}
+			// The length is the end of the suffix of the top step of the list.
+			top := steps[0].Suffix
+			if len(top) < hashLengthBytes {
+				return errors.New("proof step does not match the path")
+			}
+			n, err := readLength(top[len(top)-hashLengthBytes:])
+			if err != nil {
+				return err
+			}
+			if typ.Kind() == reflect.Array && n != uint64(typ.Len()) {
+				return fmt.Errorf("vector of length %d has a length of %d in the proof", typ.Len(), n)
+			}
+			if elem.index >= n {
+				return fmt.Errorf("index %d is out of range for length %d", elem.index, n)
+			}
+			shape := newListShape(typ, n)
+			for _, sizes := range shape.stepSizes(int(elem.index)) {
+				if _, err := next(sizes[0], sizes[1]); err != nil {
+					return err
+				}
+			}
+			typ = typ.Elem()
+		}
+	}
+	if len(steps) != 0 {
+		return errors.New("proof is too long")
+	}
+	if leafSize < 0 {
+		leafSize = treeHashSize(typ)
+	}
+	if len(leaf) != leafSize {
+		return errors.New("leaf does not match the path")
+	}
+	return nil
+}
+
+// readLength reads the length of a list from the chunk it is mixed in as.
+func readLength(chunk []byte) (uint64, error) {
+	if len(chunk) != hashLengthBytes || !bytes.Equal(chunk[8:], make([]byte, hashLengthBytes-8)) {
+		return 0, errors.New("invalid list length")
+	}
+	n := binary.LittleEndian.Uint64(chunk)
+	// The chunk index of every element must fit in an int.
+	if n > uint64(int(^uint(0)>>1)) {
+		return 0, errors.New("invalid list length")
+	}
+	return n, nil
+}
+
+// listShape describes the tree merkleHash builds over a list of n elements
+// with tree-hashes of the given size.
+type listShape struct {
+	n        int
+	size     int
+	perChunk int
+	// layers holds the number of nodes of each layer, from the chunks up.
+	layers []int
+}
+
+func newListShape(typ reflect.Type, n uint64) *listShape {
+	s := &listShape{n: int(n), size: treeHashSize(typ.Elem()), perChunk: 1}
+	if s.size < sszChunkSize {
+		s.perChunk = sszChunkSize / s.size
+	}
+	chunks := (s.n + s.perChunk - 1) / s.perChunk
+	if chunks == 0 {
+		chunks = 1
+	}
+	for s.layers = []int{chunks}; chunks > 1; s.layers = append(s.layers, chunks) {
+		chunks = (chunks + 1) / 2
+	}
+	return s
+}
+
+// chunkSize returns the size of the chunk at index i of the first layer.
+func (s *listShape) chunkSize(i int) int {
+	if s.n == 0 {
+		return sszChunkSize
+	}
+	if i >= s.layers[0] {
+		// The layer is padded with an empty chunk.
+		return sszChunkSize
+	}
+	items := s.n - i*s.perChunk
+	if items > s.perChunk {
+		items = s.perChunk
+	}
+	return items * s.size
+}
+
+// nodeSize returns the size of the node at index i of a layer.
+func (s *listShape) nodeSize(layer int, i int) int {
+	if layer == 0 {
+		return s.chunkSize(i)
+	}
+	if i >= s.layers[layer] {
+		return sszChunkSize
+	}
+	return hashLengthBytes
+}
+
+// rootSize returns the size of the node which is mixed with the length.
+func (s *listShape) rootSize() int {
+	return s.nodeSize(len(s.layers)-1, 0)
+}
+
+// stepSizes returns the sizes of the prefix and the suffix of each step proving
+// the element at index, from the root of the list down, as listSteps builds them.
+func (s *listShape) stepSizes(index int) [][2]int {
+	position := index / s.perChunk
+	offset := index % s.perChunk * s.size
+	// Sizes from the bottom up.
+	prefix, suffix := offset, s.chunkSize(position)-offset-s.size
+	var sizes [][2]int
+	for layer := 0; layer < len(s.layers)-1; layer++ {
+		if position%2 == 0 {
+			suffix += s.nodeSize(layer, position+1)
+		} else {
+			prefix += s.nodeSize(layer, position-1)
+		}
+		sizes = append(sizes, [2]int{prefix, suffix})
+		prefix, suffix = 0, 0
+		position /= 2
+	}
+	sizes = append(sizes, [2]int{prefix, suffix + hashLengthBytes})
+	for i, j := 0, len(sizes)-1; i < j; i, j = i+1, j-1 {
+		sizes[i], sizes[j] = sizes[j], sizes[i]
+	}
+	return sizes
+}
@@ -0,0 +1,100 @@
+package ssz
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+)
+
+func TestTreeHashProof_VerifiesAgainstTreeHash(t *testing.T) {
+	for _, n := range []int{1, 2, 5, 16, 17, 37} {
+		r := newCachedRegistry(n)
+		root, err := TreeHash(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		paths := []string{"slot", "records.__len__", "balances.__len__", "roots.__len__"}
+		for _, i := range []int{0, n / 2, n - 1} {
+			paths = append(paths,
+				fmt.Sprintf("records[%d]", i),
+				fmt.Sprintf("records[%d].balance", i),
+				fmt.Sprintf("records[%d].pubkey", i),
+				fmt.Sprintf("balances[%d]", i),
+				fmt.Sprintf("roots[%d]", i),
+			)
+		}
+		for _, path := range paths {
+			leaf, proof, err := TreeHashProof(r, path)
+			if err != nil {
+				t.Errorf("Length %d: could not prove %q: %v", n, path, err)
+				continue
+			}
+			if !VerifyTreeHashProof((*cachedRegistry)(nil), path, root, leaf, proof) {
+				t.Errorf("Length %d: proof of %q did not verify", n, path)
+			}
+		}
+	}
+}
+
+func TestTreeHashProof_LeafOfBasicValue(t *testing.T) {
+	r := newCachedRegistry(20)
+	r.Records[13].Balance = 1234
+	leaf, _, err := TreeHashProof(r, "records[13].balance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(leaf) != 8 || binary.LittleEndian.Uint64(leaf) != 1234 {
+		t.Errorf("Expected the leaf to encode the balance, received %#x", leaf)
+	}
+	leaf, _, err = TreeHashProof(&cachedRegistry{}, "records.__len__")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(leaf) != 32 || binary.LittleEndian.Uint64(leaf) != 0 {
+		t.Errorf("Expected the leaf to encode an empty length, received %#x", leaf)
+	}
+}
+
+func TestVerifyTreeHashProof_RejectsOtherPaths(t *testing.T) {
+	r := newCachedRegistry(37)
+	root, err := TreeHash(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf, proof, err := TreeHashProof(r, "records[20].balance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{"records[21].balance", "records[4].balance", "records[20].exited", "records[20].pubkey", "slot", "records[20]"} {
+		if VerifyTreeHashProof((*cachedRegistry)(nil), path, root, leaf, proof) {
+			t.Errorf("Expected the proof of records[20].balance not to verify for %q", path)
+		}
+	}
+	forged := append([]byte{}, leaf...)
+	forged[0]++
+	if VerifyTreeHashProof((*cachedRegistry)(nil), "records[20].balance", root, forged, proof) {
+		t.Error("Expected a proof of another balance not to verify")
+	}
+	if VerifyTreeHashProof((*cachedRegistry)(nil), "records[20].balance", root, leaf, proof[:len(proof)-1]) {
+		t.Error("Expected a truncated proof not to verify")
+	}
+
+	// Balances are packed 16 to a chunk, so the proofs of two balances in the
+	// same chunk have the same nodes, split at different positions.
+	leaf, proof, err = TreeHashProof(r, "balances[3]")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if VerifyTreeHashProof((*cachedRegistry)(nil), "balances[4]", root, leaf, proof) {
+		t.Error("Expected the proof of balances[3] not to verify for balances[4]")
+	}
+}
+
+func TestTreeHashProof_InvalidPath(t *testing.T) {
+	r := newCachedRegistry(3)
+	for _, path := range []string{"missing", "records[3]", "records[0].pubkey[0]", "slot.a", "records[0].__len__", "records.__len__.a"} {
+		if _, _, err := TreeHashProof(r, path); err == nil {
+			t.Errorf("Expected an error proving %q", path)
+		}
+	}
+}
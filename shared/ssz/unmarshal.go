@@ -0,0 +1,241 @@
+package ssz
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Unmarshal deserializes data, which must hold exactly one value serialized
+// with the layout of Marshal, into the value pointed to by val. Sizes, offsets
+// and limits are checked, so any input which Marshal could not have produced
+// is rejected.
+func Unmarshal(data []byte, val interface{}) error {
+	if val == nil {
+		return newDecodeError("cannot decode into nil", nil)
+	}
+	rval := reflect.ValueOf(val)
+	rtyp := rval.Type()
+	if rtyp.Kind() != reflect.Ptr {
+		return newDecodeError("can only decode into pointer target", rtyp)
+	}
+	if rval.IsNil() {
+		return newDecodeError("cannot output to pointer of nil", rtyp)
+	}
+	t, err := cachedSpecType(rval.Elem().Type())
+	if err != nil {
+		return newDecodeError(fmt.Sprint(err), rval.Elem().Type())
+	}
+	if err := t.unmarshal(data, rval.Elem()); err != nil {
+		return newDecodeError(fmt.Sprint(err), rval.Elem().Type())
+	}
+	return nil
+}
+
+func (t *specType) unmarshal(data []byte, val reflect.Value) error {
+	if t.fixed && len(data) != t.size {
+		return fmt.Errorf("expected %d bytes, got %d", t.size, len(data))
+	}
+	if t.ptr {
+		ptr := reflect.New(t.typ.Elem())
+		if err := t.unmarshalValue(data, ptr.Elem()); err != nil {
+			return err
+		}
+		val.Set(ptr)
+		return nil
+	}
+	return t.unmarshalValue(data, val)
+}
+
+func (t *specType) unmarshalValue(data []byte, val reflect.Value) error {
+	switch t.kind {
+	case specBasic:
+		return unmarshalBasic(data, val)
+	case specBitvector:
+		if extra := t.length % 8; extra != 0 && data[len(data)-1]>>extra != 0 {
+			return fmt.Errorf("bitvector of %d bits has bits set beyond its length", t.length)
+		}
+		val.SetBytes(append([]byte{}, data...))
+		return nil
+	case specBitlist:
+		b := Bitlist(data)
+		if len(b) == 0 || b[len(b)-1] == 0 {
+			return errors.New("bitlist has no delimiting bit")
+		}
+		if t.limit > 0 && b.Len() > t.limit {
+			return fmt.Errorf("bitlist has %d bits, exceeding its limit of %d", b.Len(), t.limit)
+		}
+		val.SetBytes(append([]byte{}, data...))
+		return nil
+	case specVector, specList:
+		return t.unmarshalSequence(data, val)
+	case specContainer:
+		return t.unmarshalContainer(data, val)
+	default:
+		return fmt.Errorf("type %v is not serializable", t.typ)
+	}
+}
+
+func unmarshalBasic(data []byte, val reflect.Value) error {
+	var v uint64
+	for i := len(data) - 1; i >= 0; i-- {
+		v = v<<8 | uint64(data[i])
+	}
+	switch val.Kind() {
+	case reflect.Bool:
+		if v > 1 {
+			return fmt.Errorf("invalid boolean %d", v)
+		}
+		val.SetBool(v == 1)
+	case reflect.Int32:
+		val.SetInt(int64(int32(v)))
+	default:
+		val.SetUint(v)
+	}
+	return nil
+}
+
+func (t *specType) unmarshalSequence(data []byte, val reflect.Value) error {
+	var n int
+	var parts [][]byte
+	if t.elem.fixed {
+		if len(data)%t.elem.size != 0 {
+			return fmt.Errorf("%d bytes is not a multiple of the element size %d", len(data), t.elem.size)
+		}
+		n = len(data) / t.elem.size
+	} else {
+		var err error
+		if n, err = offsetCount(data); err != nil {
+			return err
+		}
+	}
+	if t.kind == specVector && uint64(n) != t.length {
+		return fmt.Errorf("vector must have %d elements, got %d", t.length, n)
+	}
+	if t.kind == specList && t.limit > 0 && uint64(n) > t.limit {
+		return fmt.Errorf("list has %d elements, exceeding its limit of %d", n, t.limit)
+	}
+	if !t.elem.fixed {
+		var err error
+		if parts, err = splitOffsets(data, n); err != nil {
+			return err
+		}
+	}
+
+	if val.Kind() == reflect.Slice {
+		if t.elem.typ.Kind() == reflect.Uint8 {
+			val.SetBytes(append([]byte{}, data...))
+			return nil
+		}
+		val.Set(reflect.MakeSlice(t.typ, n, n))
+	}
+	for i := 0; i < n; i++ {
+		part := data[i*t.elem.size : (i+1)*t.elem.size]
+		if !t.elem.fixed {
+			part = parts[i]
+		}
+		if err := t.elem.unmarshal(part, val.Index(i)); err != nil {
+			return partError(t, i, err)
+		}
+	}
+	return nil
+}
+
+// offsetCount returns the number of elements in the serialization of a list of
+// variable-size elements, which starts with the offset of each element. The
+// first offset gives the number of elements, and it is checked to lie within
+// data so that the count can be trusted before anything is allocated for it.
+func offsetCount(data []byte) (int, error) {
+	if len(data) == 0 {
+		return 0, nil
+	}
+	if len(data) < offsetBytes {
+		return 0, fmt.Errorf("expected an offset, got %d bytes", len(data))
+	}
+	first := binary.LittleEndian.Uint32(data)
+	if first == 0 || first%offsetBytes != 0 {
+		return 0, fmt.Errorf("invalid first offset %d", first)
+	}
+	if uint64(first) > uint64(len(data)) {
+		return 0, fmt.Errorf("first offset %d is beyond the %d bytes", first, len(data))
+	}
+	return int(first / offsetBytes), nil
+}
+
+// splitOffsets splits the serialization of a list of n variable-size elements,
+// as counted by offsetCount, into the parts their offsets delimit.
+func splitOffsets(data []byte, n int) ([][]byte, error) {
+	if n == 0 {
+		return nil, nil
+	}
+	positions := make([]int, n)
+	for i := range positions {
+		positions[i] = i * offsetBytes
+	}
+	return readParts(data, positions, n*offsetBytes)
+}
+
+// readParts reads the offsets at the given positions and returns the parts of
+// data they delimit. The first part must start right after the fixed-size
+// parts, which end at fixedEnd, and each part must end where the next starts.
+func readParts(data []byte, positions []int, fixedEnd int) ([][]byte, error) {
+	if len(data) < fixedEnd {
+		return nil, fmt.Errorf("expected at least %d bytes, got %d", fixedEnd, len(data))
+	}
+	if len(positions) == 0 {
+		if len(data) != fixedEnd {
+			return nil, fmt.Errorf("expected %d bytes, got %d", fixedEnd, len(data))
+		}
+		return nil, nil
+	}
+	offsets := make([]int, len(positions)+1)
+	for i, pos := range positions {
+		offsets[i] = int(binary.LittleEndian.Uint32(data[pos:]))
+		if i == 0 && offsets[0] != fixedEnd {
+			return nil, fmt.Errorf("first offset %d does not match the end of the fixed-size parts at %d", offsets[0], fixedEnd)
+		}
+		if offsets[i] > len(data) || (i > 0 && offsets[i] < offsets[i-1]) {
+			return nil, fmt.Errorf("offset %d at position %d is out of bounds", offsets[i], pos)
+		}
+	}
+	offsets[len(positions)] = len(data)
+	parts := make([][]byte, len(positions))
+	for i := range parts {
+		parts[i] = data[offsets[i]:offsets[i+1]]
+	}
+	return parts, nil
+}
+
+func (t *specType) unmarshalContainer(data []byte, val reflect.Value) error {
+	var positions []int
+	fixedEnd := 0
+	for _, f := range t.fields {
+		if f.typ.fixed {
+			fixedEnd += f.typ.size
+		} else {
+			positions = append(positions, fixedEnd)
+			fixedEnd += offsetBytes
+		}
+	}
+	parts, err := readParts(data, positions, fixedEnd)
+	if err != nil {
+		return err
+	}
+
+	pos := 0
+	for i, f := range t.fields {
+		var part []byte
+		if f.typ.fixed {
+			part = data[pos : pos+f.typ.size]
+			pos += f.typ.size
+		} else {
+			part, parts = parts[0], parts[1:]
+			pos += offsetBytes
+		}
+		if err := f.typ.unmarshal(part, val.Field(f.index)); err != nil {
+			return partError(t, i, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+// Genesis state generator
+//
+// Usage: bazel run //tools/genesis-state-gen -- -deposit-data=$DEPOSIT_DATA_FILE -output-json=genesis.json
+//
+// This tool generates the genesis beacon state of a new beacon chain from the
+// deposit data or the keystores of its genesis validators. The generated file
+// is used to start beacon nodes with --genesis-json, so a private network can
+// be started without deploying a deposit contract on an ETH1.0 chain.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/ssz"
+)
+
+var (
+	depositData = flag.String("deposit-data", "", "Path to a file with one hex encoded deposit input per line, as printed by validator accounts create")
+	keystores   = flag.String("keystores", "", "Comma separated paths to validator keystore files to sign the deposit inputs with")
+	password    = flag.String("password", "", "Password to decrypt the keystores given with -keystores")
+	genesisTime = flag.Uint64("genesis-time", 0, "Unix timestamp of the genesis, defaults to the current time")
+	demoConfig  = flag.Bool("demo-config", false, "Use the demo beacon chain configuration, must match the --demo-config flag of the beacon nodes")
+	outputJSON  = flag.String("output-json", "", "Path to write the JSON encoded genesis state to, to be used with --genesis-json")
+	outputSSZ   = flag.String("output-ssz", "", "Path to write the SSZ encoded genesis state to")
+	outputProto = flag.String("output-proto", "", "Path to write the protobuf encoded genesis state to")
+)
+
+func main() {
+	flag.Parse()
+	if *outputJSON == "" && *outputSSZ == "" && *outputProto == "" {
+		log.Fatal("Error: Expected at least one of -output-json, -output-ssz or -output-proto.")
+	}
+	if *demoConfig {
+		params.UseDemoBeaconConfig()
+	}
+	if *genesisTime == 0 {
+		*genesisTime = uint64(time.Now().Unix())
+	}
+
+	var depositInputs []*pb.DepositInput
+	if *depositData != "" {
+		inputs, err := readDepositData(*depositData)
+		if err != nil {
+			log.Fatalf("Error: Could not read deposit data: %v", err)
+		}
+		depositInputs = append(depositInputs, inputs...)
+	}
+	if *keystores != "" {
+		inputs, err := readKeystores(strings.Split(*keystores, ","), *password)
+		if err != nil {
+			log.Fatalf("Error: Could not read keystores: %v", err)
+		}
+		depositInputs = append(depositInputs, inputs...)
+	}
+	if len(depositInputs) == 0 {
+		log.Fatal("Error: No genesis validators provided, use -deposit-data or -keystores.")
+	}
+
+	genesis, err := state.GenerateGenesisState(depositInputs, *genesisTime)
+	if err != nil {
+		log.Fatalf("Error: Could not generate genesis state: %v", err)
+	}
+	log.Printf(
+		"Generated genesis state with %d validators, genesis time %d and genesis block root %#x\n",
+		len(genesis.State.ValidatorRegistry),
+		genesis.State.GenesisTime,
+		genesis.GenesisBlockRootHash32,
+	)
+
+	if *outputJSON != "" {
+		buf := new(bytes.Buffer)
+		if err := (&jsonpb.Marshaler{Indent: "  "}).Marshal(buf, genesis); err != nil {
+			log.Fatalf("Error: Could not marshal genesis state to JSON: %v", err)
+		}
+		writeFile(*outputJSON, buf.Bytes())
+	}
+	if *outputSSZ != "" {
+		buf := new(bytes.Buffer)
+		if err := ssz.Encode(buf, genesis); err != nil {
+			log.Fatalf("Error: Could not encode genesis state with SSZ: %v", err)
+		}
+		writeFile(*outputSSZ, buf.Bytes())
+	}
+	if *outputProto != "" {
+		enc, err := proto.Marshal(genesis)
+		if err != nil {
+			log.Fatalf("Error: Could not marshal genesis state to protobuf: %v", err)
+		}
+		writeFile(*outputProto, enc)
+	}
+}
+
+// readDepositData reads the SSZ encoded deposit inputs, one hex string per line.
+func readDepositData(path string) ([]*pb.DepositInput, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var depositInputs []*pb.DepositInput
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimPrefix(strings.TrimSpace(scanner.Text()), "0x")
+		if line == "" {
+			continue
+		}
+		enc, err := hex.DecodeString(line)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode deposit input %q: %v", line, err)
+		}
+		depositInput := &pb.DepositInput{}
+		if err := ssz.Decode(bytes.NewReader(enc), depositInput); err != nil {
+			return nil, fmt.Errorf("could not decode deposit input %q: %v", line, err)
+		}
+		depositInputs = append(depositInputs, depositInput)
+	}
+	return depositInputs, scanner.Err()
+}
+
+// readKeystores decrypts the given validator keystores and signs a deposit input
+// with each key. As for the interop validators, the withdrawal credentials are
+// derived from the validator key itself.
+func readKeystores(paths []string, password string) ([]*pb.DepositInput, error) {
+	depositInputs := make([]*pb.DepositInput, len(paths))
+	for i, path := range paths {
+		keyJSON, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		key, err := keystore.DecryptKey(keyJSON, password)
+		if err != nil {
+			return nil, fmt.Errorf("could not decrypt keystore %s: %v", path, err)
+		}
+		if depositInputs[i], err = keystore.DepositInput(key, key); err != nil {
+			return nil, fmt.Errorf("could not create deposit input for keystore %s: %v", path, err)
+		}
+	}
+	return depositInputs, nil
+}
+
+func writeFile(path string, data []byte) {
+	if err := ioutil.WriteFile(path, data, 0644); err != nil {
+		log.Fatalf("Error: Could not write %s: %v", path, err)
+	}
+	log.Printf("Wrote genesis state to %s\n", path)
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/ssz"
+)
+
+func writeKeystores(t *testing.T, dir string, password string, n int) []string {
+	paths := make([]string, n)
+	for i := range paths {
+		key, err := keystore.NewKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		keyJSON, err := keystore.EncryptKey(key, password, keystore.LightScryptN, keystore.LightScryptP)
+		if err != nil {
+			t.Fatal(err)
+		}
+		paths[i] = filepath.Join(dir, fmt.Sprintf("key%d.json", i))
+		if err := ioutil.WriteFile(paths[i], keyJSON, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return paths
+}
+
+func TestReadKeystores_RegistersValidators(t *testing.T) {
+	dir, err := ioutil.TempDir("", "genesis-state-gen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	paths := writeKeystores(t, dir, "password", 3)
+
+	depositInputs, err := readKeystores(paths, "password")
+	if err != nil {
+		t.Fatalf("Could not read keystores: %v", err)
+	}
+	genesis, err := state.GenerateGenesisState(depositInputs, 0)
+	if err != nil {
+		t.Fatalf("Could not generate genesis state: %v", err)
+	}
+	if len(genesis.State.ValidatorRegistry) != len(paths) {
+		t.Errorf("Expected %d validators in the registry, received %d", len(paths), len(genesis.State.ValidatorRegistry))
+	}
+
+	if _, err := readKeystores(paths, "wrong"); err == nil || !strings.Contains(err.Error(), "could not decrypt keystore") {
+		t.Errorf("Expected keystores to fail to decrypt with the wrong password, received %v", err)
+	}
+}
+
+func TestReadDepositData_RegistersValidators(t *testing.T) {
+	dir, err := ioutil.TempDir("", "genesis-state-gen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	keystoreInputs, err := readKeystores(writeKeystores(t, dir, "password", 2), "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var lines []string
+	for _, depositInput := range keystoreInputs {
+		buf := new(bytes.Buffer)
+		if err := ssz.Encode(buf, depositInput); err != nil {
+			t.Fatal(err)
+		}
+		lines = append(lines, "0x"+hex.EncodeToString(buf.Bytes()))
+	}
+	path := filepath.Join(dir, "deposits.txt")
+	if err := ioutil.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	depositInputs, err := readDepositData(path)
+	if err != nil {
+		t.Fatalf("Could not read deposit data: %v", err)
+	}
+	genesis, err := state.GenerateGenesisState(depositInputs, 0)
+	if err != nil {
+		t.Fatalf("Could not generate genesis state: %v", err)
+	}
+	if len(genesis.State.ValidatorRegistry) != len(lines) {
+		t.Errorf("Expected %d validators in the registry, received %d", len(lines), len(genesis.State.ValidatorRegistry))
+	}
+}
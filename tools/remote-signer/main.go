@@ -0,0 +1,114 @@
+/**
+ * Remote signer
+ *
+ * A reference signer server which holds validator keys on an isolated host and
+ * signs blocks, attestations and RANDAO reveals for validator clients started
+ * with --remote-signer. It keeps the signing history of the keys and refuses to
+ * sign anything which would get a key slashed. It only serves validator clients
+ * which authenticate with a client certificate signed by the CA of -tls-client-ca,
+ * unless it is started with -insecure.
+ *
+ * Usage: Run remote-signer --help for flag options.
+ */
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"path"
+
+	pb "github.com/prysmaticlabs/prysm/proto/signer/v1"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	"github.com/prysmaticlabs/prysm/validator/accounts"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/signer"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var (
+	port         = flag.Int("port", 4500, "The port to serve gRPC")
+	keystorePath = flag.String("keystore-path", "", "Path to the validator keystore, or to a directory of keystores")
+	password     = flag.String("password", "", "Password of the validator keystores")
+	dataDir      = flag.String("datadir", "", "Directory of the database of the signing history of the keys")
+	certFlag     = flag.String("tls-cert", "", "Certificate for secure gRPC")
+	keyFlag      = flag.String("tls-key", "", "Key for secure gRPC")
+	clientCAFlag = flag.String("tls-client-ca", "", "CA certificate which the client certificates of the validator clients must be signed by")
+	insecure     = flag.Bool("insecure", false, "Serve gRPC without TLS and without authenticating the validator clients, which lets anyone who can reach the port sign with the keys")
+
+	log = logrus.WithField("prefix", "remote-signer")
+)
+
+func main() {
+	flag.Parse()
+	log.WithField("version", version.GetVersion()).Info("Starting remote signer")
+
+	if *dataDir == "" {
+		log.Fatal("Expected a data directory for the signing history of the keys, received nil")
+	}
+	keys, err := accounts.LoadKeys(*keystorePath, *password)
+	if err != nil {
+		log.Fatalf("Could not load validator keys: %v", err)
+	}
+	validatorDB, err := db.NewDB(path.Join(*dataDir, "validatordata"))
+	if err != nil {
+		log.Fatalf("Could not open validator database: %v", err)
+	}
+	defer validatorDB.Close()
+
+	var opts []grpc.ServerOption
+	if *insecure {
+		log.Warn("You are using an insecure gRPC connection without client authentication! Anyone who can reach the port can sign with the keys")
+	} else {
+		creds, err := serverCredentials(*certFlag, *keyFlag, *clientCAFlag)
+		if err != nil {
+			log.Fatalf("Could not set up mutual TLS: %v, pass -insecure to serve without it", err)
+		}
+		opts = append(opts, grpc.Creds(creds))
+	}
+	s := grpc.NewServer(opts...)
+	pb.RegisterRemoteSignerServer(s, signer.NewServer(signer.NewLocalSigner(keys, validatorDB)))
+
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", *port))
+	if err != nil {
+		log.Fatalf("Could not listen to port %d: %v", *port, err)
+	}
+	for _, key := range keys {
+		log.WithField("publicKey", fmt.Sprintf("%#x", key.PublicKey.Marshal())).Info("Signing with validator key")
+	}
+	log.Infof("Listening for gRPC requests on port %d", *port)
+	if err := s.Serve(lis); err != nil {
+		log.Errorf("Could not serve gRPC: %v", err)
+	}
+}
+
+// serverCredentials returns the credentials of mutual TLS, with which the remote signer
+// only serves validator clients which present a certificate signed by the CA of clientCA.
+func serverCredentials(cert string, key string, clientCA string) (credentials.TransportCredentials, error) {
+	if cert == "" || key == "" || clientCA == "" {
+		return nil, errors.New("expected -tls-cert, -tls-key and -tls-client-ca")
+	}
+	serverCert, err := tls.LoadX509KeyPair(cert, key)
+	if err != nil {
+		return nil, fmt.Errorf("could not load TLS keys: %v", err)
+	}
+	pem, err := ioutil.ReadFile(clientCA)
+	if err != nil {
+		return nil, fmt.Errorf("could not read client CA certificate: %v", err)
+	}
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no valid certificate in %s", clientCA)
+	}
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientCAs,
+	}), nil
+}
@@ -0,0 +1,200 @@
+// SSZ converter
+//
+// Usage: bazel run //tools/ssz-convert -- -type=BeaconBlock -in=block.ssz -to=yaml -roots
+//
+// This tool converts beacon chain values between their SSZ encoding and a
+// human-readable JSON or YAML rendering, with bytes as 0x-prefixed hex strings
+// and integers as decimal numbers, to inspect SSZ blobs when debugging interop
+// with other clients. With -roots, it also prints the root of each subtree of
+// the value to stderr, to find where two values with different roots differ.
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/ssz"
+)
+
+var (
+	typeName  = flag.String("type", "", "Name of the type of the value, see -list-types")
+	listTypes = flag.Bool("list-types", false, "List the names of the supported types and exit")
+	input     = flag.String("in", "", "Path to read the value from, defaults to stdin")
+	output    = flag.String("out", "", "Path to write the converted value to, defaults to stdout")
+	from      = flag.String("from", "ssz", "Format of the input: ssz, hex (SSZ as a hex string), json or yaml")
+	to        = flag.String("to", "yaml", "Format of the output: ssz, hex (SSZ as a hex string), json or yaml")
+	spec      = flag.Bool("spec", false, "Encode and hash with the current SSZ spec (Marshal, Unmarshal and MerkleRoot) rather than Encode, Decode and TreeHash")
+	roots     = flag.Bool("roots", false, "Print the root of the value and of each struct, list and vector nested in it to stderr")
+)
+
+func init() {
+	for name, val := range map[string]interface{}{
+		"Attestation":                  &pb.Attestation{},
+		"AttestationData":              &pb.AttestationData{},
+		"AttestationDataAndCustodyBit": &pb.AttestationDataAndCustodyBit{},
+		"AttesterSlashing":             &pb.AttesterSlashing{},
+		"BeaconBlock":                  &pb.BeaconBlock{},
+		"BeaconBlockBody":              &pb.BeaconBlockBody{},
+		"BeaconState":                  &pb.BeaconState{},
+		"Crosslink":                    &pb.Crosslink{},
+		"Deposit":                      &pb.Deposit{},
+		"DepositData":                  &pb.DepositData{},
+		"DepositInput":                 &pb.DepositInput{},
+		"Eth1Data":                     &pb.Eth1Data{},
+		"Eth1DataVote":                 &pb.Eth1DataVote{},
+		"Fork":                         &pb.Fork{},
+		"PendingAttestation":           &pb.PendingAttestation{},
+		"ProposalSignedData":           &pb.ProposalSignedData{},
+		"ProposerSlashing":             &pb.ProposerSlashing{},
+		"SlashableAttestation":         &pb.SlashableAttestation{},
+		"Validator":                    &pb.Validator{},
+		"VoluntaryExit":                &pb.VoluntaryExit{},
+	} {
+		ssz.RegisterType(name, val)
+	}
+}
+
+func main() {
+	flag.Parse()
+	if *listTypes {
+		fmt.Println(strings.Join(ssz.RegisteredTypes(), "\n"))
+		return
+	}
+	if *typeName == "" {
+		log.Fatal("Error: Expected -type, see -list-types for the supported types.")
+	}
+	val, err := ssz.NewRegisteredValue(*typeName)
+	if err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+
+	var data []byte
+	if *input == "" {
+		data, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		data, err = ioutil.ReadFile(*input)
+	}
+	if err != nil {
+		log.Fatalf("Error: Could not read input: %v", err)
+	}
+	if err := parse(data, val); err != nil {
+		log.Fatalf("Error: Could not parse %s input: %v", *from, err)
+	}
+
+	enc, err := format(val)
+	if err != nil {
+		log.Fatalf("Error: Could not convert to %s: %v", *to, err)
+	}
+	if *output == "" {
+		_, err = os.Stdout.Write(enc)
+	} else {
+		err = ioutil.WriteFile(*output, enc, 0644)
+	}
+	if err != nil {
+		log.Fatalf("Error: Could not write output: %v", err)
+	}
+
+	if *roots {
+		if err := printRoots(val); err != nil {
+			log.Fatalf("Error: Could not compute roots: %v", err)
+		}
+	}
+}
+
+func parse(data []byte, val interface{}) error {
+	switch *from {
+	case "ssz":
+		return decode(data, val)
+	case "hex":
+		enc, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(string(data)), "0x"))
+		if err != nil {
+			return err
+		}
+		return decode(enc, val)
+	case "json":
+		return ssz.FromJSON(data, val)
+	case "yaml":
+		return ssz.FromYAML(data, val)
+	default:
+		return fmt.Errorf("unknown format %s", *from)
+	}
+}
+
+func decode(enc []byte, val interface{}) error {
+	if *spec {
+		return ssz.Unmarshal(enc, val)
+	}
+	r := bytes.NewReader(enc)
+	dec := ssz.NewDecoder(r)
+	dec.SetMaxSize(uint64(len(enc)))
+	if err := dec.Decode(val); err != nil {
+		return err
+	}
+	// The whole input must be a single value.
+	if r.Len() > 0 {
+		return fmt.Errorf("%d bytes left after decoding", r.Len())
+	}
+	return nil
+}
+
+func format(val interface{}) ([]byte, error) {
+	switch *to {
+	case "ssz":
+		return encode(val)
+	case "hex":
+		enc, err := encode(val)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(fmt.Sprintf("0x%x\n", enc)), nil
+	case "json":
+		enc, err := ssz.ToJSON(val)
+		if err != nil {
+			return nil, err
+		}
+		return append(enc, '\n'), nil
+	case "yaml":
+		return ssz.ToYAML(val)
+	default:
+		return nil, fmt.Errorf("unknown format %s", *to)
+	}
+}
+
+func encode(val interface{}) ([]byte, error) {
+	if *spec {
+		return ssz.Marshal(val)
+	}
+	buf := new(bytes.Buffer)
+	if err := ssz.Encode(buf, val); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func printRoots(val interface{}) error {
+	var subtrees []ssz.SubtreeRoot
+	var err error
+	if *spec {
+		subtrees, err = ssz.MerkleRoots(val)
+	} else {
+		subtrees, err = ssz.TreeHashRoots(val)
+	}
+	if err != nil {
+		return err
+	}
+	for _, subtree := range subtrees {
+		path := subtree.Path
+		if path == "" {
+			path = "<root>"
+		}
+		fmt.Fprintf(os.Stderr, "%#x %s\n", subtree.Root, path)
+	}
+	return nil
+}
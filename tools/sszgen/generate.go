@@ -0,0 +1,173 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+)
+
+// generator writes the methods of the containers. Nested lists declare their
+// loop variables with the nesting depth as suffix so that they do not shadow
+// each other.
+type generator struct {
+	buf   bytes.Buffer
+	depth int
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// generate returns the formatted source of the methods of every container.
+func generate(pkgName string, containers []*container) ([]byte, error) {
+	g := &generator{}
+	g.printf("// Code generated by sszgen. DO NOT EDIT.\n\n")
+	g.printf("package %s\n\n", pkgName)
+	g.printf("import %q\n", sszutilImportPath)
+	for _, c := range containers {
+		g.container(c)
+	}
+	src, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("could not format generated code: %v", err)
+	}
+	return src, nil
+}
+
+func (g *generator) container(c *container) {
+	g.printf("\n// MarshalSSZ returns the SSZ encoding of m.\n")
+	g.printf("func (m *%s) MarshalSSZ() ([]byte, error) {\n", c.name)
+	g.printf("e := &sszutil.Encoder{}\n")
+	g.printf("m.MarshalSSZTo(e)\n")
+	g.printf("return e.Result()\n")
+	g.printf("}\n")
+
+	g.printf("\n// MarshalSSZTo appends the SSZ encoding of m to e.\n")
+	g.printf("func (m *%s) MarshalSSZTo(e *sszutil.Encoder) {\n", c.name)
+	g.printf("if m == nil {\ne.Nil()\nreturn\n}\n")
+	g.printf("offset := e.Begin()\n")
+	for _, f := range c.fields {
+		g.marshal("m."+f.name, f.typ)
+	}
+	g.printf("e.End(offset)\n")
+	g.printf("}\n")
+
+	g.printf("\n// UnmarshalSSZ decodes m from its SSZ encoding.\n")
+	g.printf("func (m *%s) UnmarshalSSZ(b []byte) error {\n", c.name)
+	g.printf("d := sszutil.NewDecoder(b)\n")
+	g.printf("m.UnmarshalSSZFrom(d)\n")
+	g.printf("return d.Finish()\n")
+	g.printf("}\n")
+
+	g.printf("\n// UnmarshalSSZFrom decodes m from the next SSZ encoding read by d.\n")
+	g.printf("func (m *%s) UnmarshalSSZFrom(d *sszutil.Decoder) {\n", c.name)
+	g.printf("end := d.Begin()\n")
+	g.printf("if !d.More(end) {\nreturn\n}\n")
+	for _, f := range c.fields {
+		g.unmarshal("m."+f.name, f.typ)
+	}
+	g.printf("d.End(end)\n")
+	g.printf("}\n")
+
+	g.printf("\n// HashTreeRoot returns the SSZ tree-hash of m.\n")
+	g.printf("func (m *%s) HashTreeRoot() ([32]byte, error) {\n", c.name)
+	g.printf("if m == nil {\nreturn sszutil.NilRoot, nil\n}\n")
+	g.printf("h := &sszutil.Hasher{}\n")
+	for _, f := range c.fields {
+		g.hash("h", "m."+f.name, f.typ)
+	}
+	g.printf("return h.Sum()\n")
+	g.printf("}\n")
+}
+
+func (g *generator) marshal(expr string, t *sszType) {
+	switch t.kind {
+	case kindBool:
+		g.printf("e.Bool(%s)\n", expr)
+	case kindUint32:
+		g.printf("e.Uint32(uint32(%s))\n", expr)
+	case kindUint64:
+		g.printf("e.Uint64(%s)\n", expr)
+	case kindBytes:
+		g.printf("e.Bytes(%s)\n", expr)
+	case kindContainer:
+		g.printf("%s.MarshalSSZTo(e)\n", expr)
+	case kindList:
+		g.depth++
+		offset, v := fmt.Sprintf("offset%d", g.depth), fmt.Sprintf("v%d", g.depth)
+		g.printf("{\n%s := e.Begin()\n", offset)
+		g.printf("for _, %s := range %s {\n", v, expr)
+		g.marshal(v, t.elem)
+		g.printf("}\ne.End(%s)\n}\n", offset)
+		g.depth--
+	}
+}
+
+func (g *generator) unmarshal(target string, t *sszType) {
+	if expr, ok := decodeExpr(t); ok {
+		g.printf("%s = %s\n", target, expr)
+		return
+	}
+	g.printf("%s = nil\n", target)
+	g.decodeInto(target, t)
+}
+
+// decodeExpr returns the expression decoding a value of a basic type or a byte slice.
+func decodeExpr(t *sszType) (string, bool) {
+	switch t.kind {
+	case kindBool:
+		return "d.Bool()", true
+	case kindUint32:
+		return t.goType() + "(d.Uint32())", true
+	case kindUint64:
+		return "d.Uint64()", true
+	case kindBytes:
+		return "d.Bytes()", true
+	default:
+		return "", false
+	}
+}
+
+// decodeInto decodes a container or a list into target, which must be nil.
+func (g *generator) decodeInto(target string, t *sszType) {
+	if t.kind == kindContainer {
+		g.printf("if !d.IsNil() {\n%s = &%s{}\n%s.UnmarshalSSZFrom(d)\n}\n", target, t.name, target)
+		return
+	}
+	g.depth++
+	end, v := fmt.Sprintf("end%d", g.depth), fmt.Sprintf("v%d", g.depth)
+	g.printf("{\n%s := d.Begin()\n", end)
+	g.printf("for d.More(%s) {\n", end)
+	if expr, ok := decodeExpr(t.elem); ok {
+		g.printf("%s = append(%s, %s)\n", target, target, expr)
+	} else {
+		g.printf("var %s %s\n", v, t.elem.goType())
+		g.decodeInto(v, t.elem)
+		g.printf("%s = append(%s, %s)\n", target, target, v)
+	}
+	g.printf("}\nd.End(%s)\n}\n", end)
+	g.depth--
+}
+
+func (g *generator) hash(hasher string, expr string, t *sszType) {
+	switch t.kind {
+	case kindBool:
+		g.printf("%s.Bool(%s)\n", hasher, expr)
+	case kindUint32:
+		g.printf("%s.Uint32(uint32(%s))\n", hasher, expr)
+	case kindUint64:
+		g.printf("%s.Uint64(%s)\n", hasher, expr)
+	case kindBytes:
+		g.printf("%s.Bytes(%s)\n", hasher, expr)
+	case kindContainer:
+		g.printf("%s.Root(%s.HashTreeRoot())\n", hasher, expr)
+	case kindList:
+		g.depth++
+		list, v := fmt.Sprintf("l%d", g.depth), fmt.Sprintf("v%d", g.depth)
+		g.printf("{\n%s := &sszutil.Hasher{}\n", list)
+		g.printf("for _, %s := range %s {\n", v, expr)
+		g.hash(list, v, t.elem)
+		g.printf("}\n%s.List(%s)\n}\n", hasher, list)
+		g.depth--
+	}
+}
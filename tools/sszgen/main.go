@@ -0,0 +1,221 @@
+// SSZ code generator
+//
+// Usage: bazel run //tools/sszgen -- -path=$PWD/proto/beacon/p2p/v1 -output=$PWD/proto/beacon/p2p/v1/generated.ssz.go
+//
+// This tool generates MarshalSSZ, UnmarshalSSZ and HashTreeRoot methods for
+// every struct type declared in a Go package, such as the gogo-protobuf types
+// of the beacon chain. The generated methods give the same results as the
+// reflective encoder, decoder and tree-hash of shared/ssz, which use them
+// instead of reflection once they exist. Regenerate the methods whenever the
+// protos of the package change.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const sszutilImportPath = "github.com/prysmaticlabs/prysm/shared/ssz/sszutil"
+
+type kind int
+
+const (
+	kindBool kind = iota
+	kindUint32
+	kindUint64
+	kindBytes
+	kindContainer
+	kindList
+)
+
+// sszType describes how a Go field type is encoded.
+type sszType struct {
+	kind kind
+	// name is the name of a container type, or of a named integer type
+	// such as a proto enum, which is encoded as its underlying integer.
+	name string
+	elem *sszType
+}
+
+// goType returns the Go type which t was parsed from.
+func (t *sszType) goType() string {
+	switch t.kind {
+	case kindBool:
+		return "bool"
+	case kindUint32:
+		if t.name != "" {
+			return t.name
+		}
+		return "uint32"
+	case kindUint64:
+		return "uint64"
+	case kindBytes:
+		return "[]byte"
+	case kindContainer:
+		return "*" + t.name
+	default:
+		return "[]" + t.elem.goType()
+	}
+}
+
+type containerField struct {
+	name string
+	typ  *sszType
+}
+
+type container struct {
+	name   string
+	fields []containerField
+}
+
+func main() {
+	path := flag.String("path", "", "Directory of the Go package to generate SSZ methods for")
+	output := flag.String("output", "", "Output file for the generated methods")
+	flag.Parse()
+
+	if *path == "" || *output == "" {
+		log.Fatal("Expected -path and -output to be set")
+	}
+
+	pkgName, containers, err := parsePackage(*path, *output)
+	if err != nil {
+		log.Fatalf("Could not parse package: %v", err)
+	}
+	src, err := generate(pkgName, containers)
+	if err != nil {
+		log.Fatalf("Could not generate SSZ methods: %v", err)
+	}
+	if err := ioutil.WriteFile(*output, src, 0644); err != nil {
+		log.Fatalf("Could not write %s: %v", *output, err)
+	}
+	log.Printf("Generated SSZ methods for %d types in %s", len(containers), *output)
+}
+
+// parsePackage collects the struct types declared in the non-test Go files
+// of the package in dir, except the output file of a previous run.
+func parsePackage(dir string, output string) (string, []*container, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return "", nil, err
+	}
+	sort.Strings(files)
+
+	fset := token.NewFileSet()
+	var pkgName string
+	var structs []*ast.TypeSpec
+	integers := make(map[string]bool)
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") || sameFile(file, output) {
+			continue
+		}
+		f, err := parser.ParseFile(fset, file, nil, 0)
+		if err != nil {
+			return "", nil, err
+		}
+		pkgName = f.Name.Name
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				switch t := ts.Type.(type) {
+				case *ast.StructType:
+					structs = append(structs, ts)
+				case *ast.Ident:
+					if t.Name == "int32" || t.Name == "uint32" {
+						integers[ts.Name.Name] = true
+					}
+				}
+			}
+		}
+	}
+	if pkgName == "" {
+		return "", nil, fmt.Errorf("no Go files found in %s", dir)
+	}
+
+	containerNames := make(map[string]bool, len(structs))
+	for _, ts := range structs {
+		containerNames[ts.Name.Name] = true
+	}
+	containers := make([]*container, 0, len(structs))
+	for _, ts := range structs {
+		c := &container{name: ts.Name.Name}
+		for _, f := range ts.Type.(*ast.StructType).Fields.List {
+			for _, name := range f.Names {
+				// Fields such as XXX_unrecognized are skipped by shared/ssz too.
+				if strings.Contains(name.Name, "XXX") {
+					continue
+				}
+				typ, err := parseType(f.Type, containerNames, integers)
+				if err != nil {
+					return "", nil, fmt.Errorf("field %s of %s: %v", name.Name, c.name, err)
+				}
+				c.fields = append(c.fields, containerField{name: name.Name, typ: typ})
+			}
+		}
+		containers = append(containers, c)
+	}
+	return pkgName, containers, nil
+}
+
+func parseType(expr ast.Expr, containers map[string]bool, integers map[string]bool) (*sszType, error) {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		switch {
+		case t.Name == "bool":
+			return &sszType{kind: kindBool}, nil
+		case t.Name == "uint32":
+			return &sszType{kind: kindUint32}, nil
+		case t.Name == "uint64":
+			return &sszType{kind: kindUint64}, nil
+		case integers[t.Name]:
+			return &sszType{kind: kindUint32, name: t.Name}, nil
+		}
+	case *ast.StarExpr:
+		if ident, ok := t.X.(*ast.Ident); ok && containers[ident.Name] {
+			return &sszType{kind: kindContainer, name: ident.Name}, nil
+		}
+	case *ast.ArrayType:
+		if t.Len != nil {
+			break
+		}
+		if ident, ok := t.Elt.(*ast.Ident); ok && ident.Name == "byte" {
+			return &sszType{kind: kindBytes}, nil
+		}
+		elem, err := parseType(t.Elt, containers, integers)
+		if err != nil {
+			return nil, err
+		}
+		return &sszType{kind: kindList, elem: elem}, nil
+	}
+	var buf bytes.Buffer
+	if err := format.Node(&buf, token.NewFileSet(), expr); err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("type %s is not supported", buf.String())
+}
+
+func sameFile(a string, b string) bool {
+	aInfo, err := os.Stat(a)
+	if err != nil {
+		return false
+	}
+	bInfo, err := os.Stat(b)
+	if err != nil {
+		return false
+	}
+	return os.SameFile(aInfo, bInfo)
+}
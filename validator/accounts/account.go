@@ -5,6 +5,12 @@ import (
 	"crypto/rand"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
 
 	"github.com/prysmaticlabs/prysm/shared/keystore"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -14,6 +20,10 @@ import (
 
 var log = logrus.WithField("prefix", "accounts")
 
+// maxAccountIndex is the largest index of an account derived from a mnemonic, as
+// the index is a single level of its EIP-2334 derivation path.
+const maxAccountIndex = math.MaxUint32
+
 // VerifyAccountNotExists checks if a validator has not yet created an account
 // and keystore in the provided directory string.
 func VerifyAccountNotExists(directory string, password string) error {
@@ -23,7 +33,7 @@ func VerifyAccountNotExists(directory string, password string) error {
 	shardWithdrawalKeyFile := directory + params.BeaconConfig().WithdrawalPrivkeyFileName
 	validatorKeyFile := directory + params.BeaconConfig().ValidatorPrivkeyFileName
 	// First, if the keystore already exists, throws an error as there can only be
-	// one keystore per validator client.
+	// one keystore per directory.
 	ks := keystore.NewKeystore(directory)
 	if _, err := ks.GetKey(shardWithdrawalKeyFile, password); err == nil {
 		return fmt.Errorf("keystore at path already exists: %s", shardWithdrawalKeyFile)
@@ -40,18 +50,168 @@ func VerifyAccountNotExists(directory string, password string) error {
 // to be used in an ETH1.0 transaction by the validator.
 func NewValidatorAccount(directory string, password string) error {
 	// First, if the keystore already exists, throws an error as there can only be
-	// one keystore per validator client.
+	// one keystore per directory.
 	if err := VerifyAccountNotExists(directory, password); err != nil {
 		return fmt.Errorf("validator account exists: %v", err)
 	}
-	shardWithdrawalKeyFile := directory + params.BeaconConfig().WithdrawalPrivkeyFileName
-	validatorKeyFile := directory + params.BeaconConfig().ValidatorPrivkeyFileName
-	ks := keystore.NewKeystore(directory)
 	// If the keystore does not exists at the path, we create a new one for the validator.
 	shardWithdrawalKey, err := keystore.NewKey(rand.Reader)
 	if err != nil {
 		return err
 	}
+	validatorKey, err := keystore.NewKey(rand.Reader)
+	if err != nil {
+		return err
+	}
+	return storeValidatorAccount(directory, password, validatorKey, shardWithdrawalKey)
+}
+
+// NewMnemonicValidatorAccounts generates a new mnemonic, logs it so that it can be
+// backed up, and sets up the validator accounts with the given index range derived
+// from it, as in NewValidatorAccountsFromMnemonic.
+func NewMnemonicValidatorAccounts(
+	directory string,
+	password string,
+	passphrase string,
+	startIndex uint64,
+	numAccounts uint64,
+) error {
+	mnemonic, err := keystore.NewMnemonic(rand.Reader)
+	if err != nil {
+		return fmt.Errorf("could not generate mnemonic: %v", err)
+	}
+	log.Warn(`Write down the mnemonic shown below and keep it safe. Anyone with the mnemonic, and the mnemonic passphrase if one was set, can recover the keys of all your validator accounts`)
+	fmt.Printf(`
+=========================Mnemonic==========================
+
+%s
+
+===========================================================
+`, mnemonic)
+	return NewValidatorAccountsFromMnemonic(directory, password, mnemonic, passphrase, startIndex, numAccounts)
+}
+
+// NewValidatorAccountsFromMnemonic derives the validator and withdrawal keys of the accounts with
+// indices startIndex to startIndex+numAccounts-1 from a mnemonic and its passphrase, stores each
+// account in its own keystore at AccountDirectory, and logs the deposit data of each account.
+// The keys of an account only depend on the mnemonic, passphrase and index, so this also
+// recovers lost accounts.
+func NewValidatorAccountsFromMnemonic(
+	directory string,
+	password string,
+	mnemonic string,
+	passphrase string,
+	startIndex uint64,
+	numAccounts uint64,
+) error {
+	if numAccounts == 0 {
+		return errors.New("expected at least one account to be created")
+	}
+	if startIndex+numAccounts > maxAccountIndex+1 || startIndex+numAccounts < startIndex {
+		return fmt.Errorf("account indices must be at most %d", uint64(maxAccountIndex))
+	}
+	seed, err := keystore.SeedFromMnemonic(mnemonic, passphrase)
+	if err != nil {
+		return err
+	}
+	// Check every keystore first, so that no account is created if one of
+	// them already exists.
+	for i := startIndex; i < startIndex+numAccounts; i++ {
+		if err := VerifyAccountNotExists(AccountDirectory(directory, i), password); err != nil {
+			return fmt.Errorf("validator account %d exists: %v", i, err)
+		}
+	}
+	for i := startIndex; i < startIndex+numAccounts; i++ {
+		shardWithdrawalKey, err := keystore.NewKeyFromSeed(seed, fmt.Sprintf(keystore.WithdrawalKeyPath, i))
+		if err != nil {
+			return fmt.Errorf("could not derive withdrawal key of account %d: %v", i, err)
+		}
+		validatorKey, err := keystore.NewKeyFromSeed(seed, fmt.Sprintf(keystore.ValidatorKeyPath, i))
+		if err != nil {
+			return fmt.Errorf("could not derive validator key of account %d: %v", i, err)
+		}
+		log.WithField("index", i).Info("Setting up validator account")
+		if err := storeValidatorAccount(AccountDirectory(directory, i), password, validatorKey, shardWithdrawalKey); err != nil {
+			return fmt.Errorf("could not store validator account %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+// AccountDirectory returns the keystore directory of the validator account with the
+// given index derived from a mnemonic into directory.
+func AccountDirectory(directory string, index uint64) string {
+	return filepath.Join(directory, fmt.Sprintf("account-%d", index))
+}
+
+// KeystorePaths returns the directory if it is a validator keystore, or else the
+// subdirectories of the directory which are validator keystores, so that a single
+// validator client can validate with all the accounts created in the directory.
+func KeystorePaths(directory string) ([]string, error) {
+	if isKeystore(directory) {
+		return []string{directory}, nil
+	}
+	files, err := ioutil.ReadDir(directory)
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	for _, f := range files {
+		path := filepath.Join(directory, f.Name())
+		if f.IsDir() && isKeystore(path) {
+			paths = append(paths, path)
+		}
+	}
+	return paths, nil
+}
+
+// LoadKeys decrypts the validator key of the keystore at the keystore path or, if
+// there is none, the validator keys of the keystores in its subdirectories, such
+// as the accounts derived from a mnemonic.
+func LoadKeys(keystorePath string, password string) ([]*keystore.Key, error) {
+	paths, err := KeystorePaths(keystorePath)
+	if err != nil {
+		return nil, fmt.Errorf("could not find keystores: %v", err)
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no validator keystore found at %s", keystorePath)
+	}
+	keys := make([]*keystore.Key, len(paths))
+	errs := make([]error, len(paths))
+	var wg sync.WaitGroup
+	// Decrypting a key takes about a second and 256MB of memory, so the keys
+	// are decrypted concurrently, one per CPU.
+	sem := make(chan struct{}, runtime.NumCPU())
+	for i, path := range paths {
+		wg.Add(1)
+		go func(i int, path string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			ks := keystore.NewKeystore(path)
+			keys[i], errs[i] = ks.GetKey(path+params.BeaconConfig().ValidatorPrivkeyFileName, password)
+		}(i, path)
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if err != nil {
+			return nil, fmt.Errorf("could not get private key of keystore %s: %v", paths[i], err)
+		}
+	}
+	return keys, nil
+}
+
+func isKeystore(directory string) bool {
+	_, err := os.Stat(directory + params.BeaconConfig().ValidatorPrivkeyFileName)
+	return err == nil
+}
+
+// storeValidatorAccount stores the keys of a validator account in the keystore at directory
+// and logs the deposit data which activates it.
+func storeValidatorAccount(directory string, password string, validatorKey *keystore.Key, shardWithdrawalKey *keystore.Key) error {
+	shardWithdrawalKeyFile := directory + params.BeaconConfig().WithdrawalPrivkeyFileName
+	validatorKeyFile := directory + params.BeaconConfig().ValidatorPrivkeyFileName
+	ks := keystore.NewKeystore(directory)
 	if err := ks.StoreKey(shardWithdrawalKeyFile, shardWithdrawalKey, password); err != nil {
 		return fmt.Errorf("unable to store key %v", err)
 	}
@@ -59,10 +219,6 @@ func NewValidatorAccount(directory string, password string) error {
 		"path",
 		shardWithdrawalKeyFile,
 	).Info("Keystore generated for shard withdrawals at path")
-	validatorKey, err := keystore.NewKey(rand.Reader)
-	if err != nil {
-		return err
-	}
 	if err := ks.StoreKey(validatorKeyFile, validatorKey, password); err != nil {
 		return fmt.Errorf("unable to store key %v", err)
 	}
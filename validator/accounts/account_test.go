@@ -1,8 +1,12 @@
 package accounts
 
 import (
+	"bytes"
 	"crypto/rand"
+	"io/ioutil"
 	"os"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/shared/keystore"
@@ -28,3 +32,134 @@ func TestNewValidatorAccount_AccountExists(t *testing.T) {
 		t.Fatalf("Could not remove directory: %v", err)
 	}
 }
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func TestNewValidatorAccountsFromMnemonic_Recovers(t *testing.T) {
+	directory := testutil.TempDir() + "/testkeystore"
+	defer os.RemoveAll(directory)
+	recovered := testutil.TempDir() + "/testrecoveredkeystore"
+	defer os.RemoveAll(recovered)
+	password := "password"
+
+	if err := NewValidatorAccountsFromMnemonic(directory, password, testMnemonic, "passphrase", 3, 2); err != nil {
+		t.Fatalf("Could not create validator accounts: %v", err)
+	}
+	if err := NewValidatorAccountsFromMnemonic(recovered, password, testMnemonic, "passphrase", 4, 1); err != nil {
+		t.Fatalf("Could not recover validator account: %v", err)
+	}
+
+	for _, keyFile := range []string{
+		params.BeaconConfig().ValidatorPrivkeyFileName,
+		params.BeaconConfig().WithdrawalPrivkeyFileName,
+	} {
+		created, err := keystore.NewKeystore(directory).GetKey(AccountDirectory(directory, 4)+keyFile, password)
+		if err != nil {
+			t.Fatalf("Could not load created key: %v", err)
+		}
+		recoveredKey, err := keystore.NewKeystore(recovered).GetKey(AccountDirectory(recovered, 4)+keyFile, password)
+		if err != nil {
+			t.Fatalf("Could not load recovered key: %v", err)
+		}
+		if !bytes.Equal(created.SecretKey.Marshal(), recoveredKey.SecretKey.Marshal()) {
+			t.Errorf("Recovered key %s differs from the created one", keyFile)
+		}
+	}
+	if err := VerifyAccountNotExists(AccountDirectory(directory, 3), password); err == nil {
+		t.Error("Expected account 3 to be created")
+	}
+	if err := VerifyAccountNotExists(AccountDirectory(recovered, 3), password); err != nil {
+		t.Errorf("Expected account 3 not to be recovered: %v", err)
+	}
+}
+
+func TestNewValidatorAccountsFromMnemonic_AccountExists(t *testing.T) {
+	directory := testutil.TempDir() + "/testkeystore"
+	defer os.RemoveAll(directory)
+	password := "password"
+
+	if err := NewValidatorAccountsFromMnemonic(directory, password, testMnemonic, "", 1, 1); err != nil {
+		t.Fatalf("Could not create validator account: %v", err)
+	}
+	if err := NewValidatorAccountsFromMnemonic(directory, password, testMnemonic, "", 0, 2); err == nil {
+		t.Error("Expected an error for an existing account, received nil")
+	}
+	if err := VerifyAccountNotExists(AccountDirectory(directory, 0), password); err != nil {
+		t.Errorf("Expected no account to be created next to an existing one: %v", err)
+	}
+}
+
+func TestNewValidatorAccountsFromMnemonic_InvalidArguments(t *testing.T) {
+	directory := testutil.TempDir() + "/testkeystore"
+	defer os.RemoveAll(directory)
+
+	if err := NewValidatorAccountsFromMnemonic(directory, "password", "abandon abandon", "", 0, 1); err != keystore.ErrInvalidMnemonic {
+		t.Errorf("Expected %v, received %v", keystore.ErrInvalidMnemonic, err)
+	}
+	if err := NewValidatorAccountsFromMnemonic(directory, "password", testMnemonic, "", 0, 0); err == nil {
+		t.Error("Expected an error for no accounts, received nil")
+	}
+	if err := NewValidatorAccountsFromMnemonic(directory, "password", testMnemonic, "", 1<<32-1, 2); err == nil {
+		t.Error("Expected an error for an account index above the largest path index, received nil")
+	}
+}
+
+func TestKeystorePaths(t *testing.T) {
+	directory := testutil.TempDir() + "/testkeystores"
+	defer os.RemoveAll(directory)
+	for _, path := range []string{AccountDirectory(directory, 0), AccountDirectory(directory, 1), directory + "/other"} {
+		if err := os.MkdirAll(path, 0700); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, path := range []string{AccountDirectory(directory, 0), AccountDirectory(directory, 1)} {
+		if err := ioutil.WriteFile(path+params.BeaconConfig().ValidatorPrivkeyFileName, []byte{}, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	paths, err := KeystorePaths(directory)
+	if err != nil {
+		t.Fatalf("Could not find keystores: %v", err)
+	}
+	want := []string{AccountDirectory(directory, 0), AccountDirectory(directory, 1)}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("Unexpected keystore paths. want=%v got=%v", want, paths)
+	}
+
+	paths, err = KeystorePaths(AccountDirectory(directory, 1))
+	if err != nil {
+		t.Fatalf("Could not find keystores: %v", err)
+	}
+	if !reflect.DeepEqual(paths, []string{AccountDirectory(directory, 1)}) {
+		t.Errorf("Expected the keystore directory itself, received %v", paths)
+	}
+}
+
+func TestLoadKeys_KeystoreDirectory(t *testing.T) {
+	dir := testutil.TempDir() + "/keystores"
+	defer os.RemoveAll(dir)
+	for i := 0; i < 2; i++ {
+		if err := NewValidatorAccount(AccountDirectory(dir, uint64(i)), "1234"); err != nil {
+			t.Fatalf("Could not create validator account: %v", err)
+		}
+	}
+	keys, err := LoadKeys(dir, "1234")
+	if err != nil {
+		t.Fatalf("Could not load keys: %v", err)
+	}
+	if len(keys) != 2 {
+		t.Errorf("Expected 2 keys, received %d", len(keys))
+	}
+}
+
+func TestLoadKeys_NoKeystore(t *testing.T) {
+	dir := testutil.TempDir() + "/empty-keystores"
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if _, err := LoadKeys(dir, "1234"); err == nil || !strings.Contains(err.Error(), "no validator keystore found") {
+		t.Errorf("Expected no keystore error, received %v", err)
+	}
+}
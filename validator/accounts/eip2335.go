@@ -0,0 +1,139 @@
+package accounts
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// ExportKeystores writes the validator key of every keystore found at directory by
+// KeystorePaths to an EIP-2335 keystore in outputDirectory, encrypted with
+// exportPassword, so that the keys can be used by other clients and tools. It
+// returns the paths of the written files. Withdrawal keys are not exported.
+func ExportKeystores(directory string, password string, outputDirectory string, exportPassword string) ([]string, error) {
+	if password == "" || exportPassword == "" {
+		return nil, errors.New("expected a password for the keystores and the exported keystores, received nil")
+	}
+	paths, err := KeystorePaths(directory)
+	if err != nil {
+		return nil, fmt.Errorf("could not find keystores: %v", err)
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no validator keystore found at %s", directory)
+	}
+	if err := os.MkdirAll(outputDirectory, 0700); err != nil {
+		return nil, fmt.Errorf("could not create export directory: %v", err)
+	}
+	var files []string
+	for _, path := range paths {
+		ks := keystore.NewKeystore(path)
+		key, err := ks.GetKey(path+params.BeaconConfig().ValidatorPrivkeyFileName, password)
+		if err != nil {
+			return nil, fmt.Errorf("could not get private key of keystore %s: %v", path, err)
+		}
+		keyjson, err := keystore.EncryptKeyEIP2335(key, exportPassword, derivationPath(path), keystore.EIP2335KDFScrypt)
+		if err != nil {
+			return nil, fmt.Errorf("could not encrypt key of keystore %s: %v", path, err)
+		}
+		file := filepath.Join(outputDirectory, fmt.Sprintf("keystore-%x.json", key.PublicKey.Marshal()[:8]))
+		if _, err := os.Stat(file); err == nil {
+			return nil, fmt.Errorf("exported keystore already exists: %s", file)
+		}
+		if err := ioutil.WriteFile(file, keyjson, 0600); err != nil {
+			return nil, fmt.Errorf("could not write exported keystore: %v", err)
+		}
+		log.WithField("path", file).Info("Exported validator key")
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+// ImportKeystores stores the key of the EIP-2335 keystore at importPath, or of every
+// EIP-2335 keystore JSON file in the importPath directory, as the validator key of a
+// new keystore below directory, where KeystorePaths finds it. The imported keystores
+// are decrypted with importPassword and encrypted with password. An imported keystore
+// has no withdrawal key, so no deposit data is generated for it.
+func ImportKeystores(directory string, password string, importPath string, importPassword string) error {
+	if directory == "" || password == "" || importPassword == "" {
+		return errors.New("expected a path to the validator keystore and passwords to be provided, received nil")
+	}
+	if isKeystore(directory) {
+		return fmt.Errorf("%s is a validator keystore, expected a directory of keystores", directory)
+	}
+	files, err := eip2335Files(importPath)
+	if err != nil {
+		return err
+	}
+	// Decrypt every keystore first, so that nothing is imported if one of them
+	// cannot be read.
+	keys := make([]*keystore.Key, len(files))
+	for i, file := range files {
+		// #nosec G304
+		keyjson, err := ioutil.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("could not read keystore %s: %v", file, err)
+		}
+		keys[i], err = keystore.DecryptKeyEIP2335(keyjson, importPassword)
+		if err != nil {
+			return fmt.Errorf("could not decrypt keystore %s: %v", file, err)
+		}
+	}
+	for i, key := range keys {
+		keyDirectory := filepath.Join(directory, fmt.Sprintf("validator-%x", key.PublicKey.Marshal()[:8]))
+		if isKeystore(keyDirectory) {
+			return fmt.Errorf("validator key of keystore %s is already imported at %s", files[i], keyDirectory)
+		}
+		ks := keystore.NewKeystore(keyDirectory)
+		if err := ks.StoreKey(keyDirectory+params.BeaconConfig().ValidatorPrivkeyFileName, key, password); err != nil {
+			return fmt.Errorf("unable to store key %v", err)
+		}
+		log.WithField("path", keyDirectory).Info("Imported validator key")
+	}
+	return nil
+}
+
+// eip2335Files returns the path if it is a file, or else the JSON files in the
+// directory at path.
+func eip2335Files(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not find keystores to import: %v", err)
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+	files, err := ioutil.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	for _, f := range files {
+		if !f.IsDir() && strings.HasSuffix(f.Name(), ".json") {
+			paths = append(paths, filepath.Join(path, f.Name()))
+		}
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no keystore found to import at %s", path)
+	}
+	return paths, nil
+}
+
+// derivationPath returns the EIP-2334 derivation path of the validator key of an
+// account derived from a mnemonic into keystorePath, or an empty path for other
+// keystores.
+func derivationPath(keystorePath string) string {
+	var index uint64
+	if _, err := fmt.Sscanf(filepath.Base(keystorePath), "account-%d", &index); err != nil {
+		return ""
+	}
+	if AccountDirectory(filepath.Dir(keystorePath), index) != filepath.Clean(keystorePath) {
+		return ""
+	}
+	return fmt.Sprintf(keystore.ValidatorKeyPath, index)
+}
@@ -0,0 +1,103 @@
+package accounts
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestExportImportKeystores_RoundTrip(t *testing.T) {
+	directory := testutil.TempDir() + "/testkeystore"
+	defer os.RemoveAll(directory)
+	exported := testutil.TempDir() + "/testexportedkeystores"
+	defer os.RemoveAll(exported)
+	imported := testutil.TempDir() + "/testimportedkeystores"
+	defer os.RemoveAll(imported)
+
+	if err := NewValidatorAccount(directory, "password"); err != nil {
+		t.Fatalf("Could not create validator account: %v", err)
+	}
+	files, err := ExportKeystores(directory, "password", exported, "export password")
+	if err != nil {
+		t.Fatalf("Could not export keystores: %v", err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("Expected 1 exported keystore, received %d", len(files))
+	}
+	if err := ImportKeystores(imported, "new password", exported, "export password"); err != nil {
+		t.Fatalf("Could not import keystores: %v", err)
+	}
+
+	paths, err := KeystorePaths(imported)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) != 1 {
+		t.Fatalf("Expected 1 imported keystore, received %v", paths)
+	}
+	ks := keystore.NewKeystore(directory)
+	original, err := ks.GetKey(directory+params.BeaconConfig().ValidatorPrivkeyFileName, "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	importedKey, err := ks.GetKey(paths[0]+params.BeaconConfig().ValidatorPrivkeyFileName, "new password")
+	if err != nil {
+		t.Fatalf("Could not decrypt imported keystore: %v", err)
+	}
+	if !bytes.Equal(original.SecretKey.Marshal(), importedKey.SecretKey.Marshal()) {
+		t.Error("Imported key differs from the exported one")
+	}
+
+	if err := ImportKeystores(imported, "new password", files[0], "export password"); err == nil ||
+		!strings.Contains(err.Error(), "already imported") {
+		t.Errorf("Expected an already imported error, received %v", err)
+	}
+}
+
+func TestImportKeystores_WrongPassword(t *testing.T) {
+	exported := testutil.TempDir() + "/testwrongpasswordkeystores"
+	defer os.RemoveAll(exported)
+	imported := testutil.TempDir() + "/testwrongpasswordimported"
+	defer os.RemoveAll(imported)
+
+	key, err := keystore.NewKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyjson, err := keystore.EncryptKeyEIP2335(key, "password", "", keystore.EIP2335KDFPBKDF2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(exported, 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(exported, "keystore.json"), keyjson, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ImportKeystores(imported, "password", exported, "wrong"); err == nil {
+		t.Error("Expected import with a wrong password to fail, received nil")
+	}
+	if paths, _ := KeystorePaths(imported); len(paths) != 0 {
+		t.Errorf("Expected nothing to be imported, received %v", paths)
+	}
+}
+
+func TestDerivationPath(t *testing.T) {
+	if path := derivationPath(AccountDirectory("/keys", 3)); path != "m/12381/3600/3/0/0" {
+		t.Errorf("Unexpected derivation path %s", path)
+	}
+	if path := derivationPath("/keys/validator"); path != "" {
+		t.Errorf("Expected no derivation path, received %s", path)
+	}
+	if path := derivationPath("/keys/account-3x"); path != "" {
+		t.Errorf("Expected no derivation path, received %s", path)
+	}
+}
@@ -0,0 +1,137 @@
+package client
+
+// Validator client voluntary exit functions.
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	signerpb "github.com/prysmaticlabs/prysm/proto/signer/v1"
+	"github.com/prysmaticlabs/prysm/shared/forkutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/accounts"
+	"github.com/prysmaticlabs/prysm/validator/signer"
+	"github.com/sirupsen/logrus"
+)
+
+// ProposeExits submits a signed voluntary exit of each of the validators of the
+// public keys to the first beacon node of cfg.Endpoints which can be reached, signing with the same keys the
+// validator service would validate with. If no public keys are given, the signer
+// must hold a single key, which is exited. An exit cannot be undone.
+func ProposeExits(ctx context.Context, cfg *Config, pubKeys [][]byte) error {
+	nodes, err := dialBeaconNodes(ctx, cfg.Endpoints, cfg.CertFlag)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		for _, node := range nodes {
+			node.conn.Close()
+		}
+	}()
+
+	var s signer.Signer
+	if cfg.SignerEndpoint != "" {
+		signerConn, err := dialSigner(ctx, cfg.SignerEndpoint, cfg.SignerCertFlag, cfg.SignerClientCert, cfg.SignerClientKey)
+		if err != nil {
+			return fmt.Errorf("could not dial remote signer endpoint: %s, %v", cfg.SignerEndpoint, err)
+		}
+		defer signerConn.Close()
+		s, err = signer.NewRemoteSigner(ctx, signerpb.NewRemoteSignerClient(signerConn))
+		if err != nil {
+			return fmt.Errorf("could not connect to remote signer: %v", err)
+		}
+	} else {
+		keys := cfg.Keys
+		if len(keys) == 0 {
+			keys, err = accounts.LoadKeys(cfg.KeystorePath, cfg.Password)
+			if err != nil {
+				return err
+			}
+		}
+		// Signing an exit cannot be slashed, so it needs no signing history.
+		s = signer.NewLocalSigner(keys, cfg.DB)
+	}
+
+	if len(pubKeys) == 0 {
+		if len(s.PublicKeys()) != 1 {
+			return errors.New("more than one validator key, expected the public keys of the validators to exit")
+		}
+		pubKeys = s.PublicKeys()
+	}
+	for _, pubKey := range pubKeys {
+		if !hasKey(s.PublicKeys(), pubKey) {
+			return fmt.Errorf("no validator key with public key %#x", pubKey)
+		}
+	}
+
+	v := newValidator(s)
+	v.nodes = nodes
+	v.connectBeaconNode(ctx)
+	for _, pubKey := range pubKeys {
+		if err := v.proposeExit(ctx, pubKey); err != nil {
+			return fmt.Errorf("could not exit validator %#x: %v", pubKey, err)
+		}
+	}
+	return nil
+}
+
+// proposeExit signs a voluntary exit of the validator of the public key at the
+// epoch of the beacon node's head, and submits it to the beacon node, which
+// broadcasts it to the network for a proposer to include in a block.
+func (v *validator) proposeExit(ctx context.Context, pubKey []byte) error {
+	indexRes, err := v.validatorClient.ValidatorIndex(ctx, &pb.ValidatorIndexRequest{PublicKey: pubKey})
+	if err != nil {
+		return fmt.Errorf("could not fetch validator index: %v", err)
+	}
+	head, err := v.beaconClient.CanonicalHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		return fmt.Errorf("could not fetch canonical head: %v", err)
+	}
+	fork, err := v.beaconClient.ForkData(ctx, &ptypes.Empty{})
+	if err != nil {
+		return fmt.Errorf("could not fetch fork data: %v", err)
+	}
+	// The exit may not be included before its epoch, so it is signed at the epoch
+	// of the head, which the beacon node's state has reached.
+	epoch := head.Slot / params.BeaconConfig().SlotsPerEpoch
+	exit := &pbp2p.VoluntaryExit{
+		Epoch:          epoch,
+		ValidatorIndex: indexRes.Index,
+	}
+	// signature = bls_sign(
+	//   privkey=validator.privkey,
+	//   message_hash=signed_root(exit),
+	//   domain=get_domain(fork, exit.epoch, DOMAIN_EXIT),
+	// )
+	fork = forkutil.ScheduledFork(fork, epoch)
+	domain := forkutil.DomainVersion(fork, epoch, params.BeaconConfig().DomainExit)
+	exit.Signature, err = v.signer.SignExit(ctx, pubKey, exit, domain)
+	if err != nil {
+		return fmt.Errorf("could not sign exit: %v", err)
+	}
+	res, err := v.validatorClient.ProposeExit(ctx, exit)
+	if err != nil {
+		return fmt.Errorf("could not submit exit to beacon node: %v", err)
+	}
+	log.WithFields(logrus.Fields{
+		"publicKey":      fmt.Sprintf("%#x", pubKey),
+		"validatorIndex": exit.ValidatorIndex,
+		"epoch":          exit.Epoch - params.BeaconConfig().GenesisEpoch,
+		"hash":           fmt.Sprintf("%#x", res.ExitHash),
+	}).Info("Submitted voluntary exit")
+	return nil
+}
+
+func hasKey(pubKeys [][]byte, pubKey []byte) bool {
+	for _, k := range pubKeys {
+		if bytes.Equal(k, pubKey) {
+			return true
+		}
+	}
+	return false
+}
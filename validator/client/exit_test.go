@@ -0,0 +1,115 @@
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"strings"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/golang/mock/gomock"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/forkutil"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/ssz"
+	"google.golang.org/grpc"
+)
+
+func TestProposeExit_SignsAndSubmitsExit(t *testing.T) {
+	validator, m, finish := setup(t)
+	defer finish()
+	pubKey := validatorKey.PublicKey.Marshal()
+
+	m.validatorClient.EXPECT().ValidatorIndex(
+		gomock.Any(), // ctx
+		&pb.ValidatorIndexRequest{PublicKey: pubKey},
+	).Return(&pb.ValidatorIndexResponse{Index: 5}, nil)
+
+	m.beaconClient.EXPECT().CanonicalHead(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot + 2*params.BeaconConfig().SlotsPerEpoch}, nil /*err*/)
+
+	fork := &pbp2p.Fork{
+		Epoch:           params.BeaconConfig().GenesisEpoch,
+		CurrentVersion:  0,
+		PreviousVersion: 0,
+	}
+	m.beaconClient.EXPECT().ForkData(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(fork, nil /*err*/)
+
+	var submitted *pbp2p.VoluntaryExit
+	m.validatorClient.EXPECT().ProposeExit(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pbp2p.VoluntaryExit{}),
+	).Do(func(_ context.Context, exit *pbp2p.VoluntaryExit, _ ...grpc.CallOption) {
+		submitted = exit
+	}).Return(&pb.ProposeExitResponse{}, nil /*err*/)
+
+	if err := validator.proposeExit(context.Background(), pubKey); err != nil {
+		t.Fatalf("Could not propose exit: %v", err)
+	}
+	wantEpoch := params.BeaconConfig().GenesisEpoch + 2
+	if submitted.ValidatorIndex != 5 || submitted.Epoch != wantEpoch {
+		t.Errorf("Expected exit of validator 5 at epoch %d, received %v", wantEpoch, submitted)
+	}
+	root, err := ssz.SigningRoot(submitted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := bls.SignatureFromBytes(submitted.Signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := forkutil.DomainVersion(fork, wantEpoch, params.BeaconConfig().DomainExit)
+	if !sig.Verify(root[:], validatorKey.PublicKey, domain) {
+		t.Error("Exit signature does not verify")
+	}
+}
+
+func TestProposeExit_ValidatorIndexFailure(t *testing.T) {
+	validator, m, finish := setup(t)
+	defer finish()
+
+	m.validatorClient.EXPECT().ValidatorIndex(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pb.ValidatorIndexRequest{}),
+	).Return(nil, errors.New("unknown validator"))
+
+	want := "could not fetch validator index"
+	if err := validator.proposeExit(context.Background(), validatorKey.PublicKey.Marshal()); err == nil ||
+		!strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+}
+
+func TestProposeExits_ExpectsPublicKeysOfManyKeys(t *testing.T) {
+	other, err := keystore.NewKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := &Config{
+		Endpoints: []string{"127.0.0.1:0"},
+		Keys:      []*keystore.Key{validatorKey, other},
+	}
+	want := "expected the public keys of the validators to exit"
+	if err := ProposeExits(context.Background(), cfg, nil); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+
+	unknown, err := keystore.NewKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = "no validator key with public key"
+	if err := ProposeExits(context.Background(), cfg, [][]byte{unknown.PublicKey.Marshal()}); err == nil ||
+		!strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+}
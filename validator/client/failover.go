@@ -0,0 +1,175 @@
+package client
+
+// Validator client beacon node failover functions.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+var (
+	activeBeaconNode = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "validator_active_beacon_node",
+		Help: "Set to 1 for the beacon node endpoint the validator client performs its duties through, 0 for the others",
+	}, []string{"endpoint"})
+	beaconNodeFailovers = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "validator_beacon_node_failovers",
+		Help: "The number of times the validator client failed over to another beacon node",
+	})
+)
+
+// maxHeadLag is the number of slots the head of a beacon node may lag behind the
+// current slot before the beacon node is considered stale.
+var maxHeadLag = params.BeaconConfig().SlotsPerEpoch
+
+// healthCheckTimeout bounds the time a health check may take, so that checking an
+// unresponsive beacon node does not delay the duties of the slot.
+var healthCheckTimeout = 2 * time.Second
+
+// beaconNode is the connection to one of the beacon nodes the validator client can
+// perform its duties through.
+type beaconNode struct {
+	endpoint        string
+	conn            *grpc.ClientConn
+	beaconClient    pb.BeaconServiceClient
+	validatorClient pb.ValidatorServiceClient
+	attesterClient  pb.AttesterServiceClient
+	proposerClient  pb.ProposerServiceClient
+}
+
+// dialBeaconNodes opens a gRPC connection to each of the beacon node endpoints. The
+// connections are established lazily, so an endpoint which is down does not fail
+// the dial.
+func dialBeaconNodes(ctx context.Context, endpoints []string, withCert string) ([]*beaconNode, error) {
+	if len(endpoints) == 0 {
+		return nil, errors.New("expected at least one beacon node endpoint")
+	}
+	nodes := make([]*beaconNode, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		conn, err := dial(ctx, endpoint, withCert)
+		if err != nil {
+			for _, node := range nodes {
+				node.conn.Close()
+			}
+			return nil, fmt.Errorf("could not dial endpoint: %s, %v", endpoint, err)
+		}
+		nodes = append(nodes, &beaconNode{
+			endpoint:        endpoint,
+			conn:            conn,
+			beaconClient:    pb.NewBeaconServiceClient(conn),
+			validatorClient: pb.NewValidatorServiceClient(conn),
+			attesterClient:  pb.NewAttesterServiceClient(conn),
+			proposerClient:  pb.NewProposerServiceClient(conn),
+		})
+	}
+	return nodes, nil
+}
+
+// checkHealth returns an error if the beacon node cannot be reached, is syncing, or
+// if its head lags more than maxHeadLag slots behind the slot.
+func (n *beaconNode) checkHealth(ctx context.Context, slot uint64) error {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+	res, err := n.beaconClient.SyncStatus(ctx, &ptypes.Empty{})
+	if err != nil {
+		return fmt.Errorf("could not get sync status: %v", err)
+	}
+	if res.Syncing {
+		return errors.New("beacon node is syncing")
+	}
+	if res.HeadSlot+maxHeadLag < slot {
+		return fmt.Errorf("head is %d slots behind the current slot", slot-res.HeadSlot)
+	}
+	return nil
+}
+
+// useBeaconNode makes the validator perform its duties through the beacon node at
+// the index of the configured beacon nodes.
+func (v *validator) useBeaconNode(i int) {
+	v.activeNode = i
+	node := v.nodes[i]
+	v.beaconClient = node.beaconClient
+	v.validatorClient = node.validatorClient
+	v.attesterClient = node.attesterClient
+	v.proposerClient = node.proposerClient
+	for j, n := range v.nodes {
+		if j == i {
+			activeBeaconNode.WithLabelValues(n.endpoint).Set(1)
+		} else {
+			activeBeaconNode.WithLabelValues(n.endpoint).Set(0)
+		}
+	}
+}
+
+// connectBeaconNode chooses the beacon node the validator starts with, the first of
+// the configured beacon nodes which can be reached. Until the chain starts, a beacon
+// node cannot be expected to be synced, so only whether it can be reached is checked.
+func (v *validator) connectBeaconNode(ctx context.Context) {
+	for i, node := range v.nodes {
+		checkCtx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+		_, err := node.beaconClient.SyncStatus(checkCtx, &ptypes.Empty{})
+		cancel()
+		if err != nil {
+			log.WithField("endpoint", node.endpoint).Warnf("Could not reach beacon node: %v", err)
+			continue
+		}
+		v.useBeaconNode(i)
+		log.WithField("endpoint", node.endpoint).Info("Connected to beacon node")
+		return
+	}
+	v.useBeaconNode(0)
+	log.WithField("endpoint", v.nodes[0].endpoint).Warn("Could not reach any beacon node, waiting for the first one")
+}
+
+// SelectBeaconNode checks the health of the beacon node the validator performs its
+// duties through at the start of the slot. If the beacon node cannot be reached, is
+// syncing or is stale, the validator fails over to the first healthy beacon node of
+// the others, in the order they were configured. A healthy beacon node is kept, even
+// if it was not configured first, so that the validator does not switch back and forth.
+//
+// The beacon node is only switched between the duties of two slots, so a duty is never
+// performed through two beacon nodes. All the beacon nodes share the signer, whose
+// slashing protection refuses to sign anything conflicting with what any of them was
+// given, so failing over cannot get a key slashed.
+func (v *validator) SelectBeaconNode(ctx context.Context, slot uint64) error {
+	if len(v.nodes) < 2 {
+		return nil
+	}
+	active := v.nodes[v.activeNode]
+	err := active.checkHealth(ctx, slot)
+	if err == nil {
+		return nil
+	}
+	log.WithField("endpoint", active.endpoint).Warnf("Beacon node is unhealthy: %v", err)
+	for i, node := range v.nodes {
+		if i == v.activeNode {
+			continue
+		}
+		if err := node.checkHealth(ctx, slot); err != nil {
+			log.WithField("endpoint", node.endpoint).Debugf("Beacon node is unhealthy: %v", err)
+			continue
+		}
+		v.useBeaconNode(i)
+		beaconNodeFailovers.Inc()
+		if v.stopDuties != nil {
+			// Stream the duties from the new beacon node.
+			v.StreamDuties(ctx)
+		}
+		log.WithFields(logrus.Fields{
+			"from": active.endpoint,
+			"to":   node.endpoint,
+		}).Info("Failed over to another beacon node")
+		return nil
+	}
+	return fmt.Errorf("no healthy beacon node to fail over to from %s: %v", active.endpoint, err)
+}
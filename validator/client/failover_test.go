@@ -0,0 +1,124 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/golang/mock/gomock"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/internal"
+	"github.com/prysmaticlabs/prysm/validator/signer"
+)
+
+func failoverValidator(t *testing.T, endpoints ...string) (*validator, []*internal.MockBeaconServiceClient, func()) {
+	ctrl := gomock.NewController(t)
+	v := newValidator(signer.NewLocalSigner([]*keystore.Key{validatorKey}, nil))
+	var clients []*internal.MockBeaconServiceClient
+	for _, endpoint := range endpoints {
+		client := internal.NewMockBeaconServiceClient(ctrl)
+		clients = append(clients, client)
+		v.nodes = append(v.nodes, &beaconNode{
+			endpoint:        endpoint,
+			beaconClient:    client,
+			validatorClient: internal.NewMockValidatorServiceClient(ctrl),
+			attesterClient:  internal.NewMockAttesterServiceClient(ctrl),
+			proposerClient:  internal.NewMockProposerServiceClient(ctrl),
+		})
+	}
+	v.useBeaconNode(0)
+	return v, clients, ctrl.Finish
+}
+
+func TestSelectBeaconNode_KeepsHealthyNode(t *testing.T) {
+	v, clients, finish := failoverValidator(t, "a", "b")
+	defer finish()
+	slot := params.BeaconConfig().GenesisSlot + 10
+
+	clients[0].EXPECT().SyncStatus(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.SyncStatusResponse{HeadSlot: slot - 1}, nil)
+
+	if err := v.SelectBeaconNode(context.Background(), slot); err != nil {
+		t.Fatalf("Could not select beacon node: %v", err)
+	}
+	if v.activeNode != 0 {
+		t.Errorf("Expected to keep beacon node 0, switched to %d", v.activeNode)
+	}
+}
+
+func TestSelectBeaconNode_FailsOverFromSyncingNode(t *testing.T) {
+	v, clients, finish := failoverValidator(t, "a", "b", "c")
+	defer finish()
+	slot := params.BeaconConfig().GenesisSlot + 10
+
+	clients[0].EXPECT().SyncStatus(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.SyncStatusResponse{Syncing: true, HeadSlot: slot - 1}, nil)
+	clients[1].EXPECT().SyncStatus(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(nil, errors.New("connection refused"))
+	clients[2].EXPECT().SyncStatus(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.SyncStatusResponse{HeadSlot: slot}, nil)
+
+	if err := v.SelectBeaconNode(context.Background(), slot); err != nil {
+		t.Fatalf("Could not select beacon node: %v", err)
+	}
+	if v.activeNode != 2 {
+		t.Fatalf("Expected to fail over to beacon node 2, using %d", v.activeNode)
+	}
+	if v.beaconClient != v.nodes[2].beaconClient || v.proposerClient != v.nodes[2].proposerClient {
+		t.Error("Expected the duties to be performed through the clients of beacon node 2")
+	}
+}
+
+func TestSelectBeaconNode_NoHealthyNode(t *testing.T) {
+	v, clients, finish := failoverValidator(t, "a", "b")
+	defer finish()
+	slot := params.BeaconConfig().GenesisSlot + 2*maxHeadLag
+
+	// Both beacon nodes are stale.
+	for _, client := range clients {
+		client.EXPECT().SyncStatus(
+			gomock.Any(), // ctx
+			gomock.Eq(&ptypes.Empty{}),
+		).Return(&pb.SyncStatusResponse{HeadSlot: params.BeaconConfig().GenesisSlot}, nil)
+	}
+
+	want := "no healthy beacon node"
+	if err := v.SelectBeaconNode(context.Background(), slot); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+	if v.activeNode != 0 {
+		t.Errorf("Expected to keep beacon node 0, switched to %d", v.activeNode)
+	}
+}
+
+func TestConnectBeaconNode_SkipsUnreachableNode(t *testing.T) {
+	v, clients, finish := failoverValidator(t, "a", "b")
+	defer finish()
+
+	clients[0].EXPECT().SyncStatus(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(nil, errors.New("connection refused"))
+	// A beacon node which is syncing before the chain starts can still be connected to.
+	clients[1].EXPECT().SyncStatus(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.SyncStatusResponse{Syncing: true}, nil)
+
+	v.connectBeaconNode(context.Background())
+	if v.activeNode != 1 {
+		t.Errorf("Expected to connect to beacon node 1, connected to %d", v.activeNode)
+	}
+}
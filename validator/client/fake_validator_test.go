@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"sync"
 
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 )
@@ -12,18 +13,32 @@ type fakeValidator struct {
 	DoneCalled              bool
 	WaitForActivationCalled bool
 	WaitForChainStartCalled bool
+	StreamDutiesCalled      bool
 	NextSlotRet             <-chan uint64
 	NextSlotCalled          bool
+	SelectBeaconNodeCalled  bool
+	SelectBeaconNodeArg1    uint64
+	WatchDoppelgangerCalled bool
+	WatchDoppelgangerArg1   uint64
+	WatchDoppelgangerRet    bool
 	UpdateAssignmentsCalled bool
 	UpdateAssignmentsArg1   uint64
 	UpdateAssignmentsRet    error
-	RoleAtCalled            bool
-	RoleAtArg1              uint64
-	RoleAtRet               pb.ValidatorRole
+	RolesAtCalled           bool
+	RolesAtArg1             uint64
+	RolesAtRet              map[string]pb.ValidatorRole
 	AttestToBlockHeadCalled bool
 	AttestToBlockHeadArg1   uint64
+	AttestToBlockHeadKeys   []string
 	ProposeBlockCalled      bool
 	ProposeBlockArg1        uint64
+	ProposeBlockKeys        []string
+	AggregateCalled         bool
+	AggregateArg1           uint64
+	AggregateKeys           []string
+	UpdatePerformanceCalled bool
+	UpdatePerformanceArg1   uint64
+	lock                    sync.Mutex
 }
 
 func (fv *fakeValidator) Done() {
@@ -40,29 +55,65 @@ func (fv *fakeValidator) WaitForActivation(_ context.Context) error {
 	return nil
 }
 
+func (fv *fakeValidator) StreamDuties(_ context.Context) {
+	fv.StreamDutiesCalled = true
+}
+
 func (fv *fakeValidator) NextSlot() <-chan uint64 {
 	fv.NextSlotCalled = true
 	return fv.NextSlotRet
 }
 
+func (fv *fakeValidator) SelectBeaconNode(_ context.Context, slot uint64) error {
+	fv.SelectBeaconNodeCalled = true
+	fv.SelectBeaconNodeArg1 = slot
+	return nil
+}
+
+func (fv *fakeValidator) WatchDoppelganger(_ context.Context, slot uint64) (bool, error) {
+	fv.WatchDoppelgangerCalled = true
+	fv.WatchDoppelgangerArg1 = slot
+	return fv.WatchDoppelgangerRet, nil
+}
+
 func (fv *fakeValidator) UpdateAssignments(_ context.Context, slot uint64) error {
 	fv.UpdateAssignmentsCalled = true
 	fv.UpdateAssignmentsArg1 = slot
 	return fv.UpdateAssignmentsRet
 }
 
-func (fv *fakeValidator) RoleAt(slot uint64) pb.ValidatorRole {
-	fv.RoleAtCalled = true
-	fv.RoleAtArg1 = slot
-	return fv.RoleAtRet
+func (fv *fakeValidator) RolesAt(slot uint64) map[string]pb.ValidatorRole {
+	fv.RolesAtCalled = true
+	fv.RolesAtArg1 = slot
+	return fv.RolesAtRet
 }
 
-func (fv *fakeValidator) AttestToBlockHead(_ context.Context, slot uint64) {
+func (fv *fakeValidator) AttestToBlockHead(_ context.Context, slot uint64, pubKey string) {
+	fv.lock.Lock()
+	defer fv.lock.Unlock()
 	fv.AttestToBlockHeadCalled = true
 	fv.AttestToBlockHeadArg1 = slot
+	fv.AttestToBlockHeadKeys = append(fv.AttestToBlockHeadKeys, pubKey)
 }
 
-func (fv *fakeValidator) ProposeBlock(_ context.Context, slot uint64) {
+func (fv *fakeValidator) ProposeBlock(_ context.Context, slot uint64, pubKey string) {
+	fv.lock.Lock()
+	defer fv.lock.Unlock()
 	fv.ProposeBlockCalled = true
 	fv.ProposeBlockArg1 = slot
+	fv.ProposeBlockKeys = append(fv.ProposeBlockKeys, pubKey)
+}
+
+func (fv *fakeValidator) AggregateAttestations(_ context.Context, slot uint64, pubKey string) {
+	fv.lock.Lock()
+	defer fv.lock.Unlock()
+	fv.AggregateCalled = true
+	fv.AggregateArg1 = slot
+	fv.AggregateKeys = append(fv.AggregateKeys, pubKey)
+}
+
+func (fv *fakeValidator) UpdatePerformance(_ context.Context, slot uint64) error {
+	fv.UpdatePerformanceCalled = true
+	fv.UpdatePerformanceArg1 = slot
+	return nil
 }
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"sync"
 
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -14,11 +15,16 @@ type Validator interface {
 	Done()
 	WaitForChainStart(ctx context.Context) error
 	WaitForActivation(ctx context.Context) error
+	StreamDuties(ctx context.Context)
 	NextSlot() <-chan uint64
+	SelectBeaconNode(ctx context.Context, slot uint64) error
+	WatchDoppelganger(ctx context.Context, slot uint64) (bool, error)
 	UpdateAssignments(ctx context.Context, slot uint64) error
-	RoleAt(slot uint64) pb.ValidatorRole
-	AttestToBlockHead(ctx context.Context, slot uint64)
-	ProposeBlock(ctx context.Context, slot uint64)
+	RolesAt(slot uint64) map[string]pb.ValidatorRole
+	AttestToBlockHead(ctx context.Context, slot uint64, pubKey string)
+	ProposeBlock(ctx context.Context, slot uint64, pubKey string)
+	AggregateAttestations(ctx context.Context, slot uint64, pubKey string)
+	UpdatePerformance(ctx context.Context, slot uint64) error
 }
 
 // Run the main validator routine. This routine exits if the context is
@@ -27,10 +33,15 @@ type Validator interface {
 // Order of operations:
 // 1 - Initialize validator data
 // 2 - Wait for validator activation
-// 3 - Wait for the next slot start
-// 4 - Update assignments
-// 5 - Determine role at current slot
-// 6 - Perform assigned role, if any
+// 3 - Stream the assignments of the validator keys in the background
+// 4 - Wait for the next slot start
+// 5 - Fail over to another beacon node if the current one is unhealthy
+// 6 - Watch the network for the validator keys running elsewhere before performing duties
+// 7 - Update assignments
+// 8 - Determine the roles of the validator keys at current slot
+// 9 - Perform the assigned roles, if any, concurrently
+// 10 - Aggregate the attestations of the committees the validator keys are selected to aggregate
+// 11 - Report the performance of the validator keys at the end of an epoch
 func run(ctx context.Context, v Validator) {
 	defer v.Done()
 	if err := v.WaitForChainStart(ctx); err != nil {
@@ -39,6 +50,7 @@ func run(ctx context.Context, v Validator) {
 	if err := v.WaitForActivation(ctx); err != nil {
 		log.Fatalf("Could not wait for validator activation: %v", err)
 	}
+	v.StreamDuties(ctx)
 	if err := v.UpdateAssignments(ctx, params.BeaconConfig().GenesisSlot); err != nil {
 		log.WithField("error", err).Error("Failed to update assignments")
 	}
@@ -53,28 +65,54 @@ func run(ctx context.Context, v Validator) {
 		case slot := <-v.NextSlot():
 			span.AddAttributes(trace.Int64Attribute("slot", int64(slot)))
 
+			// Performing the duties through an unhealthy beacon node is still
+			// better than missing them.
+			if err := v.SelectBeaconNode(ctx, slot); err != nil {
+				log.WithField("error", err).Error("Failed to select a healthy beacon node")
+			}
+			// Signing with keys which are running elsewhere gets them slashed.
+			watching, err := v.WatchDoppelganger(ctx, slot)
+			if err != nil {
+				log.Fatalf("Refusing to perform duties: %v", err)
+			}
+			if watching {
+				continue
+			}
 			if err := v.UpdateAssignments(ctx, slot); err != nil {
 				log.WithField("error", err).Error("Failed to update assignments")
 				continue
 			}
-			role := v.RoleAt(slot)
-
-			switch role {
-			case pb.ValidatorRole_BOTH:
-				v.ProposeBlock(ctx, slot)
-				v.AttestToBlockHead(ctx, slot)
-			case pb.ValidatorRole_ATTESTER:
-				v.AttestToBlockHead(ctx, slot)
-			case pb.ValidatorRole_PROPOSER:
-				v.ProposeBlock(ctx, slot)
-			case pb.ValidatorRole_UNKNOWN:
-				// This shouldn't happen normally, so it is considered a warning.
-				log.WithFields(logrus.Fields{
-					"slot": slot - params.BeaconConfig().GenesisSlot,
-					"role": role,
-				}).Info("Unknown role, doing nothing")
-			default:
-				// Do nothing :)
+			var wg sync.WaitGroup
+			for pubKey, role := range v.RolesAt(slot) {
+				wg.Add(1)
+				go func(role pb.ValidatorRole, pubKey string) {
+					defer wg.Done()
+					switch role {
+					case pb.ValidatorRole_BOTH:
+						v.ProposeBlock(ctx, slot, pubKey)
+						v.AttestToBlockHead(ctx, slot, pubKey)
+						v.AggregateAttestations(ctx, slot, pubKey)
+					case pb.ValidatorRole_ATTESTER:
+						v.AttestToBlockHead(ctx, slot, pubKey)
+						v.AggregateAttestations(ctx, slot, pubKey)
+					case pb.ValidatorRole_PROPOSER:
+						v.ProposeBlock(ctx, slot, pubKey)
+					case pb.ValidatorRole_UNKNOWN:
+						// This shouldn't happen normally, so it is considered a warning.
+						log.WithFields(logrus.Fields{
+							"slot":      slot - params.BeaconConfig().GenesisSlot,
+							"role":      role,
+							"publicKey": pubKey,
+						}).Info("Unknown role, doing nothing")
+					default:
+						// Do nothing :)
+					}
+				}(role, pubKey)
+			}
+			// Wait for the duties of all the keys before waiting for the next slot.
+			wg.Wait()
+			if err := v.UpdatePerformance(ctx, slot); err != nil {
+				log.WithField("error", err).Error("Failed to update validator performance")
 			}
 		}
 	}
@@ -3,6 +3,8 @@ package client
 import (
 	"context"
 	"errors"
+	"reflect"
+	"sort"
 	"testing"
 
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
@@ -40,6 +42,14 @@ func TestCancelledContext_WaitsForActivation(t *testing.T) {
 	}
 }
 
+func TestCancelledContext_StreamsDuties(t *testing.T) {
+	v := &fakeValidator{}
+	run(cancelledContext(), v)
+	if !v.StreamDutiesCalled {
+		t.Error("Expected StreamDuties() to be called")
+	}
+}
+
 func TestUpdateAssignments_NextSlot(t *testing.T) {
 	v := &fakeValidator{}
 	ctx, cancel := context.WithCancel(context.Background())
@@ -63,6 +73,78 @@ func TestUpdateAssignments_NextSlot(t *testing.T) {
 	}
 }
 
+func TestUpdatePerformance_NextSlot(t *testing.T) {
+	v := &fakeValidator{}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	slot := uint64(55)
+	ticker := make(chan uint64)
+	v.NextSlotRet = ticker
+	go func() {
+		ticker <- slot
+
+		cancel()
+	}()
+
+	run(ctx, v)
+
+	if !v.UpdatePerformanceCalled {
+		t.Fatalf("Expected UpdatePerformance(%d) to be called", slot)
+	}
+	if v.UpdatePerformanceArg1 != slot {
+		t.Errorf("UpdatePerformance was called with wrong argument. Want=%d, got=%d", slot, v.UpdatePerformanceArg1)
+	}
+}
+
+func TestWatchDoppelganger_SkipsDutiesWhileWatching(t *testing.T) {
+	v := &fakeValidator{WatchDoppelgangerRet: true}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	slot := uint64(55)
+	ticker := make(chan uint64)
+	v.NextSlotRet = ticker
+	go func() {
+		ticker <- slot
+
+		cancel()
+	}()
+
+	run(ctx, v)
+
+	if !v.WatchDoppelgangerCalled {
+		t.Fatalf("Expected WatchDoppelganger(%d) to be called", slot)
+	}
+	if v.WatchDoppelgangerArg1 != slot {
+		t.Errorf("WatchDoppelganger was called with wrong argument. Want=%d, got=%d", slot, v.WatchDoppelgangerArg1)
+	}
+	if v.UpdateAssignmentsArg1 == slot {
+		t.Error("Expected no duties to be performed while watching for the validator keys")
+	}
+}
+
+func TestSelectBeaconNode_NextSlot(t *testing.T) {
+	v := &fakeValidator{}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	slot := uint64(55)
+	ticker := make(chan uint64)
+	v.NextSlotRet = ticker
+	go func() {
+		ticker <- slot
+
+		cancel()
+	}()
+
+	run(ctx, v)
+
+	if !v.SelectBeaconNodeCalled {
+		t.Fatalf("Expected SelectBeaconNode(%d) to be called", slot)
+	}
+	if v.SelectBeaconNodeArg1 != slot {
+		t.Errorf("SelectBeaconNode was called with wrong argument. Want=%d, got=%d", slot, v.SelectBeaconNodeArg1)
+	}
+}
+
 func TestUpdateAssignments_HandlesError(t *testing.T) {
 	hook := logTest.NewGlobal()
 	v := &fakeValidator{}
@@ -83,7 +165,7 @@ func TestUpdateAssignments_HandlesError(t *testing.T) {
 	testutil.AssertLogsContain(t, hook, "Failed to update assignments")
 }
 
-func TestRoleAt_NextSlot(t *testing.T) {
+func TestRolesAt_NextSlot(t *testing.T) {
 	v := &fakeValidator{}
 	ctx, cancel := context.WithCancel(context.Background())
 
@@ -98,11 +180,11 @@ func TestRoleAt_NextSlot(t *testing.T) {
 
 	run(ctx, v)
 
-	if !v.RoleAtCalled {
-		t.Fatalf("Expected RoleAt(%d) to be called", slot)
+	if !v.RolesAtCalled {
+		t.Fatalf("Expected RolesAt(%d) to be called", slot)
 	}
-	if v.RoleAtArg1 != slot {
-		t.Errorf("RoleAt called with the wrong arg. Want=%d, got=%d", slot, v.RoleAtArg1)
+	if v.RolesAtArg1 != slot {
+		t.Errorf("RolesAt called with the wrong arg. Want=%d, got=%d", slot, v.RolesAtArg1)
 	}
 }
 
@@ -113,7 +195,7 @@ func TestAttests_NextSlot(t *testing.T) {
 	slot := uint64(55)
 	ticker := make(chan uint64)
 	v.NextSlotRet = ticker
-	v.RoleAtRet = pb.ValidatorRole_ATTESTER
+	v.RolesAtRet = map[string]pb.ValidatorRole{"a": pb.ValidatorRole_ATTESTER}
 	go func() {
 		ticker <- slot
 
@@ -128,6 +210,12 @@ func TestAttests_NextSlot(t *testing.T) {
 	if v.AttestToBlockHeadArg1 != slot {
 		t.Errorf("AttestToBlockHead was called with wrong arg. Want=%d, got=%d", slot, v.AttestToBlockHeadArg1)
 	}
+	if !v.AggregateCalled {
+		t.Fatalf("AggregateAttestations(%d) was not called", slot)
+	}
+	if v.AggregateArg1 != slot {
+		t.Errorf("AggregateAttestations was called with wrong arg. Want=%d, got=%d", slot, v.AggregateArg1)
+	}
 }
 
 func TestProposes_NextSlot(t *testing.T) {
@@ -137,7 +225,7 @@ func TestProposes_NextSlot(t *testing.T) {
 	slot := uint64(55)
 	ticker := make(chan uint64)
 	v.NextSlotRet = ticker
-	v.RoleAtRet = pb.ValidatorRole_PROPOSER
+	v.RolesAtRet = map[string]pb.ValidatorRole{"a": pb.ValidatorRole_PROPOSER}
 	go func() {
 		ticker <- slot
 
@@ -161,7 +249,7 @@ func TestBothProposesAndAttests_NextSlot(t *testing.T) {
 	slot := uint64(55)
 	ticker := make(chan uint64)
 	v.NextSlotRet = ticker
-	v.RoleAtRet = pb.ValidatorRole_BOTH
+	v.RolesAtRet = map[string]pb.ValidatorRole{"a": pb.ValidatorRole_BOTH}
 	go func() {
 		ticker <- slot
 
@@ -183,3 +271,36 @@ func TestBothProposesAndAttests_NextSlot(t *testing.T) {
 		t.Errorf("ProposeBlock was called with wrong arg. Want=%d, got=%d", slot, v.AttestToBlockHeadArg1)
 	}
 }
+
+func TestAllKeysPerformDuties_NextSlot(t *testing.T) {
+	v := &fakeValidator{}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	slot := uint64(55)
+	ticker := make(chan uint64)
+	v.NextSlotRet = ticker
+	v.RolesAtRet = map[string]pb.ValidatorRole{
+		"a": pb.ValidatorRole_ATTESTER,
+		"b": pb.ValidatorRole_ATTESTER,
+		"c": pb.ValidatorRole_PROPOSER,
+	}
+	go func() {
+		ticker <- slot
+
+		cancel()
+	}()
+
+	run(ctx, v)
+
+	sort.Strings(v.AttestToBlockHeadKeys)
+	if !reflect.DeepEqual(v.AttestToBlockHeadKeys, []string{"a", "b"}) {
+		t.Errorf("AttestToBlockHead was called with wrong keys. Want=%v, got=%v", []string{"a", "b"}, v.AttestToBlockHeadKeys)
+	}
+	sort.Strings(v.AggregateKeys)
+	if !reflect.DeepEqual(v.AggregateKeys, []string{"a", "b"}) {
+		t.Errorf("AggregateAttestations was called with wrong keys. Want=%v, got=%v", []string{"a", "b"}, v.AggregateKeys)
+	}
+	if !reflect.DeepEqual(v.ProposeBlockKeys, []string{"c"}) {
+		t.Errorf("ProposeBlock was called with wrong keys. Want=%v, got=%v", []string{"c"}, v.ProposeBlockKeys)
+	}
+}
@@ -2,12 +2,17 @@ package client
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
+	"io/ioutil"
 
-	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	signerpb "github.com/prysmaticlabs/prysm/proto/signer/v1"
 	"github.com/prysmaticlabs/prysm/shared/keystore"
-	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/accounts"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/signer"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/plugin/ocgrpc"
 	"google.golang.org/grpc"
@@ -19,73 +24,117 @@ var log = logrus.WithField("prefix", "validator")
 // ValidatorService represents a service to manage the validator client
 // routine.
 type ValidatorService struct {
-	ctx       context.Context
-	cancel    context.CancelFunc
-	validator Validator
-	conn      *grpc.ClientConn
-	endpoint  string
-	withCert  string
-	key       *keystore.Key
+	ctx                context.Context
+	cancel             context.CancelFunc
+	validator          Validator
+	nodes              []*beaconNode
+	endpoints          []string
+	withCert           string
+	signer             signer.Signer
+	signerConn         *grpc.ClientConn
+	signerEndpoint     string
+	signerCert         string
+	signerClientCert   string
+	signerClientKey    string
+	doppelgangerEpochs uint64
 }
 
-// Config for the validator service.
+// Config for the validator service. If Keys is empty, the service validates
+// with the key stored in the keystore at KeystorePath, or with the keys of all
+// the keystores in the subdirectories of KeystorePath. The history of what the keys
+// signed is kept in DB, which protects them from being slashed. If SignerEndpoint
+// is set, the service instead validates with the keys of the remote signer at the
+// endpoint, which keeps the history of the keys itself. The service authenticates to
+// the remote signer with the TLS client certificate SignerClientCert and its key
+// SignerClientKey. The service performs its duties through the first of the beacon
+// nodes at Endpoints which can be reached, failing over to the others whenever the
+// one it uses is unhealthy. Before performing any duty, the service watches the
+// network for DoppelgangerEpochs epochs and refuses to sign with the keys if they are
+// seen attesting or proposing, as they are then running elsewhere.
 type Config struct {
-	Endpoint     string
-	CertFlag     string
-	KeystorePath string
-	Password     string
+	Endpoints          []string
+	CertFlag           string
+	KeystorePath       string
+	Password           string
+	Keys               []*keystore.Key
+	DB                 *db.ValidatorDB
+	SignerEndpoint     string
+	SignerCertFlag     string
+	SignerClientCert   string
+	SignerClientKey    string
+	DoppelgangerEpochs uint64
 }
 
 // NewValidatorService creates a new validator service for the service
 // registry.
 func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, error) {
 	ctx, cancel := context.WithCancel(ctx)
-	validatorKeyFile := cfg.KeystorePath + params.BeaconConfig().ValidatorPrivkeyFileName
-	ks := keystore.NewKeystore(cfg.KeystorePath)
-	key, err := ks.GetKey(validatorKeyFile, cfg.Password)
-	if err != nil {
-		return nil, fmt.Errorf("could not get private key: %v", err)
+	v := &ValidatorService{
+		ctx:                ctx,
+		cancel:             cancel,
+		endpoints:          cfg.Endpoints,
+		withCert:           cfg.CertFlag,
+		signerEndpoint:     cfg.SignerEndpoint,
+		signerCert:         cfg.SignerCertFlag,
+		signerClientCert:   cfg.SignerClientCert,
+		signerClientKey:    cfg.SignerClientKey,
+		doppelgangerEpochs: cfg.DoppelgangerEpochs,
+	}
+	// The keys of a remote signer are listed once the service is started.
+	if cfg.SignerEndpoint != "" {
+		return v, nil
+	}
+	if cfg.DB == nil {
+		cancel()
+		return nil, errors.New("expected a validator database for slashing protection, received nil")
+	}
+	keys := cfg.Keys
+	if len(keys) == 0 {
+		var err error
+		keys, err = accounts.LoadKeys(cfg.KeystorePath, cfg.Password)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
 	}
-	return &ValidatorService{
-		ctx:      ctx,
-		cancel:   cancel,
-		endpoint: cfg.Endpoint,
-		withCert: cfg.CertFlag,
-		key:      key,
-	}, nil
+	v.signer = signer.NewLocalSigner(keys, cfg.DB)
+	return v, nil
 }
 
 // Start the validator service. Launches the main go routine for the validator
 // client.
 func (v *ValidatorService) Start() {
-	log.WithField("publicKey", fmt.Sprintf("%#x", v.key.PublicKey.Marshal())).Info("Initializing new validator service")
-
-	var dialOpt grpc.DialOption
-	if v.withCert != "" {
-		creds, err := credentials.NewClientTLSFromFile(v.withCert, "")
-		if err != nil {
-			log.Errorf("Could not get valid credentials: %v", err)
-			return
-		}
-		dialOpt = grpc.WithTransportCredentials(creds)
-	} else {
-		dialOpt = grpc.WithInsecure()
-		log.Warn("You are using an insecure gRPC connection! Please provide a certificate and key to use a secure connection.")
-	}
-	conn, err := grpc.DialContext(v.ctx, v.endpoint, dialOpt, grpc.WithStatsHandler(&ocgrpc.ClientHandler{}))
+	nodes, err := dialBeaconNodes(v.ctx, v.endpoints, v.withCert)
 	if err != nil {
-		log.Errorf("Could not dial endpoint: %s, %v", v.endpoint, err)
+		log.Error(err)
 		return
 	}
 	log.Info("Successfully started gRPC connection")
-	v.conn = conn
-	v.validator = &validator{
-		beaconClient:    pb.NewBeaconServiceClient(v.conn),
-		validatorClient: pb.NewValidatorServiceClient(v.conn),
-		attesterClient:  pb.NewAttesterServiceClient(v.conn),
-		proposerClient:  pb.NewProposerServiceClient(v.conn),
-		key:             v.key,
+	v.nodes = nodes
+	if v.signerEndpoint != "" {
+		signerConn, err := dialSigner(v.ctx, v.signerEndpoint, v.signerCert, v.signerClientCert, v.signerClientKey)
+		if err != nil {
+			log.Errorf("Could not dial remote signer endpoint: %s, %v", v.signerEndpoint, err)
+			return
+		}
+		v.signerConn = signerConn
+		remoteSigner, err := signer.NewRemoteSigner(v.ctx, signerpb.NewRemoteSignerClient(signerConn))
+		if err != nil {
+			log.Errorf("Could not connect to remote signer: %v", err)
+			return
+		}
+		log.WithField("endpoint", v.signerEndpoint).Info("Signing with the keys of the remote signer")
+		v.signer = remoteSigner
+	}
+	for _, pubKey := range v.signer.PublicKeys() {
+		log.WithField("publicKey", fmt.Sprintf("%#x", pubKey)).Info("Initializing new validator service")
 	}
+	// A single validator routine performs the duties of all the keys.
+	val := newValidator(v.signer)
+	val.nodes = v.nodes
+	val.doppelganger.epochs = v.doppelgangerEpochs
+	val.connectBeaconNode(v.ctx)
+	v.validator = val
 	go run(v.ctx, v.validator)
 }
 
@@ -93,18 +142,79 @@ func (v *ValidatorService) Start() {
 func (v *ValidatorService) Stop() error {
 	v.cancel()
 	log.Info("Stopping service")
-	if v.conn != nil {
-		return v.conn.Close()
+	if v.signerConn != nil {
+		if err := v.signerConn.Close(); err != nil {
+			log.Errorf("Could not close remote signer connection: %v", err)
+		}
 	}
-	return nil
+	var err error
+	for _, node := range v.nodes {
+		if closeErr := node.conn.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}
+	return err
 }
 
 // Status ...
 //
 // WIP - not done.
 func (v *ValidatorService) Status() error {
-	if v.conn == nil {
+	if len(v.nodes) == 0 {
 		return errors.New("no connection to beacon RPC")
 	}
 	return nil
 }
+
+// dial opens a gRPC connection to the endpoint, secured with the TLS certificate if
+// one is given.
+func dial(ctx context.Context, endpoint string, withCert string) (*grpc.ClientConn, error) {
+	var dialOpt grpc.DialOption
+	if withCert != "" {
+		creds, err := credentials.NewClientTLSFromFile(withCert, "")
+		if err != nil {
+			return nil, fmt.Errorf("could not get valid credentials: %v", err)
+		}
+		dialOpt = grpc.WithTransportCredentials(creds)
+	} else {
+		dialOpt = grpc.WithInsecure()
+		log.Warn("You are using an insecure gRPC connection! Please provide a certificate and key to use a secure connection.")
+	}
+	return grpc.DialContext(ctx, endpoint, dialOpt, grpc.WithStatsHandler(&ocgrpc.ClientHandler{}))
+}
+
+// dialSigner opens a gRPC connection to the remote signer, which only serves validator
+// clients that authenticate with a TLS client certificate. The certificate of the remote
+// signer is verified against withCert, or against the root certificates of the host if
+// withCert is empty. Without a client certificate, the connection is opened as with dial,
+// which only a remote signer started with -insecure accepts.
+func dialSigner(ctx context.Context, endpoint string, withCert string, clientCert string, clientKey string) (*grpc.ClientConn, error) {
+	if clientCert == "" && clientKey == "" {
+		log.Warn("You are connecting to the remote signer without a client certificate! Please provide one to authenticate with the remote signer.")
+		return dial(ctx, endpoint, withCert)
+	}
+	if clientCert == "" || clientKey == "" {
+		return nil, errors.New("expected both a client certificate and its key for the remote signer")
+	}
+	cert, err := tls.LoadX509KeyPair(clientCert, clientKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not load client certificate: %v", err)
+	}
+	tlsConfig := &tls.Config{Certificates: []tls.Certificate{cert}}
+	if withCert != "" {
+		pem, err := ioutil.ReadFile(withCert)
+		if err != nil {
+			return nil, fmt.Errorf("could not read certificate of the remote signer: %v", err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no valid certificate in %s", withCert)
+		}
+	}
+	return grpc.DialContext(
+		ctx,
+		endpoint,
+		grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+		grpc.WithStatsHandler(&ocgrpc.ClientHandler{}),
+	)
+}
@@ -2,27 +2,44 @@ package client
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
+	signerpb "github.com/prysmaticlabs/prysm/proto/signer/v1"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/keystore"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/validator/accounts"
+	"github.com/prysmaticlabs/prysm/validator/signer"
 	logTest "github.com/sirupsen/logrus/hooks/test"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 var _ = shared.Service(&ValidatorService{})
 var validatorKey *keystore.Key
+var validatorPubKey string
 
 func TestMain(m *testing.M) {
 	dir := testutil.TempDir() + "/keystore1"
 	defer os.RemoveAll(dir)
 	accounts.NewValidatorAccount(dir, "1234")
 	validatorKey, _ = keystore.NewKey(rand.Reader)
+	validatorPubKey = hex.EncodeToString(validatorKey.PublicKey.Marshal())
 	os.Exit(m.Run())
 }
 
@@ -50,11 +67,11 @@ func TestLifecycle(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel()
 	validatorService := &ValidatorService{
-		ctx:      ctx,
-		cancel:   cancel,
-		endpoint: "merkle tries",
-		withCert: "alice.crt",
-		key:      validatorKey,
+		ctx:       ctx,
+		cancel:    cancel,
+		endpoints: []string{"merkle tries"},
+		withCert:  "alice.crt",
+		signer:    signer.NewLocalSigner([]*keystore.Key{validatorKey}, nil),
 	}
 	validatorService.Start()
 	if err := validatorService.Stop(); err != nil {
@@ -69,10 +86,10 @@ func TestLifecycle_Insecure(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel()
 	validatorService := &ValidatorService{
-		ctx:      ctx,
-		cancel:   cancel,
-		endpoint: "merkle tries",
-		key:      validatorKey,
+		ctx:       ctx,
+		cancel:    cancel,
+		endpoints: []string{"merkle tries"},
+		signer:    signer.NewLocalSigner([]*keystore.Key{validatorKey}, nil),
 	}
 	validatorService.Start()
 	testutil.AssertLogsContain(t, hook, "You are using an insecure gRPC connection")
@@ -88,3 +105,116 @@ func TestStatus_NoConnectionError(t *testing.T) {
 		t.Errorf("Expected status check to fail if no connection is found, received: %v", err)
 	}
 }
+
+// writeCert writes a PEM encoded certificate for 127.0.0.1 and its key to dir,
+// signed by the CA of caCert and caKey, or self-signed if caCert is nil.
+func writeCert(t *testing.T, dir string, name string, caCert *x509.Certificate, caKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  caCert == nil,
+	}
+	parent, parentKey := template, key
+	if caCert != nil {
+		parent, parentKey = caCert, caKey
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	if err := ioutil.WriteFile(filepath.Join(dir, name+".crt"), certPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, name+".key"), keyPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key
+}
+
+func TestDialSigner_MutualTLS(t *testing.T) {
+	dir, err := ioutil.TempDir("", "remote-signer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	caCert, caKey := writeCert(t, dir, "ca", nil, nil)
+	writeCert(t, dir, "signer", caCert, caKey)
+	writeCert(t, dir, "client", caCert, caKey)
+	otherCA, otherKey := writeCert(t, dir, "other-ca", nil, nil)
+	writeCert(t, dir, "other-client", otherCA, otherKey)
+
+	// The remote signer requires client certificates signed by its CA.
+	serverCert, err := tls.LoadX509KeyPair(filepath.Join(dir, "signer.crt"), filepath.Join(dir, "signer.key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(caCert)
+	s := grpc.NewServer(grpc.Creds(credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientCAs,
+	})))
+	signerpb.RegisterRemoteSignerServer(s, signer.NewServer(signer.NewLocalSigner([]*keystore.Key{validatorKey}, nil)))
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go s.Serve(lis)
+	defer s.Stop()
+
+	tests := []struct {
+		name       string
+		clientCert string
+		clientKey  string
+		valid      bool
+	}{
+		{name: "client certificate", clientCert: "client.crt", clientKey: "client.key", valid: true},
+		{name: "no client certificate"},
+		{name: "client certificate of another CA", clientCert: "other-client.crt", clientKey: "other-client.key"},
+	}
+	for _, tt := range tests {
+		var clientCert, clientKey string
+		if tt.clientCert != "" {
+			clientCert, clientKey = filepath.Join(dir, tt.clientCert), filepath.Join(dir, tt.clientKey)
+		}
+		conn, err := dialSigner(context.Background(), lis.Addr().String(), filepath.Join(dir, "ca.crt"), clientCert, clientKey)
+		if err != nil {
+			t.Fatalf("%s: could not dial remote signer: %v", tt.name, err)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		remoteSigner, err := signer.NewRemoteSigner(ctx, signerpb.NewRemoteSignerClient(conn))
+		cancel()
+		conn.Close()
+		if tt.valid && (err != nil || len(remoteSigner.PublicKeys()) != 1) {
+			t.Errorf("%s: expected to list the keys of the remote signer, received %v", tt.name, err)
+		}
+		if !tt.valid && err == nil {
+			t.Errorf("%s: expected the remote signer to refuse the connection", tt.name)
+		}
+	}
+
+	if _, err := dialSigner(context.Background(), lis.Addr().String(), "", filepath.Join(dir, "client.crt"), ""); err == nil {
+		t.Error("Expected an error for a client certificate without its key")
+	}
+}
@@ -3,16 +3,18 @@ package client
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"io"
+	"sync"
 	"time"
 
 	ptypes "github.com/gogo/protobuf/types"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
-	"github.com/prysmaticlabs/prysm/shared/keystore"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/prysmaticlabs/prysm/validator/signer"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -29,16 +31,47 @@ type AttestationPool interface {
 type validator struct {
 	genesisTime     uint64
 	ticker          *slotutil.SlotTicker
-	assignment      *pb.CommitteeAssignmentResponse
+	assignments     *pb.CommitteeAssignmentResponse
 	proposerClient  pb.ProposerServiceClient
 	validatorClient pb.ValidatorServiceClient
 	beaconClient    pb.BeaconServiceClient
 	attesterClient  pb.AttesterServiceClient
-	key             *keystore.Key
+	nodes           []*beaconNode // Beacon nodes to fail over between, whose clients are set above.
+	activeNode      int
+	signer          signer.Signer
+	keys            map[string][]byte // Public keys of the signer, keyed by their hex encoding.
+	pubkeys         [][]byte
+	attestedLock    sync.Mutex
+	attested        map[string]*pbp2p.AttestationData // Data of the latest attestation of each key.
+	dutiesLock      sync.Mutex
+	duties          map[uint64]*pb.CommitteeAssignmentResponse // Streamed assignments, keyed by epoch.
+	stopDuties      context.CancelFunc                         // Stops the duties stream, if it was started.
+	reportedEpoch   uint64                                     // Latest epoch the performance of the keys was reported on.
+	doppelganger    doppelgangerWatch
+}
+
+// newValidator returns a validator which performs the duties of all the keys of
+// the signer.
+func newValidator(s signer.Signer) *validator {
+	pubkeys := s.PublicKeys()
+	v := &validator{
+		signer:   s,
+		keys:     make(map[string][]byte, len(pubkeys)),
+		pubkeys:  pubkeys,
+		attested: make(map[string]*pbp2p.AttestationData, len(pubkeys)),
+		duties:   make(map[uint64]*pb.CommitteeAssignmentResponse),
+	}
+	for _, pubKey := range pubkeys {
+		v.keys[hex.EncodeToString(pubKey)] = pubKey
+	}
+	return v
 }
 
 // Done cleans up the validator.
 func (v *validator) Done() {
+	if v.stopDuties != nil {
+		v.stopDuties()
+	}
 	v.ticker.Done()
 }
 
@@ -78,22 +111,22 @@ func (v *validator) WaitForChainStart(ctx context.Context) error {
 	return nil
 }
 
-// WaitForActivation checks whether the validator pubkey is in the active
-// validator set. If not, this operation will block until an activation message is
-// received.
+// WaitForActivation checks whether any of the validator pubkeys are in the validator
+// registry. If not, this operation will block until an activation message is received.
+// Validators activated later get their assignments as soon as they are active.
 func (v *validator) WaitForActivation(ctx context.Context) error {
 	ctx, span := trace.StartSpan(ctx, "validator.WaitForActivation")
 	defer span.End()
 	req := &pb.ValidatorActivationRequest{
-		Pubkey: v.key.PublicKey.Marshal(),
+		PublicKeys: v.pubkeys,
 	}
 	stream, err := v.validatorClient.WaitForActivation(ctx, req)
 	if err != nil {
 		return fmt.Errorf("could not setup validator WaitForActivation streaming client: %v", err)
 	}
-	var validatorActivatedRecord *pbp2p.Validator
+	var activatedValidators []*pbp2p.Validator
 	for {
-		log.Info("Waiting for validator to be activated in the beacon chain")
+		log.WithField("numKeys", len(v.pubkeys)).Info("Waiting for validators to be activated in the beacon chain")
 		res, err := stream.Recv()
 		// If the stream is closed, we stop the loop.
 		if err == io.EOF {
@@ -106,12 +139,15 @@ func (v *validator) WaitForActivation(ctx context.Context) error {
 		if err != nil {
 			return fmt.Errorf("could not receive validator activation from stream: %v", err)
 		}
-		validatorActivatedRecord = res.Validator
+		activatedValidators = res.Validators
 		break
 	}
-	log.WithFields(logrus.Fields{
-		"activationEpoch": validatorActivatedRecord.ActivationEpoch - params.BeaconConfig().GenesisEpoch,
-	}).Info("Validator activated")
+	for _, activated := range activatedValidators {
+		log.WithFields(logrus.Fields{
+			"publicKey":       fmt.Sprintf("%#x", activated.Pubkey),
+			"activationEpoch": activated.ActivationEpoch - params.BeaconConfig().GenesisEpoch,
+		}).Info("Validator activated")
+	}
 	return nil
 }
 
@@ -120,11 +156,20 @@ func (v *validator) NextSlot() <-chan uint64 {
 	return v.ticker.C()
 }
 
-// UpdateAssignments checks the slot number to determine if the validator's
+// UpdateAssignments checks the slot number to determine if the validators'
 // list of upcoming assignments needs to be updated. For example, at the
-// beginning of a new epoch.
+// beginning of a new epoch. The assignments streamed by the beacon node for the
+// epoch of the slot are used when there are any, as they are kept up to date
+// through reorgs; otherwise the assignments of all keys are fetched at once.
 func (v *validator) UpdateAssignments(ctx context.Context, slot uint64) error {
-	if slot%params.BeaconConfig().SlotsPerEpoch != 0 && v.assignment != nil {
+	if duties := v.streamedDuties(slot / params.BeaconConfig().SlotsPerEpoch); duties != nil {
+		if duties != v.assignments {
+			v.assignments = duties
+			v.logAssignments(duties)
+		}
+		return nil
+	}
+	if slot%params.BeaconConfig().SlotsPerEpoch != 0 && v.assignments != nil {
 		// Do nothing if not epoch start AND assignments already exist.
 		return nil
 	}
@@ -134,53 +179,85 @@ func (v *validator) UpdateAssignments(ctx context.Context, slot uint64) error {
 
 	req := &pb.ValidatorEpochAssignmentsRequest{
 		EpochStart: slot,
-		PublicKey:  v.key.PublicKey.Marshal(),
+		PublicKeys: v.pubkeys,
 	}
 
 	resp, err := v.validatorClient.CommitteeAssignment(ctx, req)
 	if err != nil {
-		v.assignment = nil // Clear assignments so we know to retry the request.
+		v.assignments = nil // Clear assignments so we know to retry the request.
 		return err
 	}
 
-	v.assignment = resp
+	v.assignments = resp
+	v.logAssignments(resp)
+	return nil
+}
 
-	var proposerSlot uint64
-	var attesterSlot uint64
-	if v.assignment.IsProposer && len(v.assignment.Committee) == 1 {
-		proposerSlot = resp.Slot
-		attesterSlot = resp.Slot
-	} else if v.assignment.IsProposer {
-		proposerSlot = resp.Slot
-	} else {
-		attesterSlot = resp.Slot
-	}
+// logAssignments logs the assignments of the validator keys.
+func (v *validator) logAssignments(resp *pb.CommitteeAssignmentResponse) {
+	for _, assignment := range resp.Assignment {
+		var proposerSlot uint64
+		var attesterSlot uint64
+		if assignment.IsProposer && len(assignment.Committee) == 1 {
+			proposerSlot = assignment.Slot
+			attesterSlot = assignment.Slot
+		} else if assignment.IsProposer {
+			proposerSlot = assignment.Slot
+		} else {
+			attesterSlot = assignment.Slot
+		}
 
-	log.WithFields(logrus.Fields{
-		"proposerSlot": proposerSlot - params.BeaconConfig().GenesisSlot,
-		"attesterSlot": attesterSlot - params.BeaconConfig().GenesisSlot,
-		"shard":        resp.Shard,
-	}).Info("Updated validator assignments")
-	return nil
+		log.WithFields(logrus.Fields{
+			"publicKey":    fmt.Sprintf("%#x", assignment.PublicKey),
+			"proposerSlot": proposerSlot - params.BeaconConfig().GenesisSlot,
+			"attesterSlot": attesterSlot - params.BeaconConfig().GenesisSlot,
+			"shard":        assignment.Shard,
+		}).Info("Updated validator assignments")
+	}
+	if unassigned := len(v.pubkeys) - len(resp.Assignment); unassigned > 0 {
+		log.WithField(
+			"numKeys", unassigned,
+		).Info("Validators without an assignment, as they are not active yet")
+	}
 }
 
-// RoleAt slot returns the validator role at the given slot. Returns nil if the
-// validator is known to not have a role at the at slot. Returns UNKNOWN if the
-// validator assignments are unknown. Otherwise returns a valid ValidatorRole.
-func (v *validator) RoleAt(slot uint64) pb.ValidatorRole {
-	if v.assignment == nil {
-		return pb.ValidatorRole_UNKNOWN
+// RolesAt slot returns the roles of the validators which have a duty at the given
+// slot, keyed by their hex encoded public key. Validators without an assignment at
+// the slot are left out.
+func (v *validator) RolesAt(slot uint64) map[string]pb.ValidatorRole {
+	roles := make(map[string]pb.ValidatorRole)
+	if v.assignments == nil {
+		return roles
 	}
-	if v.assignment.Slot == slot {
+	for _, assignment := range v.assignments.Assignment {
+		if assignment.Slot != slot {
+			continue
+		}
+		var role pb.ValidatorRole
 		// if the committee length is 1, that means validator has to perform both
 		// proposer and validator roles.
-		if len(v.assignment.Committee) == 1 {
-			return pb.ValidatorRole_BOTH
-		} else if v.assignment.IsProposer {
-			return pb.ValidatorRole_PROPOSER
+		if len(assignment.Committee) == 1 {
+			role = pb.ValidatorRole_BOTH
+		} else if assignment.IsProposer {
+			role = pb.ValidatorRole_PROPOSER
 		} else {
-			return pb.ValidatorRole_ATTESTER
+			role = pb.ValidatorRole_ATTESTER
+		}
+		roles[hex.EncodeToString(assignment.PublicKey)] = role
+	}
+	return roles
+}
+
+// assignment returns the current assignment of the validator with the hex encoded
+// public key, or nil if it has none.
+func (v *validator) assignment(pubKey string) *pb.CommitteeAssignmentResponse_CommitteeAssignment {
+	if v.assignments == nil {
+		return nil
+	}
+	for _, assignment := range v.assignments.Assignment {
+		if hex.EncodeToString(assignment.PublicKey) == pubKey {
+			return assignment
 		}
 	}
-	return pb.ValidatorRole_UNKNOWN
+	return nil
 }
@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/attestations"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bitutil"
+	"github.com/prysmaticlabs/prysm/shared/forkutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+var aggregationDelay = 2 * params.BeaconConfig().SecondsPerSlot / 3
+
+// AggregateAttestations completes the aggregator responsibility of the key of the hex
+// encoded public key at a given slot. The signature of the slot, the selection proof,
+// selects about TARGET_AGGREGATORS_PER_COMMITTEE members of each committee as its
+// aggregators. A selected member waits for the attestations of its committee to reach
+// the beacon node, combines the attestations which have the data it attested to, and
+// publishes the aggregate along with its selection proof for proposers to include.
+func (v *validator) AggregateAttestations(ctx context.Context, slot uint64, pubKey string) {
+	ctx, span := trace.StartSpan(ctx, "validator.AggregateAttestations")
+	defer span.End()
+	log := log.WithField("publicKey", pubKey)
+	key, ok := v.keys[pubKey]
+	if !ok {
+		log.Error("No key to aggregate with")
+		return
+	}
+	assignment := v.assignment(pubKey)
+	if assignment == nil {
+		log.Errorf("No committee assignment to aggregate at slot %d", slot-params.BeaconConfig().GenesisSlot)
+		return
+	}
+	v.attestedLock.Lock()
+	data := v.attested[pubKey]
+	v.attestedLock.Unlock()
+	if data == nil || data.Slot != slot {
+		log.Debugf("No attestation at slot %d to aggregate", slot-params.BeaconConfig().GenesisSlot)
+		return
+	}
+
+	// selection_proof = bls_sign(
+	//   privkey=validator.privkey,
+	//   message_hash=int_to_bytes32(slot),
+	//   domain=get_domain(fork, slot_to_epoch(slot), DOMAIN_SELECTION_PROOF),
+	// )
+	fork, err := v.beaconClient.ForkData(ctx, &ptypes.Empty{})
+	if err != nil {
+		log.Errorf("Failed to get fork data from beacon node's state: %v", err)
+		return
+	}
+	epoch := slot / params.BeaconConfig().SlotsPerEpoch
+	fork = forkutil.ScheduledFork(fork, epoch)
+	domain := forkutil.DomainVersion(fork, epoch, params.BeaconConfig().DomainSelectionProof)
+	proof, err := v.signer.SignSelectionProof(ctx, key, slot, domain)
+	if err != nil {
+		log.Errorf("Could not sign selection proof: %v", err)
+		return
+	}
+	if !attestations.IsAggregator(uint64(len(assignment.Committee)), proof) {
+		return
+	}
+
+	// Attestations are broadcast halfway through the slot, so the aggregator waits
+	// until two thirds of the slot for the attestations of its committee.
+	duration := time.Duration(slot*params.BeaconConfig().SecondsPerSlot+aggregationDelay) * time.Second
+	timeToAggregate := time.Unix(int64(v.genesisTime), 0).Add(duration)
+	_, sleepSpan := trace.StartSpan(ctx, "validator.AggregateAttestations_sleepUntilTimeToAggregate")
+	time.Sleep(time.Until(timeToAggregate))
+	sleepSpan.End()
+
+	res, err := v.attesterClient.AttestationsToAggregate(ctx, data)
+	if err != nil {
+		log.Errorf("Could not fetch attestations to aggregate: %v", err)
+		return
+	}
+	aggregates, err := attestations.AggregateAttestations(res.Attestations)
+	if err != nil {
+		log.Errorf("Could not aggregate attestations: %v", err)
+		return
+	}
+	// Attestations which overlap end up in separate aggregates, of which the one with
+	// the most participants is published.
+	var aggregate *pbp2p.Attestation
+	for _, agg := range aggregates {
+		if aggregate == nil || bitutil.BitSetCount(agg.AggregationBitfield) > bitutil.BitSetCount(aggregate.AggregationBitfield) {
+			aggregate = agg
+		}
+	}
+	if aggregate == nil {
+		log.Warnf("No attestations to aggregate at slot %d", slot-params.BeaconConfig().GenesisSlot)
+		return
+	}
+
+	aggregateRes, err := v.attesterClient.SubmitAggregateAndProof(ctx, &pbp2p.AggregateAndProof{
+		AggregatorIndex: assignment.ValidatorIndex,
+		Aggregate:       aggregate,
+		SelectionProof:  proof,
+	})
+	if err != nil {
+		log.Errorf("Could not submit aggregate to beacon node: %v", err)
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"slot":         slot - params.BeaconConfig().GenesisSlot,
+		"shard":        data.Shard,
+		"participants": bitutil.BitSetCount(aggregate.AggregationBitfield),
+		"hash":         fmt.Sprintf("%#x", aggregateRes.AttestationHash),
+	}).Info("Submitted aggregate attestation")
+}
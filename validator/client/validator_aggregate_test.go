@@ -0,0 +1,114 @@
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/golang/mock/gomock"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/forkutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/ssz"
+	"google.golang.org/grpc"
+)
+
+func TestAggregateAttestations_SubmitsLargestAggregate(t *testing.T) {
+	validator, m, finish := setup(t)
+	defer finish()
+	slot := uint64(30)
+	validator.assignments = &pb.CommitteeAssignmentResponse{
+		Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
+			{
+				PublicKey:      validatorKey.PublicKey.Marshal(),
+				Shard:          5,
+				Committee:      []uint64{0, 1, 2, 3},
+				ValidatorIndex: 2,
+			},
+		},
+	}
+	data := &pbp2p.AttestationData{Slot: slot, Shard: 5}
+	validator.attested[validatorPubKey] = data
+
+	fork := &pbp2p.Fork{}
+	m.beaconClient.EXPECT().ForkData(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(fork, nil /*err*/)
+
+	msg, err := ssz.TreeHash(&pbp2p.AttestationDataAndCustodyBit{Data: data, CustodyBit: false})
+	if err != nil {
+		t.Fatal(err)
+	}
+	attDomain := forkutil.DomainVersion(fork, slot/params.BeaconConfig().SlotsPerEpoch, params.BeaconConfig().DomainAttestation)
+	var atts []*pbp2p.Attestation
+	for _, bitfield := range []byte{0x80, 0x80, 0x40, 0x20} {
+		priv, err := bls.RandKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		atts = append(atts, &pbp2p.Attestation{
+			Data:                data,
+			AggregationBitfield: []byte{bitfield},
+			CustodyBitfield:     []byte{0},
+			AggregateSignature:  priv.Sign(msg[:], attDomain).Marshal(),
+		})
+	}
+	m.attesterClient.EXPECT().AttestationsToAggregate(
+		gomock.Any(), // ctx
+		gomock.Eq(data),
+	).Return(&pb.AttestationsToAggregateResponse{Attestations: atts}, nil)
+
+	var submitted *pbp2p.AggregateAndProof
+	m.attesterClient.EXPECT().SubmitAggregateAndProof(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pbp2p.AggregateAndProof{}),
+	).Do(func(_ context.Context, agg *pbp2p.AggregateAndProof, _ ...grpc.CallOption) {
+		submitted = agg
+	}).Return(&pb.AttestResponse{}, nil /*err*/)
+
+	validator.AggregateAttestations(context.Background(), slot, validatorPubKey)
+
+	if submitted == nil {
+		t.Fatal("Expected an aggregate to be submitted")
+	}
+	if submitted.AggregatorIndex != 2 {
+		t.Errorf("Expected aggregator index 2, received %d", submitted.AggregatorIndex)
+	}
+	if submitted.Aggregate.AggregationBitfield[0] != 0xe0 {
+		t.Errorf("Expected aggregate bitfield %#x, received %#x", 0xe0, submitted.Aggregate.AggregationBitfield)
+	}
+	proof, err := bls.SignatureFromBytes(submitted.SelectionProof)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 32)
+	binary.LittleEndian.PutUint64(buf, slot)
+	domain := forkutil.DomainVersion(fork, slot/params.BeaconConfig().SlotsPerEpoch, params.BeaconConfig().DomainSelectionProof)
+	if !proof.Verify(buf, validatorKey.PublicKey, domain) {
+		t.Error("Selection proof does not verify")
+	}
+}
+
+func TestAggregateAttestations_DoesNotAggregateWithoutAttestation(t *testing.T) {
+	validator, _, finish := setup(t)
+	defer finish()
+	validator.assignments = &pb.CommitteeAssignmentResponse{
+		Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
+			{
+				PublicKey: validatorKey.PublicKey.Marshal(),
+				Shard:     5,
+				Committee: []uint64{0, 1, 2, 3},
+			},
+		},
+	}
+	// The key attested at an earlier slot, so it has nothing to aggregate; the mocks
+	// fail the test on any call to the beacon node.
+	validator.attested[validatorPubKey] = &pbp2p.AttestationData{Slot: 29, Shard: 5}
+
+	validator.AggregateAttestations(context.Background(), 30, validatorPubKey)
+}
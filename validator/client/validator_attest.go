@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"time"
 
+	ptypes "github.com/gogo/protobuf/types"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bitutil"
+	"github.com/prysmaticlabs/prysm/shared/forkutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"go.opencensus.io/trace"
 )
@@ -17,10 +19,22 @@ var delay = params.BeaconConfig().SecondsPerSlot / 2
 // AttestToBlockHead completes the validator client's attester responsibility at a given slot.
 // It fetches the latest beacon block head along with the latest canonical beacon state
 // information in order to sign the block and include information about the validator's
-// participation in voting on the block.
-func (v *validator) AttestToBlockHead(ctx context.Context, slot uint64) {
+// participation in voting on the block. The validator attests with the key of the hex
+// encoded public key, in the committee of its current assignment.
+func (v *validator) AttestToBlockHead(ctx context.Context, slot uint64, pubKey string) {
 	ctx, span := trace.StartSpan(ctx, "validator.AttestToBlockHead")
 	defer span.End()
+	log := log.WithField("publicKey", pubKey)
+	key, ok := v.keys[pubKey]
+	if !ok {
+		log.Error("No key to attest with")
+		return
+	}
+	assignment := v.assignment(pubKey)
+	if assignment == nil {
+		log.Errorf("No committee assignment to attest at slot %d", slot-params.BeaconConfig().GenesisSlot)
+		return
+	}
 	log.Info("Attesting...")
 	// First the validator should construct attestation_data, an AttestationData
 	// object based upon the state at the assigned slot.
@@ -28,36 +42,15 @@ func (v *validator) AttestToBlockHead(ctx context.Context, slot uint64) {
 		Slot:                    slot,
 		CrosslinkDataRootHash32: params.BeaconConfig().ZeroHash[:], // Stub for Phase 0.
 	}
-	// We fetch the validator index as it is necessary to generate the aggregation
-	// bitfield of the attestation itself.
-	pubKey := v.key.PublicKey.Marshal()
-	idxReq := &pb.ValidatorIndexRequest{
-		PublicKey: pubKey,
-	}
-	validatorIndexRes, err := v.validatorClient.ValidatorIndex(ctx, idxReq)
-	if err != nil {
-		log.Errorf("Could not fetch validator index: %v", err)
-		return
-	}
-	req := &pb.ValidatorEpochAssignmentsRequest{
-		EpochStart: slot,
-		PublicKey:  pubKey,
-	}
-	resp, err := v.validatorClient.CommitteeAssignment(ctx, req)
-	if err != nil {
-		log.Errorf("Could not fetch crosslink committees at slot %d: %v",
-			slot-params.BeaconConfig().GenesisSlot, err)
-		return
-	}
 	// Set the attestation data's shard as the shard associated with the validator's
 	// committee as retrieved by CrosslinkCommitteesAtSlot.
-	attData.Shard = resp.Shard
+	attData.Shard = assignment.Shard
 
 	// Fetch other necessary information from the beacon node in order to attest
 	// including the justified epoch, epoch boundary information, and more.
 	infoReq := &pb.AttestationDataRequest{
 		Slot:  slot,
-		Shard: resp.Shard,
+		Shard: assignment.Shard,
 	}
 	infoRes, err := v.attesterClient.AttestationDataAtSlot(ctx, infoReq)
 	if err != nil {
@@ -92,7 +85,7 @@ func (v *validator) AttestToBlockHead(ctx context.Context, slot uint64) {
 
 	// We set the custody bitfield to an slice of zero values as a stub for phase 0
 	// of length len(committee)+7 // 8.
-	attestation.CustodyBitfield = make([]byte, (len(resp.Committee)+7)/8)
+	attestation.CustodyBitfield = make([]byte, (len(assignment.Committee)+7)/8)
 
 	// Note: calling get_attestation_participants(state, attestation.data, attestation.aggregation_bitfield)
 	// should return a list of length equal to 1, containing validator_index.
@@ -100,8 +93,8 @@ func (v *validator) AttestToBlockHead(ctx context.Context, slot uint64) {
 	// Find the index in committee to be used for
 	// the aggregation bitfield
 	var indexInCommittee int
-	for i, vIndex := range resp.Committee {
-		if vIndex == validatorIndexRes.Index {
+	for i, vIndex := range assignment.Committee {
+		if vIndex == assignment.ValidatorIndex {
 			indexInCommittee = i
 			break
 		}
@@ -110,8 +103,30 @@ func (v *validator) AttestToBlockHead(ctx context.Context, slot uint64) {
 	aggregationBitfield := bitutil.SetBitfield(indexInCommittee)
 	attestation.AggregationBitfield = aggregationBitfield
 
-	// TODO(#1366): Use BLS to generate an aggregate signature.
-	attestation.AggregateSignature = []byte("signed")
+	// Until phase 1, every participant signs the attestation data with custody bit 0,
+	// so that the signatures of a committee can be aggregated.
+	// signature = bls_sign(
+	//   privkey=validator.privkey,
+	//   message_hash=hash_tree_root(AttestationDataAndCustodyBit(data=attestation.data, custody_bit=0b0)),
+	//   domain=get_domain(fork, slot_to_epoch(attestation.data.slot), DOMAIN_ATTESTATION),
+	// )
+	fork, err := v.beaconClient.ForkData(ctx, &ptypes.Empty{})
+	if err != nil {
+		log.Errorf("Failed to get fork data from beacon node's state: %v", err)
+		return
+	}
+	epoch := slot / params.BeaconConfig().SlotsPerEpoch
+	// The beacon node's head state may predate a fork scheduled for this epoch.
+	fork = forkutil.ScheduledFork(fork, epoch)
+	domain := forkutil.DomainVersion(fork, epoch, params.BeaconConfig().DomainAttestation)
+	// A beacon node may ask for an attestation which conflicts with one the validator
+	// already signed, so the signer refuses to sign an attestation which would be
+	// slashable.
+	attestation.AggregateSignature, err = v.signer.SignAttestation(ctx, key, attData, domain)
+	if err != nil {
+		log.Errorf("Not attesting, could not sign attestation: %v", err)
+		return
+	}
 
 	duration := time.Duration(slot*params.BeaconConfig().SecondsPerSlot+delay) * time.Second
 	timeToBroadcast := time.Unix(int64(v.genesisTime), 0).Add(duration)
@@ -127,4 +142,9 @@ func (v *validator) AttestToBlockHead(ctx context.Context, slot uint64) {
 	log.WithField(
 		"hash", fmt.Sprintf("%#x", attestRes.AttestationHash),
 	).Infof("Submitted attestation successfully with hash %#x", attestRes.AttestationHash)
+	validatorAttestationsProduced.WithLabelValues(fmt.Sprintf("%#x", key)).Inc()
+	// Keep the attestation data, which the attestations aggregated by the key must have.
+	v.attestedLock.Lock()
+	v.attested[pubKey] = attData
+	v.attestedLock.Unlock()
 }
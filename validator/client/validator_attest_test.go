@@ -8,45 +8,36 @@ import (
 	"time"
 
 	"github.com/gogo/protobuf/proto"
+	ptypes "github.com/gogo/protobuf/types"
 	"github.com/golang/mock/gomock"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bitutil"
+	"github.com/prysmaticlabs/prysm/shared/forkutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/ssz"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
 
-func TestAttestToBlockHead_ValidatorIndexRequestFailure(t *testing.T) {
+func TestAttestToBlockHead_NoAssignment(t *testing.T) {
 	hook := logTest.NewGlobal()
 
-	validator, m, finish := setup(t)
+	validator, _, finish := setup(t)
 	defer finish()
-	m.validatorClient.EXPECT().ValidatorIndex(
-		gomock.Any(), // ctx
-		gomock.AssignableToTypeOf(&pb.ValidatorIndexRequest{}),
-	).Return(nil /* Validator Index Response*/, errors.New("something bad happened"))
 
-	validator.AttestToBlockHead(context.Background(), 30)
-	testutil.AssertLogsContain(t, hook, "Could not fetch validator index")
+	validator.AttestToBlockHead(context.Background(), 30+params.BeaconConfig().GenesisSlot, validatorPubKey)
+	testutil.AssertLogsContain(t, hook, "No committee assignment to attest at slot 30")
 }
 
-func TestAttestToBlockHead_ValidatorCommitteeAtSlotFailure(t *testing.T) {
+func TestAttestToBlockHead_UnknownKey(t *testing.T) {
 	hook := logTest.NewGlobal()
 
-	validator, m, finish := setup(t)
+	validator, _, finish := setup(t)
 	defer finish()
-	m.validatorClient.EXPECT().ValidatorIndex(
-		gomock.Any(), // ctx
-		gomock.AssignableToTypeOf(&pb.ValidatorIndexRequest{}),
-	).Return(&pb.ValidatorIndexResponse{Index: 5}, nil)
-	m.validatorClient.EXPECT().CommitteeAssignment(
-		gomock.Any(), // ctx
-		gomock.Any(),
-	).Return(nil, errors.New("something went wrong"))
 
-	validator.AttestToBlockHead(context.Background(), 30+params.BeaconConfig().GenesisSlot)
-	testutil.AssertLogsContain(t, hook, "Could not fetch crosslink committees at slot 30")
+	validator.AttestToBlockHead(context.Background(), 30, "deadbeef")
+	testutil.AssertLogsContain(t, hook, "No key to attest with")
 }
 
 func TestAttestToBlockHead_AttestationDataAtSlotFailure(t *testing.T) {
@@ -54,22 +45,20 @@ func TestAttestToBlockHead_AttestationDataAtSlotFailure(t *testing.T) {
 
 	validator, m, finish := setup(t)
 	defer finish()
-	m.validatorClient.EXPECT().ValidatorIndex(
-		gomock.Any(), // ctx
-		gomock.AssignableToTypeOf(&pb.ValidatorIndexRequest{}),
-	).Return(&pb.ValidatorIndexResponse{Index: 5}, nil)
-	m.validatorClient.EXPECT().CommitteeAssignment(
-		gomock.Any(), // ctx
-		gomock.AssignableToTypeOf(&pb.ValidatorEpochAssignmentsRequest{}),
-	).Return(&pb.CommitteeAssignmentResponse{
-		Shard: 5,
-	}, nil)
+	validator.assignments = &pb.CommitteeAssignmentResponse{
+		Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
+			{
+				PublicKey: validatorKey.PublicKey.Marshal(),
+				Shard:     5,
+			},
+		},
+	}
 	m.attesterClient.EXPECT().AttestationDataAtSlot(
 		gomock.Any(), // ctx
 		gomock.AssignableToTypeOf(&pb.AttestationDataRequest{}),
 	).Return(nil, errors.New("something went wrong"))
 
-	validator.AttestToBlockHead(context.Background(), 30)
+	validator.AttestToBlockHead(context.Background(), 30, validatorPubKey)
 	testutil.AssertLogsContain(t, hook, "Could not fetch necessary info to produce attestation")
 }
 
@@ -78,19 +67,15 @@ func TestAttestToBlockHead_AttestHeadRequestFailure(t *testing.T) {
 
 	validator, m, finish := setup(t)
 	defer finish()
-	m.validatorClient.EXPECT().ValidatorIndex(
-		gomock.Any(), // ctx
-		gomock.AssignableToTypeOf(&pb.ValidatorIndexRequest{}),
-	).Return(&pb.ValidatorIndexResponse{
-		Index: 0,
-	}, nil)
-	m.validatorClient.EXPECT().CommitteeAssignment(
-		gomock.Any(), // ctx
-		gomock.AssignableToTypeOf(&pb.ValidatorEpochAssignmentsRequest{}),
-	).Return(&pb.CommitteeAssignmentResponse{
-		Shard:     5,
-		Committee: make([]uint64, 111),
-	}, nil)
+	validator.assignments = &pb.CommitteeAssignmentResponse{
+		Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
+			{
+				PublicKey: validatorKey.PublicKey.Marshal(),
+				Shard:     5,
+				Committee: make([]uint64, 111),
+			},
+		},
+	}
 	m.attesterClient.EXPECT().AttestationDataAtSlot(
 		gomock.Any(), // ctx
 		gomock.AssignableToTypeOf(&pb.AttestationDataRequest{}),
@@ -101,12 +86,21 @@ func TestAttestToBlockHead_AttestHeadRequestFailure(t *testing.T) {
 		LatestCrosslink:          &pbp2p.Crosslink{},
 		JustifiedEpoch:           0,
 	}, nil)
+	m.beaconClient.EXPECT().ForkData(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.Fork{
+		Epoch:           params.BeaconConfig().GenesisEpoch,
+		CurrentVersion:  0,
+		PreviousVersion: 0,
+	}, nil /*err*/)
+
 	m.attesterClient.EXPECT().AttestHead(
 		gomock.Any(), // ctx
 		gomock.AssignableToTypeOf(&pbp2p.Attestation{}),
 	).Return(nil, errors.New("something went wrong"))
 
-	validator.AttestToBlockHead(context.Background(), 30)
+	validator.AttestToBlockHead(context.Background(), 30, validatorPubKey)
 	testutil.AssertLogsContain(t, hook, "Could not submit attestation to beacon node")
 }
 
@@ -117,19 +111,16 @@ func TestAttestToBlockHead_AttestsCorrectly(t *testing.T) {
 	defer finish()
 	validatorIndex := uint64(7)
 	committee := []uint64{0, 3, 4, 2, validatorIndex, 6, 8, 9, 10}
-	m.validatorClient.EXPECT().ValidatorIndex(
-		gomock.Any(), // ctx
-		gomock.AssignableToTypeOf(&pb.ValidatorIndexRequest{}),
-	).Return(&pb.ValidatorIndexResponse{
-		Index: uint64(validatorIndex),
-	}, nil)
-	m.validatorClient.EXPECT().CommitteeAssignment(
-		gomock.Any(), // ctx
-		gomock.AssignableToTypeOf(&pb.ValidatorEpochAssignmentsRequest{}),
-	).Return(&pb.CommitteeAssignmentResponse{
-		Shard:     5,
-		Committee: committee,
-	}, nil)
+	validator.assignments = &pb.CommitteeAssignmentResponse{
+		Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
+			{
+				PublicKey:      validatorKey.PublicKey.Marshal(),
+				Shard:          5,
+				Committee:      committee,
+				ValidatorIndex: validatorIndex,
+			},
+		},
+	}
 	m.attesterClient.EXPECT().AttestationDataAtSlot(
 		gomock.Any(), // ctx
 		gomock.AssignableToTypeOf(&pb.AttestationDataRequest{}),
@@ -141,6 +132,15 @@ func TestAttestToBlockHead_AttestsCorrectly(t *testing.T) {
 		JustifiedEpoch:           3,
 	}, nil)
 
+	m.beaconClient.EXPECT().ForkData(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.Fork{
+		Epoch:           params.BeaconConfig().GenesisEpoch,
+		CurrentVersion:  0,
+		PreviousVersion: 0,
+	}, nil /*err*/)
+
 	var generatedAttestation *pbp2p.Attestation
 	m.attesterClient.EXPECT().AttestHead(
 		gomock.Any(), // ctx
@@ -149,7 +149,7 @@ func TestAttestToBlockHead_AttestsCorrectly(t *testing.T) {
 		generatedAttestation = att
 	}).Return(&pb.AttestResponse{}, nil /* error */)
 
-	validator.AttestToBlockHead(context.Background(), 30)
+	validator.AttestToBlockHead(context.Background(), 30, validatorPubKey)
 
 	// Validator index is at index 4 in the mocked committee defined in this test.
 	expectedAttestation := &pbp2p.Attestation{
@@ -163,11 +163,17 @@ func TestAttestToBlockHead_AttestsCorrectly(t *testing.T) {
 			CrosslinkDataRootHash32:  params.BeaconConfig().ZeroHash[:],
 			JustifiedEpoch:           3,
 		},
-		CustodyBitfield:    make([]byte, (len(committee)+7)/8),
-		AggregateSignature: []byte("signed"),
+		CustodyBitfield: make([]byte, (len(committee)+7)/8),
 	}
 	aggregationBitfield := bitutil.SetBitfield(4)
 	expectedAttestation.AggregationBitfield = aggregationBitfield
+	msg, err := ssz.TreeHash(&pbp2p.AttestationDataAndCustodyBit{Data: expectedAttestation.Data, CustodyBit: false})
+	if err != nil {
+		t.Fatal(err)
+	}
+	epoch := uint64(30) / params.BeaconConfig().SlotsPerEpoch
+	domain := forkutil.DomainVersion(&pbp2p.Fork{Epoch: params.BeaconConfig().GenesisEpoch}, epoch, params.BeaconConfig().DomainAttestation)
+	expectedAttestation.AggregateSignature = validatorKey.SecretKey.Sign(msg[:], domain).Marshal()
 	if !proto.Equal(generatedAttestation, expectedAttestation) {
 		t.Errorf("Incorrectly attested head, wanted %v, received %v", expectedAttestation, generatedAttestation)
 	}
@@ -179,22 +185,22 @@ func TestAttestToBlockHead_DoesNotAttestBeforeDelay(t *testing.T) {
 	defer finish()
 
 	var wg sync.WaitGroup
-	wg.Add(3)
+	wg.Add(2)
 	defer wg.Wait()
 
 	validator.genesisTime = uint64(time.Now().Unix())
 	validatorIndex := uint64(5)
 	committee := []uint64{0, 3, 4, 2, validatorIndex, 6, 8, 9, 10}
-	m.validatorClient.EXPECT().CommitteeAssignment(
-		gomock.Any(), // ctx
-		gomock.AssignableToTypeOf(&pb.ValidatorEpochAssignmentsRequest{}),
-		gomock.Any(), // ctx
-	).Return(&pb.CommitteeAssignmentResponse{
-		Shard:     5,
-		Committee: committee,
-	}, nil).Do(func(arg0, arg1 interface{}) {
-		wg.Done()
-	})
+	validator.assignments = &pb.CommitteeAssignmentResponse{
+		Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
+			{
+				PublicKey:      validatorKey.PublicKey.Marshal(),
+				Shard:          5,
+				Committee:      committee,
+				ValidatorIndex: validatorIndex,
+			},
+		},
+	}
 
 	m.attesterClient.EXPECT().AttestationDataAtSlot(
 		gomock.Any(), // ctx
@@ -209,12 +215,14 @@ func TestAttestToBlockHead_DoesNotAttestBeforeDelay(t *testing.T) {
 		wg.Done()
 	})
 
-	m.validatorClient.EXPECT().ValidatorIndex(
+	m.beaconClient.EXPECT().ForkData(
 		gomock.Any(), // ctx
-		gomock.AssignableToTypeOf(&pb.ValidatorIndexRequest{}),
-	).Return(&pb.ValidatorIndexResponse{
-		Index: uint64(validatorIndex),
-	}, nil).Do(func(arg0, arg1 interface{}) {
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.Fork{
+		Epoch:           params.BeaconConfig().GenesisEpoch,
+		CurrentVersion:  0,
+		PreviousVersion: 0,
+	}, nil /*err*/).Do(func(arg0, arg1 interface{}) {
 		wg.Done()
 	})
 
@@ -224,7 +232,7 @@ func TestAttestToBlockHead_DoesNotAttestBeforeDelay(t *testing.T) {
 	).Return(&pb.AttestResponse{}, nil /* error */).Times(0)
 
 	delay = 2
-	go validator.AttestToBlockHead(context.Background(), 0)
+	go validator.AttestToBlockHead(context.Background(), 0, validatorPubKey)
 }
 
 func TestAttestToBlockHead_DoesAttestAfterDelay(t *testing.T) {
@@ -232,21 +240,22 @@ func TestAttestToBlockHead_DoesAttestAfterDelay(t *testing.T) {
 	defer finish()
 
 	var wg sync.WaitGroup
-	wg.Add(3)
+	wg.Add(2)
 	defer wg.Wait()
 
 	validator.genesisTime = uint64(time.Now().Unix())
 	validatorIndex := uint64(5)
 	committee := []uint64{0, 3, 4, 2, validatorIndex, 6, 8, 9, 10}
-	m.validatorClient.EXPECT().CommitteeAssignment(
-		gomock.Any(), // ctx
-		gomock.AssignableToTypeOf(&pb.ValidatorEpochAssignmentsRequest{}),
-	).Return(&pb.CommitteeAssignmentResponse{
-		Shard:     5,
-		Committee: committee,
-	}, nil).Do(func(arg0, arg1 interface{}) {
-		wg.Done()
-	})
+	validator.assignments = &pb.CommitteeAssignmentResponse{
+		Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
+			{
+				PublicKey:      validatorKey.PublicKey.Marshal(),
+				Shard:          5,
+				Committee:      committee,
+				ValidatorIndex: validatorIndex,
+			},
+		},
+	}
 
 	m.attesterClient.EXPECT().AttestationDataAtSlot(
 		gomock.Any(), // ctx
@@ -261,12 +270,14 @@ func TestAttestToBlockHead_DoesAttestAfterDelay(t *testing.T) {
 		wg.Done()
 	})
 
-	m.validatorClient.EXPECT().ValidatorIndex(
+	m.beaconClient.EXPECT().ForkData(
 		gomock.Any(), // ctx
-		gomock.AssignableToTypeOf(&pb.ValidatorIndexRequest{}),
-	).Return(&pb.ValidatorIndexResponse{
-		Index: uint64(validatorIndex),
-	}, nil).Do(func(arg0, arg1 interface{}) {
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.Fork{
+		Epoch:           params.BeaconConfig().GenesisEpoch,
+		CurrentVersion:  0,
+		PreviousVersion: 0,
+	}, nil /*err*/).Do(func(arg0, arg1 interface{}) {
 		wg.Done()
 	})
 
@@ -276,5 +287,56 @@ func TestAttestToBlockHead_DoesAttestAfterDelay(t *testing.T) {
 	).Return(&pb.AttestResponse{}, nil).Times(1)
 
 	delay = 0
-	validator.AttestToBlockHead(context.Background(), 0)
+	validator.AttestToBlockHead(context.Background(), 0, validatorPubKey)
+}
+
+func TestAttestToBlockHead_SlashingProtectionRefusesDoubleVote(t *testing.T) {
+	hook := logTest.NewGlobal()
+
+	validator, m, finish := setup(t)
+	defer finish()
+	// Another attestation was already signed with the target epoch of slot 30.
+	if _, err := validator.signer.SignAttestation(
+		context.Background(),
+		validatorKey.PublicKey.Marshal(),
+		&pbp2p.AttestationData{Slot: 31, JustifiedEpoch: 0},
+		0, // domain
+	); err != nil {
+		t.Fatal(err)
+	}
+	validator.assignments = &pb.CommitteeAssignmentResponse{
+		Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
+			{
+				PublicKey:      validatorKey.PublicKey.Marshal(),
+				Shard:          5,
+				Committee:      []uint64{0, 1, 2},
+				ValidatorIndex: 1,
+			},
+		},
+	}
+	m.attesterClient.EXPECT().AttestationDataAtSlot(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pb.AttestationDataRequest{}),
+	).Return(&pb.AttestationDataResponse{
+		BeaconBlockRootHash32:    []byte("A"),
+		EpochBoundaryRootHash32:  []byte("B"),
+		JustifiedBlockRootHash32: []byte("C"),
+		LatestCrosslink:          &pbp2p.Crosslink{},
+		JustifiedEpoch:           0,
+	}, nil)
+	m.beaconClient.EXPECT().ForkData(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.Fork{
+		Epoch:           params.BeaconConfig().GenesisEpoch,
+		CurrentVersion:  0,
+		PreviousVersion: 0,
+	}, nil /*err*/)
+	m.attesterClient.EXPECT().AttestHead(
+		gomock.Any(), // ctx
+		gomock.Any(),
+	).Times(0)
+
+	validator.AttestToBlockHead(context.Background(), 30, validatorPubKey)
+	testutil.AssertLogsContain(t, hook, "slashing protection refused the attestation")
 }
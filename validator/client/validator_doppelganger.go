@@ -0,0 +1,82 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// doppelgangerWatch tracks the watch of the network for the validator keys on start-up.
+type doppelgangerWatch struct {
+	epochs      uint64 // Number of epochs to watch for, or 0 not to watch.
+	startSlot   uint64 // Slot the watch started at, or 0 before it started.
+	checkedSlot uint64 // Latest slot the beacon node reported no activity of the keys at.
+	done        bool
+}
+
+// WatchDoppelganger watches the network for attestations and blocks of the validator
+// keys for the configured number of epochs before they perform any duty. If the same key
+// is running in another validator client, both sign and the key gets slashed, so an error
+// is returned if the beacon node saw the keys attest or propose since the watch started.
+// It returns true while the keys are watched, in which case they must not perform their
+// duties at the slot.
+//
+// The beacon node is asked at every slot of the watch, and can only look back into the
+// slots of the previous and the current epoch, so the watch starts over if it could not be
+// asked for an epoch.
+func (v *validator) WatchDoppelganger(ctx context.Context, slot uint64) (bool, error) {
+	w := &v.doppelganger
+	if w.epochs == 0 || w.done {
+		return false, nil
+	}
+	ctx, span := trace.StartSpan(ctx, "validator.WatchDoppelganger")
+	defer span.End()
+
+	if w.startSlot == 0 || slot >= w.checkedSlot+params.BeaconConfig().SlotsPerEpoch {
+		w.startSlot = slot
+		w.checkedSlot = slot
+		log.WithFields(logrus.Fields{
+			"epochs":    w.epochs,
+			"startSlot": slot - params.BeaconConfig().GenesisSlot,
+		}).Info("Watching the network for the validator keys before performing duties")
+	}
+
+	// The keys may have signed at the slot the watch started at before the validator
+	// client was restarted, so only the slots after it are looked into.
+	res, err := v.validatorClient.ValidatorActivity(ctx, &pb.ValidatorActivityRequest{
+		PublicKeys: v.pubkeys,
+		SinceSlot:  w.startSlot + 1,
+	})
+	if err != nil {
+		log.WithField("error", err).Warn("Could not check the validator keys for activity on the network")
+		return true, nil
+	}
+	if len(res.ActiveValidators) > 0 {
+		pubKeys := make([]string, len(res.ActiveValidators))
+		for i, activity := range res.ActiveValidators {
+			pubKeys[i] = fmt.Sprintf("%#x", activity.PublicKey)
+			fields := logrus.Fields{"publicKey": pubKeys[i]}
+			if activity.AttestationSlot != 0 {
+				fields["attestationSlot"] = activity.AttestationSlot - params.BeaconConfig().GenesisSlot
+			}
+			if activity.BlockSlot != 0 {
+				fields["blockSlot"] = activity.BlockSlot - params.BeaconConfig().GenesisSlot
+			}
+			log.WithFields(fields).Error("Validator key is active on the network")
+		}
+		return true, fmt.Errorf("validator keys %s are active on the network, they may be running in another validator client",
+			strings.Join(pubKeys, ", "))
+	}
+	w.checkedSlot = slot
+	if slot < w.startSlot+w.epochs*params.BeaconConfig().SlotsPerEpoch {
+		return true, nil
+	}
+	w.done = true
+	log.Info("Validator keys are not active on the network, performing duties")
+	return false, nil
+}
@@ -0,0 +1,120 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestWatchDoppelganger_DisabledDoesNotWatch(t *testing.T) {
+	validator, _, finish := setup(t)
+	defer finish()
+
+	// The mocks fail the test on any call to the beacon node.
+	watching, err := validator.WatchDoppelganger(context.Background(), params.BeaconConfig().GenesisSlot)
+	if err != nil {
+		t.Fatalf("Could not watch for the validator keys: %v", err)
+	}
+	if watching {
+		t.Error("Expected the validator keys not to be watched")
+	}
+}
+
+func TestWatchDoppelganger_PerformsDutiesAfterWatch(t *testing.T) {
+	validator, m, finish := setup(t)
+	defer finish()
+	validator.doppelganger.epochs = 1
+	startSlot := params.BeaconConfig().GenesisSlot + 3
+
+	m.validatorClient.EXPECT().ValidatorActivity(
+		gomock.Any(), // ctx
+		&pb.ValidatorActivityRequest{
+			PublicKeys: [][]byte{validatorKey.PublicKey.Marshal()},
+			SinceSlot:  startSlot + 1,
+		},
+	).Return(&pb.ValidatorActivityResponse{}, nil).Times(int(params.BeaconConfig().SlotsPerEpoch) + 1)
+
+	endSlot := startSlot + params.BeaconConfig().SlotsPerEpoch
+	for slot := startSlot; slot <= endSlot; slot++ {
+		watching, err := validator.WatchDoppelganger(context.Background(), slot)
+		if err != nil {
+			t.Fatalf("Could not watch for the validator keys: %v", err)
+		}
+		if watching != (slot < endSlot) {
+			t.Errorf("Expected the validator keys to be watched at slot %d: %v, received %v", slot, slot < endSlot, watching)
+		}
+	}
+	// The keys are not watched again once they perform duties.
+	if watching, _ := validator.WatchDoppelganger(context.Background(), endSlot+1); watching {
+		t.Error("Expected the validator keys not to be watched after the watch")
+	}
+}
+
+func TestWatchDoppelganger_RefusesActiveKeys(t *testing.T) {
+	validator, m, finish := setup(t)
+	defer finish()
+	validator.doppelganger.epochs = 2
+	slot := params.BeaconConfig().GenesisSlot + 3
+
+	m.validatorClient.EXPECT().ValidatorActivity(
+		gomock.Any(), // ctx
+		gomock.Any(),
+	).Return(&pb.ValidatorActivityResponse{
+		ActiveValidators: []*pb.ValidatorActivity{
+			{PublicKey: validatorKey.PublicKey.Marshal(), AttestationSlot: slot + 1},
+		},
+	}, nil)
+
+	watching, err := validator.WatchDoppelganger(context.Background(), slot)
+	want := "are active on the network"
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error to contain %s, received %v", want, err)
+	}
+	if !watching {
+		t.Error("Expected the validator keys not to perform duties")
+	}
+}
+
+func TestWatchDoppelganger_StartsOverAfterAnEpochWithoutBeaconNode(t *testing.T) {
+	validator, m, finish := setup(t)
+	defer finish()
+	validator.doppelganger.epochs = 1
+	startSlot := params.BeaconConfig().GenesisSlot + 3
+	restartSlot := startSlot + params.BeaconConfig().SlotsPerEpoch
+
+	gomock.InOrder(
+		m.validatorClient.EXPECT().ValidatorActivity(
+			gomock.Any(), // ctx
+			gomock.Any(),
+		).Return(&pb.ValidatorActivityResponse{}, nil),
+		m.validatorClient.EXPECT().ValidatorActivity(
+			gomock.Any(), // ctx
+			gomock.Any(),
+		).Return(nil, errors.New("connection refused")),
+		m.validatorClient.EXPECT().ValidatorActivity(
+			gomock.Any(), // ctx
+			&pb.ValidatorActivityRequest{
+				PublicKeys: [][]byte{validatorKey.PublicKey.Marshal()},
+				SinceSlot:  restartSlot + 1,
+			},
+		).Return(&pb.ValidatorActivityResponse{}, nil),
+	)
+
+	for _, slot := range []uint64{startSlot, startSlot + 1, restartSlot} {
+		watching, err := validator.WatchDoppelganger(context.Background(), slot)
+		if err != nil {
+			t.Fatalf("Could not watch for the validator keys: %v", err)
+		}
+		if !watching {
+			t.Errorf("Expected the validator keys to be watched at slot %d", slot)
+		}
+	}
+	if validator.doppelganger.startSlot != restartSlot {
+		t.Errorf("Expected the watch to start over at slot %d, received %d", restartSlot, validator.doppelganger.startSlot)
+	}
+}
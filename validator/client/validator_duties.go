@@ -0,0 +1,88 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// dutiesRetryDelay is the time to wait before reopening a duties stream which failed.
+var dutiesRetryDelay = time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+
+// StreamDuties opens a stream through which the beacon node pushes the assignments
+// of the validator keys for the current and the next epoch whenever they change,
+// and caches them for UpdateAssignments. This replaces polling for the assignments
+// at every epoch, and keeps them correct after a reorg or a registry update. The
+// stream is reopened when it fails, until the context is canceled. Calling it again
+// closes the previous stream, so it is called after failing over to another beacon node.
+func (v *validator) StreamDuties(ctx context.Context) {
+	if v.stopDuties != nil {
+		v.stopDuties()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	v.stopDuties = cancel
+	go v.streamDuties(ctx, v.validatorClient)
+}
+
+// streamDuties receives the assignments of the validator keys from the beacon node,
+// reopening the stream a slot after it fails.
+func (v *validator) streamDuties(ctx context.Context, client pb.ValidatorServiceClient) {
+	for {
+		err := v.receiveDuties(ctx, client)
+		if ctx.Err() != nil {
+			return
+		}
+		log.WithField("error", err).Warn("Duties stream failed, reopening it")
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(dutiesRetryDelay):
+		}
+	}
+}
+
+// receiveDuties caches the assignments received through a duties stream until the
+// stream fails.
+func (v *validator) receiveDuties(ctx context.Context, client pb.ValidatorServiceClient) error {
+	stream, err := client.StreamDuties(ctx, &pb.DutiesRequest{PublicKeys: v.pubkeys})
+	if err != nil {
+		return fmt.Errorf("could not setup duties streaming client: %v", err)
+	}
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			return errors.New("duties stream closed by the beacon node")
+		}
+		if err != nil {
+			return fmt.Errorf("could not receive duties from stream: %v", err)
+		}
+		v.cacheDuties(res)
+	}
+}
+
+// cacheDuties replaces the cached assignments of the epochs of the response, and
+// drops those of the epochs before.
+func (v *validator) cacheDuties(res *pb.DutiesResponse) {
+	v.dutiesLock.Lock()
+	defer v.dutiesLock.Unlock()
+	for epoch := range v.duties {
+		if epoch < res.CurrentEpoch {
+			delete(v.duties, epoch)
+		}
+	}
+	v.duties[res.CurrentEpoch] = res.CurrentEpochDuties
+	v.duties[res.CurrentEpoch+1] = res.NextEpochDuties
+}
+
+// streamedDuties returns the cached assignments of the epoch, or nil if none were
+// streamed.
+func (v *validator) streamedDuties(epoch uint64) *pb.CommitteeAssignmentResponse {
+	v.dutiesLock.Lock()
+	defer v.dutiesLock.Unlock()
+	return v.duties[epoch]
+}
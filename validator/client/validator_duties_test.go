@@ -0,0 +1,153 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/internal"
+)
+
+func TestReceiveDuties_CachesStreamedDuties(t *testing.T) {
+	validator, m, finish := setup(t)
+	defer finish()
+	epoch := params.BeaconConfig().GenesisEpoch + 3
+	current := &pb.CommitteeAssignmentResponse{
+		Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
+			{PublicKey: validatorKey.PublicKey.Marshal(), Shard: 1, ValidatorIndex: 4},
+		},
+	}
+	next := &pb.CommitteeAssignmentResponse{
+		Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
+			{PublicKey: validatorKey.PublicKey.Marshal(), Shard: 2, ValidatorIndex: 4},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	stream := internal.NewMockValidatorService_StreamDutiesClient(ctrl)
+	m.validatorClient.EXPECT().StreamDuties(
+		gomock.Any(), // ctx
+		&pb.DutiesRequest{PublicKeys: [][]byte{validatorKey.PublicKey.Marshal()}},
+	).Return(stream, nil)
+	stream.EXPECT().Recv().Return(&pb.DutiesResponse{
+		CurrentEpoch:       epoch,
+		CurrentEpochDuties: current,
+		NextEpochDuties:    next,
+	}, nil)
+	stream.EXPECT().Recv().Return(nil, io.EOF)
+
+	want := "duties stream closed by the beacon node"
+	if err := validator.receiveDuties(context.Background(), m.validatorClient); err == nil || err.Error() != want {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+	if duties := validator.streamedDuties(epoch); duties != current {
+		t.Errorf("Expected the current epoch duties to be cached, received %v", duties)
+	}
+	if duties := validator.streamedDuties(epoch + 1); duties != next {
+		t.Errorf("Expected the next epoch duties to be cached, received %v", duties)
+	}
+}
+
+func TestCacheDuties_DropsPastEpochs(t *testing.T) {
+	validator, _, finish := setup(t)
+	defer finish()
+	epoch := params.BeaconConfig().GenesisEpoch
+	validator.cacheDuties(&pb.DutiesResponse{
+		CurrentEpoch:       epoch,
+		CurrentEpochDuties: &pb.CommitteeAssignmentResponse{},
+		NextEpochDuties:    &pb.CommitteeAssignmentResponse{},
+	})
+	validator.cacheDuties(&pb.DutiesResponse{
+		CurrentEpoch:       epoch + 1,
+		CurrentEpochDuties: &pb.CommitteeAssignmentResponse{},
+		NextEpochDuties:    &pb.CommitteeAssignmentResponse{},
+	})
+
+	if validator.streamedDuties(epoch) != nil {
+		t.Error("Expected the duties of the past epoch to be dropped")
+	}
+	if len(validator.duties) != 2 {
+		t.Errorf("Expected the duties of 2 epochs to be cached, received %d", len(validator.duties))
+	}
+}
+
+func TestUpdateAssignments_UsesStreamedDuties(t *testing.T) {
+	validator, m, finish := setup(t)
+	defer finish()
+	slot := params.BeaconConfig().GenesisSlot + params.BeaconConfig().SlotsPerEpoch + 3
+	current := &pb.CommitteeAssignmentResponse{
+		Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
+			{PublicKey: validatorKey.PublicKey.Marshal(), Slot: slot},
+		},
+	}
+	validator.assignments = &pb.CommitteeAssignmentResponse{}
+	validator.cacheDuties(&pb.DutiesResponse{
+		CurrentEpoch:       slot / params.BeaconConfig().SlotsPerEpoch,
+		CurrentEpochDuties: current,
+		NextEpochDuties:    &pb.CommitteeAssignmentResponse{},
+	})
+	m.validatorClient.EXPECT().CommitteeAssignment(
+		gomock.Any(),
+		gomock.Any(),
+	).Times(0)
+
+	// The streamed duties replace the assignments even in the middle of an epoch, as
+	// they change after a reorg.
+	if err := validator.UpdateAssignments(context.Background(), slot); err != nil {
+		t.Fatalf("Could not update assignments: %v", err)
+	}
+	if validator.assignments != current {
+		t.Errorf("Expected the streamed duties to be used, received %v", validator.assignments)
+	}
+}
+
+func TestStreamDuties_ReopensFailedStream(t *testing.T) {
+	validator, m, finish := setup(t)
+	defer finish()
+	defer func(d time.Duration) {
+		dutiesRetryDelay = d
+	}(dutiesRetryDelay)
+	dutiesRetryDelay = 0
+
+	epoch := params.BeaconConfig().GenesisEpoch
+	received := make(chan bool)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	stream := internal.NewMockValidatorService_StreamDutiesClient(ctrl)
+	gomock.InOrder(
+		m.validatorClient.EXPECT().StreamDuties(
+			gomock.Any(), // ctx
+			gomock.Any(),
+		).Return(nil, errors.New("connection refused")),
+		m.validatorClient.EXPECT().StreamDuties(
+			gomock.Any(), // ctx
+			gomock.Any(),
+		).Return(stream, nil),
+	)
+	gomock.InOrder(
+		stream.EXPECT().Recv().Return(&pb.DutiesResponse{
+			CurrentEpoch:       epoch,
+			CurrentEpochDuties: &pb.CommitteeAssignmentResponse{},
+			NextEpochDuties:    &pb.CommitteeAssignmentResponse{},
+		}, nil),
+		// Block until the stream is stopped.
+		stream.EXPECT().Recv().Do(func() {
+			received <- true
+			<-received
+		}).Return(nil, context.Canceled),
+	)
+
+	validator.StreamDuties(context.Background())
+	<-received
+	if validator.streamedDuties(epoch) == nil {
+		t.Error("Expected the duties to be cached")
+	}
+	validator.stopDuties()
+	received <- true
+}
@@ -0,0 +1,123 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+var (
+	validatorBalance = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "validator_balance",
+		Help: "The balance of the validator in Gwei",
+	}, []string{"pubkey"})
+	validatorEffectiveBalance = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "validator_effective_balance",
+		Help: "The effective balance of the validator in Gwei",
+	}, []string{"pubkey"})
+	validatorStatus = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "validator_status",
+		Help: "The status of the validator, as the number of its ValidatorStatus value",
+	}, []string{"pubkey"})
+	validatorInclusionDistance = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "validator_inclusion_distance",
+		Help: "The number of slots after its slot the latest included attestation of the validator was included",
+	}, []string{"pubkey"})
+	validatorAttestationsProduced = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "validator_attestations_produced",
+		Help: "The number of attestations the validator submitted to the beacon node",
+	}, []string{"pubkey"})
+	validatorAttestationsIncluded = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "validator_attestations_included",
+		Help: "The number of epochs an attestation of the validator was included in the canonical chain",
+	}, []string{"pubkey"})
+	validatorCorrectSourceVotes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "validator_correct_source_votes",
+		Help: "The number of included attestations of the validator which voted for the canonical source",
+	}, []string{"pubkey"})
+	validatorCorrectTargetVotes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "validator_correct_target_votes",
+		Help: "The number of included attestations of the validator which voted for the canonical target",
+	}, []string{"pubkey"})
+	validatorCorrectHeadVotes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "validator_correct_head_votes",
+		Help: "The number of included attestations of the validator which voted for the canonical head",
+	}, []string{"pubkey"})
+	validatorProposals = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "validator_proposals",
+		Help: "The number of slots the validator was the proposer of",
+	}, []string{"pubkey"})
+	validatorMissedProposals = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "validator_missed_proposals",
+		Help: "The number of slots the validator was the proposer of without a block in the canonical chain",
+	}, []string{"pubkey"})
+)
+
+// UpdatePerformance fetches how the validator keys performed their duties from the
+// beacon node at the last slot of each epoch, and exports it as metrics labeled by
+// public key. The beacon node reports on the previous epoch, whose attestations can no
+// longer be included after the current epoch, so the report of each epoch is complete.
+func (v *validator) UpdatePerformance(ctx context.Context, slot uint64) error {
+	if (slot+1)%params.BeaconConfig().SlotsPerEpoch != 0 {
+		return nil
+	}
+	ctx, span := trace.StartSpan(ctx, "validator.UpdatePerformance")
+	defer span.End()
+
+	res, err := v.validatorClient.ValidatorPerformance(ctx, &pb.ValidatorPerformanceRequest{
+		PublicKeys: v.pubkeys,
+	})
+	if err != nil {
+		return fmt.Errorf("could not fetch validator performance: %v", err)
+	}
+	// The counters are only increased once per epoch, even if the beacon node reports
+	// on the same epoch again, for example after failing over to another beacon node.
+	newEpoch := res.Epoch > v.reportedEpoch
+	v.reportedEpoch = res.Epoch
+
+	for _, performance := range res.Validators {
+		pubKey := fmt.Sprintf("%#x", performance.PublicKey)
+		validatorBalance.WithLabelValues(pubKey).Set(float64(performance.Balance))
+		validatorEffectiveBalance.WithLabelValues(pubKey).Set(float64(performance.EffectiveBalance))
+		validatorStatus.WithLabelValues(pubKey).Set(float64(performance.Status))
+		if performance.AttestationIncluded {
+			validatorInclusionDistance.WithLabelValues(pubKey).Set(float64(performance.InclusionDistance))
+		}
+		if newEpoch {
+			if performance.AttestationIncluded {
+				validatorAttestationsIncluded.WithLabelValues(pubKey).Inc()
+			}
+			if performance.CorrectSource {
+				validatorCorrectSourceVotes.WithLabelValues(pubKey).Inc()
+			}
+			if performance.CorrectTarget {
+				validatorCorrectTargetVotes.WithLabelValues(pubKey).Inc()
+			}
+			if performance.CorrectHead {
+				validatorCorrectHeadVotes.WithLabelValues(pubKey).Inc()
+			}
+			validatorProposals.WithLabelValues(pubKey).Add(float64(performance.Proposals))
+			validatorMissedProposals.WithLabelValues(pubKey).Add(float64(performance.MissedProposals))
+		}
+
+		log.WithFields(logrus.Fields{
+			"publicKey":           pubKey,
+			"epoch":               res.Epoch - params.BeaconConfig().GenesisEpoch,
+			"status":              performance.Status,
+			"balance":             performance.Balance,
+			"attestationIncluded": performance.AttestationIncluded,
+			"inclusionDistance":   performance.InclusionDistance,
+			"correctSource":       performance.CorrectSource,
+			"correctTarget":       performance.CorrectTarget,
+			"correctHead":         performance.CorrectHead,
+			"missedProposals":     performance.MissedProposals,
+		}).Info("Validator performance")
+	}
+	return nil
+}
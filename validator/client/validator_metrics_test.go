@@ -0,0 +1,74 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestUpdatePerformance_DoesNothingBeforeEpochEnd(t *testing.T) {
+	validator, _, finish := setup(t)
+	defer finish()
+
+	// The mocks fail the test on any call to the beacon node.
+	if err := validator.UpdatePerformance(context.Background(), params.BeaconConfig().SlotsPerEpoch); err != nil {
+		t.Fatalf("Could not update performance: %v", err)
+	}
+}
+
+func TestUpdatePerformance_ExportsMetricsOncePerEpoch(t *testing.T) {
+	validator, m, finish := setup(t)
+	defer finish()
+	slot := params.BeaconConfig().GenesisSlot + 2*params.BeaconConfig().SlotsPerEpoch - 1
+	res := &pb.ValidatorPerformanceResponse{
+		Epoch: params.BeaconConfig().GenesisEpoch,
+		Validators: []*pb.ValidatorPerformance{
+			{
+				PublicKey:           validatorKey.PublicKey.Marshal(),
+				Status:              pb.ValidatorStatus_ACTIVE,
+				Balance:             31e9,
+				EffectiveBalance:    31e9,
+				AttestationIncluded: true,
+				InclusionDistance:   2,
+				CorrectSource:       true,
+				Proposals:           2,
+				MissedProposals:     1,
+			},
+		},
+	}
+	// The beacon node reports on the same epoch twice, as after a failover.
+	m.validatorClient.EXPECT().ValidatorPerformance(
+		gomock.Any(), // ctx
+		&pb.ValidatorPerformanceRequest{PublicKeys: [][]byte{validatorKey.PublicKey.Marshal()}},
+	).Return(res, nil).Times(2)
+
+	for i := 0; i < 2; i++ {
+		if err := validator.UpdatePerformance(context.Background(), slot); err != nil {
+			t.Fatalf("Could not update performance: %v", err)
+		}
+	}
+
+	pubKey := fmt.Sprintf("%#x", validatorKey.PublicKey.Marshal())
+	expected := fmt.Sprintf(`
+		# HELP validator_balance The balance of the validator in Gwei
+		# TYPE validator_balance gauge
+		validator_balance{pubkey="%s"} 3.1e+10
+	`, pubKey)
+	if err := testutil.CollectAndCompare(validatorBalance, strings.NewReader(expected)); err != nil {
+		t.Error(err)
+	}
+	expected = fmt.Sprintf(`
+		# HELP validator_missed_proposals The number of slots the validator was the proposer of without a block in the canonical chain
+		# TYPE validator_missed_proposals counter
+		validator_missed_proposals{pubkey="%s"} 1
+	`, pubKey)
+	if err := testutil.CollectAndCompare(validatorMissedProposals, strings.NewReader(expected)); err != nil {
+		t.Error(err)
+	}
+}
@@ -4,7 +4,6 @@ package client
 
 import (
 	"context"
-	"encoding/binary"
 	"fmt"
 
 	"github.com/gogo/protobuf/proto"
@@ -21,14 +20,21 @@ import (
 // previous beacon block, any pending deposits, and ETH1 data from the beacon
 // chain node to construct the new block. The new block is then processed with
 // the state root computation, and finally signed by the validator before being
-// sent back to the beacon node for broadcasting.
-func (v *validator) ProposeBlock(ctx context.Context, slot uint64) {
+// sent back to the beacon node for broadcasting. The block is proposed with the key
+// of the hex encoded public key.
+func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey string) {
+	log := log.WithField("publicKey", pubKey)
 	if slot == params.BeaconConfig().GenesisSlot {
 		log.Info("Assigned to genesis slot, skipping proposal")
 		return
 	}
 	ctx, span := trace.StartSpan(ctx, "validator.ProposeBlock")
 	defer span.End()
+	key, ok := v.keys[pubKey]
+	if !ok {
+		log.Error("No key to propose with")
+		return
+	}
 	log.Info("Proposing...")
 	// 1. Fetch data from Beacon Chain node.
 	// Get current head beacon block.
@@ -76,13 +82,16 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64) {
 	//   )
 	// )
 	epoch := slot / params.BeaconConfig().SlotsPerEpoch
-	buf := make([]byte, 32)
-	binary.LittleEndian.PutUint64(buf, epoch)
 	log.Infof("Signing randao epoch: %d", epoch)
+	// The beacon node's head state may predate a fork scheduled for this epoch.
+	fork = forkutil.ScheduledFork(fork, epoch)
 	domain := forkutil.DomainVersion(fork, epoch, params.BeaconConfig().DomainRandao)
-	epochSignature := v.key.SecretKey.Sign(buf, domain)
-	log.Infof("Pubkey: %#x", v.key.PublicKey.Marshal())
-	log.Infof("Epoch signature: %#x", epochSignature.Marshal())
+	epochSignature, err := v.signer.SignRandaoReveal(ctx, key, epoch, domain)
+	if err != nil {
+		log.Errorf("Could not sign randao reveal: %v", err)
+		return
+	}
+	log.Infof("Epoch signature: %#x", epochSignature)
 
 	// Fetch pending attestations seen by the beacon node.
 	attResp, err := v.proposerClient.PendingAttestations(ctx, &pb.PendingAttestationsRequest{
@@ -94,18 +103,25 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64) {
 		return
 	}
 
+	// Fetch the voluntary exits waiting in the beacon node's operations pool.
+	exitResp, err := v.proposerClient.PendingExits(ctx, &ptypes.Empty{})
+	if err != nil {
+		log.Errorf("Failed to fetch pending exits from the beacon node: %v", err)
+		return
+	}
+
 	// 2. Construct block.
 	block := &pbp2p.BeaconBlock{
 		Slot:             slot,
 		ParentRootHash32: parentTreeRoot[:],
-		RandaoReveal:     epochSignature.Marshal(),
+		RandaoReveal:     epochSignature,
 		Eth1Data:         eth1DataResp.Eth1Data,
 		Body: &pbp2p.BeaconBlockBody{
 			Attestations:      attResp.PendingAttestations,
 			ProposerSlashings: nil, // TODO(1438): Add after operations pool
 			AttesterSlashings: nil, // TODO(1438): Add after operations pool
 			Deposits:          pDepResp.PendingDeposits,
-			VoluntaryExits:    nil, // TODO(1323): Add validator exits
+			VoluntaryExits:    exitResp.PendingExits,
 		},
 	}
 
@@ -120,8 +136,19 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64) {
 	block.StateRootHash32 = resp.GetStateRoot()
 
 	// 4. Sign the complete block.
-	// TODO(1366): BLS sign block
-	block.Signature = nil
+	// signature = bls_sign(
+	//   privkey=validator.privkey,
+	//   message_hash=signed_root(block),
+	//   domain=get_domain(fork, slot_to_epoch(block.slot), DOMAIN_PROPOSAL),
+	// )
+	// A beacon node may ask for a block which conflicts with one the validator already
+	// signed, so the signer refuses to sign a block which would be slashable.
+	domain = forkutil.DomainVersion(fork, epoch, params.BeaconConfig().DomainProposal)
+	block.Signature, err = v.signer.SignBlock(ctx, key, block, domain)
+	if err != nil {
+		log.Errorf("Not proposing, could not sign block: %v", err)
+		return
+	}
 
 	// 5. Broadcast to the network via beacon chain node.
 	blkResp, err := v.proposerClient.ProposeBlock(ctx, block)
@@ -4,16 +4,24 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"reflect"
 	"testing"
 
 	ptypes "github.com/gogo/protobuf/types"
 	"github.com/golang/mock/gomock"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/forkutil"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/ssz"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/internal"
+	"github.com/prysmaticlabs/prysm/validator/signer"
 	logTest "github.com/sirupsen/logrus/hooks/test"
+	"google.golang.org/grpc"
 )
 
 type mocks struct {
@@ -32,22 +40,28 @@ func setup(t *testing.T) (*validator, *mocks, func()) {
 		attesterClient:  internal.NewMockAttesterServiceClient(ctrl),
 	}
 
-	validator := &validator{
-		proposerClient:  m.proposerClient,
-		beaconClient:    m.beaconClient,
-		attesterClient:  m.attesterClient,
-		validatorClient: m.validatorClient,
-		key:             validatorKey,
+	validatorDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatalf("Could not setup validator database: %v", err)
 	}
 
-	return validator, m, ctrl.Finish
+	validator := newValidator(signer.NewLocalSigner([]*keystore.Key{validatorKey}, validatorDB))
+	validator.proposerClient = m.proposerClient
+	validator.beaconClient = m.beaconClient
+	validator.attesterClient = m.attesterClient
+	validator.validatorClient = m.validatorClient
+
+	return validator, m, func() {
+		ctrl.Finish()
+		db.TeardownDB(validatorDB)
+	}
 }
 
 func TestProposeBlock_DoesNotProposeGenesisBlock(t *testing.T) {
 	hook := logTest.NewGlobal()
 	validator, _, finish := setup(t)
 	defer finish()
-	validator.ProposeBlock(context.Background(), params.BeaconConfig().GenesisSlot)
+	validator.ProposeBlock(context.Background(), params.BeaconConfig().GenesisSlot, validatorPubKey)
 
 	testutil.AssertLogsContain(t, hook, "Assigned to genesis slot, skipping proposal")
 }
@@ -62,7 +76,7 @@ func TestProposeBlock_LogsCanonicalHeadFailure(t *testing.T) {
 		gomock.Eq(&ptypes.Empty{}),
 	).Return(nil /*beaconBlock*/, errors.New("something bad happened"))
 
-	validator.ProposeBlock(context.Background(), 55)
+	validator.ProposeBlock(context.Background(), 55, validatorPubKey)
 
 	testutil.AssertLogsContain(t, hook, "something bad happened")
 }
@@ -82,7 +96,7 @@ func TestProposeBlock_PendingDepositsFailure(t *testing.T) {
 		gomock.Eq(&ptypes.Empty{}),
 	).Return(nil /*response*/, errors.New("something bad happened"))
 
-	validator.ProposeBlock(context.Background(), 55)
+	validator.ProposeBlock(context.Background(), 55, validatorPubKey)
 
 	testutil.AssertLogsContain(t, hook, "something bad happened")
 }
@@ -124,6 +138,11 @@ func TestProposeBlock_UsePendingDeposits(t *testing.T) {
 		gomock.AssignableToTypeOf(&pb.PendingAttestationsRequest{}),
 	).Return(&pb.PendingAttestationsResponse{PendingAttestations: []*pbp2p.Attestation{}}, nil)
 
+	m.proposerClient.EXPECT().PendingExits(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingExitsResponse{}, nil)
+
 	m.proposerClient.EXPECT().ComputeStateRoot(
 		gomock.Any(), // context
 		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
@@ -139,7 +158,7 @@ func TestProposeBlock_UsePendingDeposits(t *testing.T) {
 		broadcastedBlock = blk
 	}).Return(&pb.ProposeResponse{}, nil /*error*/)
 
-	validator.ProposeBlock(context.Background(), 55)
+	validator.ProposeBlock(context.Background(), 55, validatorPubKey)
 
 	if !bytes.Equal(broadcastedBlock.Body.Deposits[0].DepositData, []byte{'D', 'A', 'T', 'A'}) {
 		t.Errorf("Unexpected deposit data: %v", broadcastedBlock.Body.Deposits)
@@ -166,7 +185,7 @@ func TestProposeBlock_Eth1DataFailure(t *testing.T) {
 		gomock.Eq(&ptypes.Empty{}),
 	).Return(nil /*response*/, errors.New("something bad happened"))
 
-	validator.ProposeBlock(context.Background(), 55)
+	validator.ProposeBlock(context.Background(), 55, validatorPubKey)
 
 	testutil.AssertLogsContain(t, hook, "something bad happened")
 }
@@ -206,6 +225,11 @@ func TestProposeBlock_UsesEth1Data(t *testing.T) {
 		gomock.AssignableToTypeOf(&pb.PendingAttestationsRequest{}),
 	).Return(&pb.PendingAttestationsResponse{PendingAttestations: []*pbp2p.Attestation{}}, nil)
 
+	m.proposerClient.EXPECT().PendingExits(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingExitsResponse{}, nil)
+
 	m.proposerClient.EXPECT().ComputeStateRoot(
 		gomock.Any(), // context
 		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
@@ -221,7 +245,7 @@ func TestProposeBlock_UsesEth1Data(t *testing.T) {
 		broadcastedBlock = blk
 	}).Return(&pb.ProposeResponse{}, nil /*error*/)
 
-	validator.ProposeBlock(context.Background(), 55)
+	validator.ProposeBlock(context.Background(), 55, validatorPubKey)
 
 	if !bytes.Equal(broadcastedBlock.Eth1Data.BlockHash32, []byte{'B', 'L', 'O', 'C', 'K'}) {
 		t.Errorf("Unexpected ETH1 data: %v", broadcastedBlock.Eth1Data)
@@ -267,6 +291,11 @@ func TestProposeBlock_PendingAttestations_UsesCurrentSlot(t *testing.T) {
 		return &pb.PendingAttestationsResponse{PendingAttestations: []*pbp2p.Attestation{}}, nil
 	})
 
+	m.proposerClient.EXPECT().PendingExits(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingExitsResponse{}, nil)
+
 	m.proposerClient.EXPECT().ComputeStateRoot(
 		gomock.Any(), // context
 		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
@@ -279,7 +308,7 @@ func TestProposeBlock_PendingAttestations_UsesCurrentSlot(t *testing.T) {
 		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
 	).Return(&pb.ProposeResponse{}, nil /*error*/)
 
-	validator.ProposeBlock(context.Background(), 55)
+	validator.ProposeBlock(context.Background(), 55, validatorPubKey)
 	if req.ProposalBlockSlot != 55 {
 		t.Errorf(
 			"expected request to use the current proposal slot %d, but got %d",
@@ -325,10 +354,53 @@ func TestProposeBlock_PendingAttestationsFailure(t *testing.T) {
 		gomock.AssignableToTypeOf(&pb.PendingAttestationsRequest{}),
 	).Return(nil, errors.New("failed"))
 
-	validator.ProposeBlock(context.Background(), 55)
+	validator.ProposeBlock(context.Background(), 55, validatorPubKey)
 	testutil.AssertLogsContain(t, hook, "Failed to fetch pending attestations")
 }
 
+func TestProposeBlock_PendingExitsFailure(t *testing.T) {
+	hook := logTest.NewGlobal()
+	validator, m, finish := setup(t)
+	defer finish()
+
+	m.beaconClient.EXPECT().CanonicalHead(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.BeaconBlock{}, nil /*err*/)
+
+	m.beaconClient.EXPECT().PendingDeposits(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingDepositsResponse{}, nil /*err*/)
+
+	m.beaconClient.EXPECT().Eth1Data(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.Eth1DataResponse{}, nil /*err*/)
+
+	m.beaconClient.EXPECT().ForkData(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.Fork{
+		Epoch:           params.BeaconConfig().GenesisEpoch,
+		CurrentVersion:  0,
+		PreviousVersion: 0,
+	}, nil /*err*/)
+
+	m.proposerClient.EXPECT().PendingAttestations(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pb.PendingAttestationsRequest{}),
+	).Return(&pb.PendingAttestationsResponse{PendingAttestations: []*pbp2p.Attestation{}}, nil)
+
+	m.proposerClient.EXPECT().PendingExits(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(nil, errors.New("failed"))
+
+	validator.ProposeBlock(context.Background(), 55, validatorPubKey)
+	testutil.AssertLogsContain(t, hook, "Failed to fetch pending exits")
+}
+
 func TestProposeBlock_ComputeStateFailure(t *testing.T) {
 	hook := logTest.NewGlobal()
 	validator, m, finish := setup(t)
@@ -363,12 +435,17 @@ func TestProposeBlock_ComputeStateFailure(t *testing.T) {
 		gomock.AssignableToTypeOf(&pb.PendingAttestationsRequest{}),
 	).Return(&pb.PendingAttestationsResponse{PendingAttestations: []*pbp2p.Attestation{}}, nil)
 
+	m.proposerClient.EXPECT().PendingExits(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingExitsResponse{}, nil)
+
 	m.proposerClient.EXPECT().ComputeStateRoot(
 		gomock.Any(), // context
 		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
 	).Return(nil /*response*/, errors.New("something bad happened"))
 
-	validator.ProposeBlock(context.Background(), 55)
+	validator.ProposeBlock(context.Background(), 55, validatorPubKey)
 	testutil.AssertLogsContain(t, hook, "something bad happened")
 }
 
@@ -405,6 +482,11 @@ func TestProposeBlock_UsesComputedState(t *testing.T) {
 		gomock.AssignableToTypeOf(&pb.PendingAttestationsRequest{}),
 	).Return(&pb.PendingAttestationsResponse{PendingAttestations: []*pbp2p.Attestation{}}, nil)
 
+	m.proposerClient.EXPECT().PendingExits(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingExitsResponse{}, nil)
+
 	var broadcastedBlock *pbp2p.BeaconBlock
 	m.proposerClient.EXPECT().ProposeBlock(
 		gomock.Any(), // ctx
@@ -424,7 +506,7 @@ func TestProposeBlock_UsesComputedState(t *testing.T) {
 		nil, // err
 	)
 
-	validator.ProposeBlock(context.Background(), 55)
+	validator.ProposeBlock(context.Background(), 55, validatorPubKey)
 
 	if !bytes.Equal(broadcastedBlock.StateRootHash32, computedStateRoot) {
 		t.Errorf("Unexpected state root hash. want=%#x got=%#x", computedStateRoot, broadcastedBlock.StateRootHash32)
@@ -464,6 +546,11 @@ func TestProposeBlock_BroadcastsABlock(t *testing.T) {
 		gomock.AssignableToTypeOf(&pb.PendingAttestationsRequest{}),
 	).Return(&pb.PendingAttestationsResponse{PendingAttestations: []*pbp2p.Attestation{}}, nil)
 
+	m.proposerClient.EXPECT().PendingExits(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingExitsResponse{}, nil)
+
 	m.proposerClient.EXPECT().ComputeStateRoot(
 		gomock.Any(), // context
 		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
@@ -476,5 +563,201 @@ func TestProposeBlock_BroadcastsABlock(t *testing.T) {
 		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
 	).Return(&pb.ProposeResponse{}, nil /*error*/)
 
-	validator.ProposeBlock(context.Background(), 55)
+	validator.ProposeBlock(context.Background(), 55, validatorPubKey)
+}
+
+func TestProposeBlock_IncludesPendingExits(t *testing.T) {
+	validator, m, finish := setup(t)
+	defer finish()
+
+	m.beaconClient.EXPECT().CanonicalHead(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.BeaconBlock{}, nil /*err*/)
+
+	m.beaconClient.EXPECT().PendingDeposits(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingDepositsResponse{}, nil /*err*/)
+
+	m.beaconClient.EXPECT().Eth1Data(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.Eth1DataResponse{}, nil /*err*/)
+
+	m.beaconClient.EXPECT().ForkData(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.Fork{
+		Epoch:           params.BeaconConfig().GenesisEpoch,
+		CurrentVersion:  0,
+		PreviousVersion: 0,
+	}, nil /*err*/)
+
+	m.proposerClient.EXPECT().PendingAttestations(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pb.PendingAttestationsRequest{}),
+	).Return(&pb.PendingAttestationsResponse{PendingAttestations: []*pbp2p.Attestation{}}, nil)
+
+	exits := []*pbp2p.VoluntaryExit{{ValidatorIndex: 3}, {ValidatorIndex: 4}}
+	m.proposerClient.EXPECT().PendingExits(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingExitsResponse{PendingExits: exits}, nil)
+
+	m.proposerClient.EXPECT().ComputeStateRoot(
+		gomock.Any(), // context
+		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
+	).Return(&pb.StateRootResponse{
+		StateRoot: []byte{'F'},
+	}, nil /*err*/)
+
+	var broadcastedBlock *pbp2p.BeaconBlock
+	m.proposerClient.EXPECT().ProposeBlock(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
+	).Do(func(_ context.Context, blk *pbp2p.BeaconBlock, _ ...grpc.CallOption) {
+		broadcastedBlock = blk
+	}).Return(&pb.ProposeResponse{}, nil /*error*/)
+
+	validator.ProposeBlock(context.Background(), 55, validatorPubKey)
+	if !reflect.DeepEqual(broadcastedBlock.Body.VoluntaryExits, exits) {
+		t.Errorf("Expected block to include pending exits %v, received %v", exits, broadcastedBlock.Body.VoluntaryExits)
+	}
+}
+
+func TestProposeBlock_SignsBlock(t *testing.T) {
+	validator, m, finish := setup(t)
+	defer finish()
+
+	m.beaconClient.EXPECT().CanonicalHead(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.BeaconBlock{}, nil /*err*/)
+
+	m.beaconClient.EXPECT().PendingDeposits(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingDepositsResponse{}, nil /*err*/)
+
+	m.beaconClient.EXPECT().Eth1Data(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.Eth1DataResponse{}, nil /*err*/)
+
+	fork := &pbp2p.Fork{
+		Epoch:           params.BeaconConfig().GenesisEpoch,
+		CurrentVersion:  0,
+		PreviousVersion: 0,
+	}
+	m.beaconClient.EXPECT().ForkData(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(fork, nil /*err*/)
+
+	m.proposerClient.EXPECT().PendingAttestations(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pb.PendingAttestationsRequest{}),
+	).Return(&pb.PendingAttestationsResponse{PendingAttestations: []*pbp2p.Attestation{}}, nil)
+
+	m.proposerClient.EXPECT().PendingExits(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingExitsResponse{}, nil)
+
+	m.proposerClient.EXPECT().ComputeStateRoot(
+		gomock.Any(), // context
+		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
+	).Return(&pb.StateRootResponse{
+		StateRoot: []byte{'F'},
+	}, nil /*err*/)
+
+	var broadcastedBlock *pbp2p.BeaconBlock
+	m.proposerClient.EXPECT().ProposeBlock(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
+	).Do(func(_ context.Context, blk *pbp2p.BeaconBlock) {
+		broadcastedBlock = blk
+	}).Return(&pb.ProposeResponse{}, nil /*error*/)
+
+	slot := uint64(55)
+	validator.ProposeBlock(context.Background(), slot, validatorPubKey)
+
+	root, err := ssz.SigningRoot(broadcastedBlock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := bls.SignatureFromBytes(broadcastedBlock.Signature)
+	if err != nil {
+		t.Fatalf("Could not deserialize block signature: %v", err)
+	}
+	domain := forkutil.DomainVersion(fork, slot/params.BeaconConfig().SlotsPerEpoch, params.BeaconConfig().DomainProposal)
+	if !sig.Verify(root[:], validatorKey.PublicKey, domain) {
+		t.Error("Expected block signature to verify against the signing root of the block")
+	}
+}
+
+func TestProposeBlock_SlashingProtectionRefusesDoubleProposal(t *testing.T) {
+	hook := logTest.NewGlobal()
+	validator, m, finish := setup(t)
+	defer finish()
+
+	// Another block was already signed in the epoch of slot 55.
+	if _, err := validator.signer.SignBlock(
+		context.Background(),
+		validatorKey.PublicKey.Marshal(),
+		&pbp2p.BeaconBlock{Slot: 54},
+		0, // domain
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	m.beaconClient.EXPECT().CanonicalHead(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.BeaconBlock{}, nil /*err*/)
+
+	m.beaconClient.EXPECT().PendingDeposits(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingDepositsResponse{}, nil /*err*/)
+
+	m.beaconClient.EXPECT().Eth1Data(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.Eth1DataResponse{}, nil /*err*/)
+
+	m.beaconClient.EXPECT().ForkData(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.Fork{
+		Epoch:           params.BeaconConfig().GenesisEpoch,
+		CurrentVersion:  0,
+		PreviousVersion: 0,
+	}, nil /*err*/)
+
+	m.proposerClient.EXPECT().PendingAttestations(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pb.PendingAttestationsRequest{}),
+	).Return(&pb.PendingAttestationsResponse{PendingAttestations: []*pbp2p.Attestation{}}, nil)
+
+	m.proposerClient.EXPECT().PendingExits(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingExitsResponse{}, nil)
+
+	m.proposerClient.EXPECT().ComputeStateRoot(
+		gomock.Any(), // context
+		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
+	).Return(&pb.StateRootResponse{
+		StateRoot: []byte{'F'},
+	}, nil /*err*/)
+
+	m.proposerClient.EXPECT().ProposeBlock(
+		gomock.Any(), // ctx
+		gomock.Any(),
+	).Times(0)
+
+	validator.ProposeBlock(context.Background(), 55, validatorPubKey)
+	testutil.AssertLogsContain(t, hook, "slashing protection refused the block")
 }
@@ -2,8 +2,11 @@ package client
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"io/ioutil"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -12,9 +15,11 @@ import (
 	"github.com/golang/mock/gomock"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/validator/internal"
+	"github.com/prysmaticlabs/prysm/validator/signer"
 	"github.com/sirupsen/logrus"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
@@ -32,7 +37,6 @@ func TestWaitForChainStart_SetsChainStartGenesisTime(t *testing.T) {
 	client := internal.NewMockBeaconServiceClient(ctrl)
 
 	v := validator{
-		key:          validatorKey,
 		beaconClient: client,
 	}
 	genesis := uint64(time.Unix(0, 0).Unix())
@@ -65,7 +69,6 @@ func TestWaitForChainStart_ContextCanceled(t *testing.T) {
 	client := internal.NewMockBeaconServiceClient(ctrl)
 
 	v := validator{
-		key:          validatorKey,
 		beaconClient: client,
 	}
 	genesis := uint64(time.Unix(0, 0).Unix())
@@ -96,7 +99,6 @@ func TestWaitForChainStart_StreamSetupFails(t *testing.T) {
 	client := internal.NewMockBeaconServiceClient(ctrl)
 
 	v := validator{
-		key:          validatorKey,
 		beaconClient: client,
 	}
 	clientStream := internal.NewMockBeaconService_WaitForChainStartClient(ctrl)
@@ -117,7 +119,6 @@ func TestWaitForChainStart_ReceiveErrorFromStream(t *testing.T) {
 	client := internal.NewMockBeaconServiceClient(ctrl)
 
 	v := validator{
-		key:          validatorKey,
 		beaconClient: client,
 	}
 	clientStream := internal.NewMockBeaconService_WaitForChainStartClient(ctrl)
@@ -141,21 +142,22 @@ func TestWaitActivation_ContextCanceled(t *testing.T) {
 	defer ctrl.Finish()
 	client := internal.NewMockValidatorServiceClient(ctrl)
 
-	v := validator{
-		key:             validatorKey,
-		validatorClient: client,
-	}
+	v := newValidator(signer.NewLocalSigner([]*keystore.Key{validatorKey}, nil))
+	v.validatorClient = client
 	clientStream := internal.NewMockValidatorService_WaitForActivationClient(ctrl)
 	client.EXPECT().WaitForActivation(
 		gomock.Any(),
 		&pb.ValidatorActivationRequest{
-			Pubkey: v.key.PublicKey.Marshal(),
+			PublicKeys: v.pubkeys,
 		},
 	).Return(clientStream, nil)
 	clientStream.EXPECT().Recv().Return(
 		&pb.ValidatorActivationResponse{
-			Validator: &pbp2p.Validator{
-				ActivationEpoch: params.BeaconConfig().GenesisEpoch,
+			Validators: []*pbp2p.Validator{
+				{
+					Pubkey:          validatorKey.PublicKey.Marshal(),
+					ActivationEpoch: params.BeaconConfig().GenesisEpoch,
+				},
 			},
 		},
 		nil,
@@ -174,15 +176,13 @@ func TestWaitActivation_StreamSetupFails(t *testing.T) {
 	defer ctrl.Finish()
 	client := internal.NewMockValidatorServiceClient(ctrl)
 
-	v := validator{
-		key:             validatorKey,
-		validatorClient: client,
-	}
+	v := newValidator(signer.NewLocalSigner([]*keystore.Key{validatorKey}, nil))
+	v.validatorClient = client
 	clientStream := internal.NewMockValidatorService_WaitForActivationClient(ctrl)
 	client.EXPECT().WaitForActivation(
 		gomock.Any(),
 		&pb.ValidatorActivationRequest{
-			Pubkey: v.key.PublicKey.Marshal(),
+			PublicKeys: v.pubkeys,
 		},
 	).Return(clientStream, errors.New("failed stream"))
 	err := v.WaitForActivation(context.Background())
@@ -197,15 +197,13 @@ func TestWaitActivation_ReceiveErrorFromStream(t *testing.T) {
 	defer ctrl.Finish()
 	client := internal.NewMockValidatorServiceClient(ctrl)
 
-	v := validator{
-		key:             validatorKey,
-		validatorClient: client,
-	}
+	v := newValidator(signer.NewLocalSigner([]*keystore.Key{validatorKey}, nil))
+	v.validatorClient = client
 	clientStream := internal.NewMockValidatorService_WaitForActivationClient(ctrl)
 	client.EXPECT().WaitForActivation(
 		gomock.Any(),
 		&pb.ValidatorActivationRequest{
-			Pubkey: v.key.PublicKey.Marshal(),
+			PublicKeys: v.pubkeys,
 		},
 	).Return(clientStream, nil)
 	clientStream.EXPECT().Recv().Return(
@@ -225,21 +223,22 @@ func TestWaitActivation_LogsActivationEpochOK(t *testing.T) {
 	defer ctrl.Finish()
 	client := internal.NewMockValidatorServiceClient(ctrl)
 
-	v := validator{
-		key:             validatorKey,
-		validatorClient: client,
-	}
+	v := newValidator(signer.NewLocalSigner([]*keystore.Key{validatorKey}, nil))
+	v.validatorClient = client
 	clientStream := internal.NewMockValidatorService_WaitForActivationClient(ctrl)
 	client.EXPECT().WaitForActivation(
 		gomock.Any(),
 		&pb.ValidatorActivationRequest{
-			Pubkey: v.key.PublicKey.Marshal(),
+			PublicKeys: v.pubkeys,
 		},
 	).Return(clientStream, nil)
 	clientStream.EXPECT().Recv().Return(
 		&pb.ValidatorActivationResponse{
-			Validator: &pbp2p.Validator{
-				ActivationEpoch: params.BeaconConfig().GenesisEpoch,
+			Validators: []*pbp2p.Validator{
+				{
+					Pubkey:          validatorKey.PublicKey.Marshal(),
+					ActivationEpoch: params.BeaconConfig().GenesisEpoch,
+				},
 			},
 		},
 		nil,
@@ -256,13 +255,16 @@ func TestUpdateAssignments_DoesNothingWhenNotEpochStartAndAlreadyExistingAssignm
 	client := internal.NewMockValidatorServiceClient(ctrl)
 
 	slot := uint64(1)
-	v := validator{
-		key:             validatorKey,
-		validatorClient: client,
-		assignment: &pb.CommitteeAssignmentResponse{
-			Committee: []uint64{},
-			Slot:      10,
-			Shard:     20,
+	v := newValidator(signer.NewLocalSigner([]*keystore.Key{validatorKey}, nil))
+	v.validatorClient = client
+	v.assignments = &pb.CommitteeAssignmentResponse{
+		Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
+			{
+				Committee: []uint64{},
+				Slot:      10,
+				Shard:     20,
+				PublicKey: validatorKey.PublicKey.Marshal(),
+			},
 		},
 	}
 	client.EXPECT().CommitteeAssignment(
@@ -280,10 +282,12 @@ func TestUpdateAssignments_ReturnsError(t *testing.T) {
 	defer ctrl.Finish()
 	client := internal.NewMockValidatorServiceClient(ctrl)
 
-	v := validator{
-		key:             validatorKey,
-		validatorClient: client,
-		assignment:      &pb.CommitteeAssignmentResponse{Shard: 1},
+	v := newValidator(signer.NewLocalSigner([]*keystore.Key{validatorKey}, nil))
+	v.validatorClient = client
+	v.assignments = &pb.CommitteeAssignmentResponse{
+		Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
+			{Shard: 1},
+		},
 	}
 
 	expected := errors.New("bad")
@@ -296,7 +300,7 @@ func TestUpdateAssignments_ReturnsError(t *testing.T) {
 	if err := v.UpdateAssignments(context.Background(), params.BeaconConfig().SlotsPerEpoch); err != expected {
 		t.Errorf("Bad error; want=%v got=%v", expected, err)
 	}
-	if v.assignment != nil {
+	if v.assignments != nil {
 		t.Error("Assignments should have been cleared on failure")
 	}
 }
@@ -307,32 +311,112 @@ func TestUpdateAssignments_OK(t *testing.T) {
 	client := internal.NewMockValidatorServiceClient(ctrl)
 
 	slot := params.BeaconConfig().SlotsPerEpoch
+	pubKey := validatorKey.PublicKey.Marshal()
 	resp := &pb.CommitteeAssignmentResponse{
-		Slot:       params.BeaconConfig().SlotsPerEpoch,
-		Shard:      100,
-		Committee:  []uint64{0, 1, 2, 3},
-		IsProposer: true,
-	}
-	v := validator{
-		key:             validatorKey,
-		validatorClient: client,
+		Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
+			{
+				Slot:       params.BeaconConfig().SlotsPerEpoch,
+				Shard:      100,
+				Committee:  []uint64{0, 1, 2, 3},
+				IsProposer: true,
+				PublicKey:  pubKey,
+			},
+		},
 	}
+	v := newValidator(signer.NewLocalSigner([]*keystore.Key{validatorKey}, nil))
+	v.validatorClient = client
 	client.EXPECT().CommitteeAssignment(
 		gomock.Any(),
-		gomock.Any(),
+		&pb.ValidatorEpochAssignmentsRequest{
+			EpochStart: slot,
+			PublicKeys: [][]byte{pubKey},
+		},
 	).Return(resp, nil)
 
 	if err := v.UpdateAssignments(context.Background(), slot); err != nil {
 		t.Fatalf("Could not update assignments: %v", err)
 	}
 
-	if v.assignment.Slot != params.BeaconConfig().SlotsPerEpoch {
-		t.Errorf("Unexpected validator assignments. want=%v got=%v", params.BeaconConfig().SlotsPerEpoch, v.assignment.Slot)
+	assignment := v.assignment(hex.EncodeToString(pubKey))
+	if assignment == nil {
+		t.Fatal("Expected an assignment for the validator key")
+	}
+	if assignment.Slot != params.BeaconConfig().SlotsPerEpoch {
+		t.Errorf("Unexpected validator assignments. want=%v got=%v", params.BeaconConfig().SlotsPerEpoch, assignment.Slot)
 	}
-	if v.assignment.Shard != resp.Shard {
-		t.Errorf("Unexpected validator assignments. want=%v got=%v", resp.Shard, v.assignment.Slot)
+	if assignment.Shard != 100 {
+		t.Errorf("Unexpected validator assignments. want=%v got=%v", 100, assignment.Shard)
 	}
-	if !v.assignment.IsProposer {
+	if !assignment.IsProposer {
 		t.Errorf("Unexpected validator assignments. want: proposer=true")
 	}
 }
+
+func TestUpdateAssignments_RequestsAllKeysAtOnce(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := internal.NewMockValidatorServiceClient(ctrl)
+
+	otherKey, err := keystore.NewKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := newValidator(signer.NewLocalSigner([]*keystore.Key{validatorKey, otherKey}, nil))
+	v.validatorClient = client
+	client.EXPECT().CommitteeAssignment(
+		gomock.Any(),
+		&pb.ValidatorEpochAssignmentsRequest{
+			EpochStart: params.BeaconConfig().GenesisSlot,
+			PublicKeys: [][]byte{validatorKey.PublicKey.Marshal(), otherKey.PublicKey.Marshal()},
+		},
+	).Times(1).Return(&pb.CommitteeAssignmentResponse{}, nil)
+
+	if err := v.UpdateAssignments(context.Background(), params.BeaconConfig().GenesisSlot); err != nil {
+		t.Fatalf("Could not update assignments: %v", err)
+	}
+}
+
+func TestRolesAt_OK(t *testing.T) {
+	proposer, err := keystore.NewKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	attester, err := keystore.NewKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	both, err := keystore.NewKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	idle, err := keystore.NewKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := newValidator(signer.NewLocalSigner([]*keystore.Key{proposer, attester, both, idle}, nil))
+	v.assignments = &pb.CommitteeAssignmentResponse{
+		Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
+			{Slot: 1, Committee: []uint64{0, 1}, IsProposer: true, PublicKey: proposer.PublicKey.Marshal()},
+			{Slot: 1, Committee: []uint64{0, 1}, PublicKey: attester.PublicKey.Marshal()},
+			{Slot: 1, Committee: []uint64{2}, IsProposer: true, PublicKey: both.PublicKey.Marshal()},
+			{Slot: 2, Committee: []uint64{3, 4}, PublicKey: idle.PublicKey.Marshal()},
+		},
+	}
+
+	roles := v.RolesAt(1)
+	want := map[string]pb.ValidatorRole{
+		hex.EncodeToString(proposer.PublicKey.Marshal()): pb.ValidatorRole_PROPOSER,
+		hex.EncodeToString(attester.PublicKey.Marshal()): pb.ValidatorRole_ATTESTER,
+		hex.EncodeToString(both.PublicKey.Marshal()):     pb.ValidatorRole_BOTH,
+	}
+	if !reflect.DeepEqual(roles, want) {
+		t.Errorf("Unexpected roles. want=%v got=%v", want, roles)
+	}
+}
+
+func TestRolesAt_NoAssignments(t *testing.T) {
+	v := newValidator(signer.NewLocalSigner([]*keystore.Key{validatorKey}, nil))
+	if roles := v.RolesAt(1); len(roles) != 0 {
+		t.Errorf("Expected no roles without assignments, received %v", roles)
+	}
+}
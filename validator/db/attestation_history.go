@@ -0,0 +1,98 @@
+package db
+
+import (
+	"errors"
+
+	"github.com/boltdb/bolt"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/attestations"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+var (
+	// ErrDoubleVote is returned when a validator key would sign a second, different
+	// attestation with the target epoch of an attestation it already signed.
+	ErrDoubleVote = errors.New("a different attestation was already signed with the target epoch of the attestation")
+	// ErrSurroundVote is returned when a validator key would sign an attestation which
+	// surrounds, or is surrounded by, an attestation it already signed.
+	ErrSurroundVote = errors.New("the attestation surrounds or is surrounded by an attestation already signed")
+)
+
+// Attestation is an attestation signed by a validator key, voting from the justified
+// source epoch to the target epoch.
+type Attestation struct {
+	SourceEpoch uint64
+	TargetEpoch uint64
+	SigningRoot [32]byte
+}
+
+// data returns the attestation data fields which its slashing conditions depend on.
+func (a *Attestation) data() *pbp2p.AttestationData {
+	return &pbp2p.AttestationData{
+		Slot:           a.TargetEpoch * params.BeaconConfig().SlotsPerEpoch,
+		JustifiedEpoch: a.SourceEpoch,
+	}
+}
+
+// SaveAttestation records that the validator key signs the attestation data with the
+// signing root, unless that would be slashable. It returns ErrDoubleVote or
+// ErrSurroundVote, and records nothing, if the attestation would be a double vote or a
+// surround vote with an attestation the key already signed. Signing the same attestation
+// again is allowed. The attestation must be saved before it is signed, so that it is never
+// signed without being in the history.
+func (db *ValidatorDB) SaveAttestation(pubKey []byte, data *pbp2p.AttestationData, signingRoot [32]byte) error {
+	return db.update(func(tx *bolt.Tx) error {
+		history, err := tx.Bucket(attestationHistoryBucket).CreateBucketIfNotExists(pubKey)
+		if err != nil {
+			return err
+		}
+		err = history.ForEach(func(target, enc []byte) error {
+			source, root := decodeHistoryEntry(enc)
+			signed := (&Attestation{SourceEpoch: source, TargetEpoch: decodeEpoch(target)}).data()
+			if attestations.IsDoubleVote(signed, data) {
+				if root == signingRoot {
+					return errAlreadySigned
+				}
+				return ErrDoubleVote
+			}
+			if attestations.IsSurroundVote(signed, data) || attestations.IsSurroundVote(data, signed) {
+				return ErrSurroundVote
+			}
+			return nil
+		})
+		if err == errAlreadySigned {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		targetEpoch := data.Slot / params.BeaconConfig().SlotsPerEpoch
+		return history.Put(encodeEpoch(targetEpoch), encodeHistoryEntry(data.JustifiedEpoch, signingRoot))
+	})
+}
+
+// errAlreadySigned stops the search for slashable attestations when the attestation
+// is already in the history.
+var errAlreadySigned = errors.New("attestation already signed")
+
+// AttestationHistory returns the attestations signed by the validator key, ordered by
+// target epoch.
+func (db *ValidatorDB) AttestationHistory(pubKey []byte) ([]*Attestation, error) {
+	var atts []*Attestation
+	err := db.view(func(tx *bolt.Tx) error {
+		history := tx.Bucket(attestationHistoryBucket).Bucket(pubKey)
+		if history == nil {
+			return nil
+		}
+		return history.ForEach(func(target, enc []byte) error {
+			source, signingRoot := decodeHistoryEntry(enc)
+			atts = append(atts, &Attestation{
+				SourceEpoch: source,
+				TargetEpoch: decodeEpoch(target),
+				SigningRoot: signingRoot,
+			})
+			return nil
+		})
+	})
+	return atts, err
+}
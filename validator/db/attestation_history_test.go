@@ -0,0 +1,63 @@
+package db
+
+import (
+	"testing"
+
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func attestationData(source uint64, target uint64) *pbp2p.AttestationData {
+	return &pbp2p.AttestationData{
+		Slot:           target*params.BeaconConfig().SlotsPerEpoch + 1,
+		JustifiedEpoch: source,
+	}
+}
+
+func TestSaveAttestation_SlashableVotes(t *testing.T) {
+	db := setupDB(t)
+	defer TeardownDB(db)
+
+	if err := db.SaveAttestation(testPubKey, attestationData(3, 5), [32]byte{1}); err != nil {
+		t.Fatalf("Could not save attestation: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		source uint64
+		target uint64
+		root   [32]byte
+		err    error
+	}{
+		{name: "same attestation", source: 3, target: 5, root: [32]byte{1}},
+		{name: "double vote", source: 3, target: 5, root: [32]byte{2}, err: ErrDoubleVote},
+		{name: "double vote from another source", source: 4, target: 5, root: [32]byte{2}, err: ErrDoubleVote},
+		{name: "surrounding vote", source: 2, target: 6, root: [32]byte{3}, err: ErrSurroundVote},
+		{name: "surrounded vote", source: 4, target: 4, root: [32]byte{4}, err: ErrSurroundVote},
+		{name: "next vote", source: 5, target: 6, root: [32]byte{5}},
+		{name: "vote after the next vote", source: 6, target: 7, root: [32]byte{6}},
+	}
+	for _, tt := range tests {
+		if err := db.SaveAttestation(testPubKey, attestationData(tt.source, tt.target), tt.root); err != tt.err {
+			t.Errorf("%s: expected %v, received %v", tt.name, tt.err, err)
+		}
+	}
+
+	atts, err := db.AttestationHistory(testPubKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*Attestation{
+		{SourceEpoch: 3, TargetEpoch: 5, SigningRoot: [32]byte{1}},
+		{SourceEpoch: 5, TargetEpoch: 6, SigningRoot: [32]byte{5}},
+		{SourceEpoch: 6, TargetEpoch: 7, SigningRoot: [32]byte{6}},
+	}
+	if len(atts) != len(want) {
+		t.Fatalf("Expected %d attestations, received %d", len(want), len(atts))
+	}
+	for i := range want {
+		if *atts[i] != *want[i] {
+			t.Errorf("Unexpected attestation %d. want=%v got=%v", i, want[i], atts[i])
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Package db defines the validator client's local database, which keeps the
+// history of the proposals and attestations signed by its keys to protect
+// them from being slashed.
+package db
+
+import (
+	"errors"
+	"os"
+	"path"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "validatordb")
+
+// ValidatorDB manages the data layer of the validator client.
+type ValidatorDB struct {
+	db           *bolt.DB
+	DatabasePath string
+}
+
+// Close closes the underlying boltdb database.
+func (db *ValidatorDB) Close() error {
+	return db.db.Close()
+}
+
+func (db *ValidatorDB) update(fn func(*bolt.Tx) error) error {
+	return db.db.Update(fn)
+}
+
+func (db *ValidatorDB) view(fn func(*bolt.Tx) error) error {
+	return db.db.View(fn)
+}
+
+func createBuckets(tx *bolt.Tx, buckets ...[]byte) error {
+	for _, bucket := range buckets {
+		if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewDB initializes a new DB at the directory path.
+func NewDB(dirPath string) (*ValidatorDB, error) {
+	if err := os.MkdirAll(dirPath, 0700); err != nil {
+		return nil, err
+	}
+	datafile := path.Join(dirPath, "validator.db")
+	boltDB, err := bolt.Open(datafile, 0600, &bolt.Options{Timeout: 1 * time.Second})
+	if err != nil {
+		if err == bolt.ErrTimeout {
+			// Two validator clients sharing a database would also share their keys,
+			// which is how validators get slashed.
+			return nil, errors.New("cannot obtain database lock, database may be in use by another validator client")
+		}
+		return nil, err
+	}
+
+	db := &ValidatorDB{db: boltDB, DatabasePath: dirPath}
+
+	if err := db.update(func(tx *bolt.Tx) error {
+		return createBuckets(tx, proposalHistoryBucket, attestationHistoryBucket)
+	}); err != nil {
+		return nil, err
+	}
+
+	return db, err
+}
@@ -0,0 +1,22 @@
+package db
+
+import (
+	"testing"
+)
+
+// setupDB instantiates and returns a ValidatorDB instance.
+func setupDB(t testing.TB) *ValidatorDB {
+	db, err := SetupDB()
+	if err != nil {
+		t.Fatalf("Failed to instantiate DB: %v", err)
+	}
+	return db
+}
+
+func TestNewDB_Locked(t *testing.T) {
+	db := setupDB(t)
+	defer TeardownDB(db)
+	if _, err := NewDB(db.DatabasePath); err == nil {
+		t.Error("Expected opening a database in use to fail, received nil")
+	}
+}
@@ -0,0 +1,223 @@
+package db
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/boltdb/bolt"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// interchangeVersion is the version of the slashing protection history format of
+// Export and Import.
+const interchangeVersion = 1
+
+// interchangeJSON is the slashing protection history of validator keys, as exported
+// to be imported by another validator client. A history entry without a signing root
+// means that an unknown object was signed, so that nothing else can be signed at its
+// epoch.
+type interchangeJSON struct {
+	Version uint                  `json:"version"`
+	Data    []*interchangeKeyJSON `json:"data"`
+}
+
+type interchangeKeyJSON struct {
+	PublicKey          string                   `json:"pubkey"`
+	SignedBlocks       []*signedBlockJSON       `json:"signed_blocks"`
+	SignedAttestations []*signedAttestationJSON `json:"signed_attestations"`
+}
+
+type signedBlockJSON struct {
+	Slot        uint64 `json:"slot,string"`
+	SigningRoot string `json:"signing_root,omitempty"`
+}
+
+type signedAttestationJSON struct {
+	SourceEpoch uint64 `json:"source_epoch,string"`
+	TargetEpoch uint64 `json:"target_epoch,string"`
+	SigningRoot string `json:"signing_root,omitempty"`
+}
+
+// Export writes the slashing protection history of all the validator keys in the
+// database as JSON, so that the keys can be moved to another validator client
+// without being slashed.
+func (db *ValidatorDB) Export(w io.Writer) error {
+	pubKeys, err := db.publicKeys()
+	if err != nil {
+		return fmt.Errorf("could not read validator keys: %v", err)
+	}
+	interchange := &interchangeJSON{
+		Version: interchangeVersion,
+		Data:    make([]*interchangeKeyJSON, 0, len(pubKeys)),
+	}
+	for _, pubKey := range pubKeys {
+		proposals, err := db.ProposalHistory(pubKey)
+		if err != nil {
+			return fmt.Errorf("could not read proposal history: %v", err)
+		}
+		atts, err := db.AttestationHistory(pubKey)
+		if err != nil {
+			return fmt.Errorf("could not read attestation history: %v", err)
+		}
+		keyHistory := &interchangeKeyJSON{
+			PublicKey:          fmt.Sprintf("%#x", pubKey),
+			SignedBlocks:       make([]*signedBlockJSON, len(proposals)),
+			SignedAttestations: make([]*signedAttestationJSON, len(atts)),
+		}
+		for i, p := range proposals {
+			keyHistory.SignedBlocks[i] = &signedBlockJSON{
+				Slot:        p.Slot,
+				SigningRoot: encodeSigningRoot(p.SigningRoot),
+			}
+		}
+		for i, a := range atts {
+			keyHistory.SignedAttestations[i] = &signedAttestationJSON{
+				SourceEpoch: a.SourceEpoch,
+				TargetEpoch: a.TargetEpoch,
+				SigningRoot: encodeSigningRoot(a.SigningRoot),
+			}
+		}
+		interchange.Data = append(interchange.Data, keyHistory)
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(interchange)
+}
+
+// Import merges the slashing protection history written by Export into the database.
+// An imported entry which conflicts with the history of the database, because the key
+// signed different objects in the same epoch, is kept without a signing root, so that
+// the key signs nothing else in that epoch.
+func (db *ValidatorDB) Import(r io.Reader) error {
+	interchange := &interchangeJSON{}
+	if err := json.NewDecoder(r).Decode(interchange); err != nil {
+		return fmt.Errorf("could not decode slashing protection history: %v", err)
+	}
+	if interchange.Version != interchangeVersion {
+		return fmt.Errorf("unsupported slashing protection history version %d", interchange.Version)
+	}
+	// Decode everything first, so that nothing is imported from an invalid history.
+	type keyEntries struct {
+		pubKey    []byte
+		proposals map[uint64][]byte
+		atts      map[uint64][]byte
+	}
+	keys := make([]*keyEntries, len(interchange.Data))
+	for i, keyHistory := range interchange.Data {
+		pubKey, err := hex.DecodeString(strings.TrimPrefix(keyHistory.PublicKey, "0x"))
+		if err != nil || len(pubKey) != params.BeaconConfig().BLSPubkeyLength {
+			return fmt.Errorf("invalid public key %q", keyHistory.PublicKey)
+		}
+		entries := &keyEntries{
+			pubKey:    pubKey,
+			proposals: make(map[uint64][]byte),
+			atts:      make(map[uint64][]byte),
+		}
+		for _, b := range keyHistory.SignedBlocks {
+			signingRoot, err := decodeSigningRoot(b.SigningRoot)
+			if err != nil {
+				return err
+			}
+			epoch := b.Slot / params.BeaconConfig().SlotsPerEpoch
+			entries.proposals[epoch] = mergeHistoryEntry(entries.proposals[epoch], encodeHistoryEntry(b.Slot, signingRoot))
+		}
+		for _, a := range keyHistory.SignedAttestations {
+			signingRoot, err := decodeSigningRoot(a.SigningRoot)
+			if err != nil {
+				return err
+			}
+			entries.atts[a.TargetEpoch] = mergeHistoryEntry(entries.atts[a.TargetEpoch], encodeHistoryEntry(a.SourceEpoch, signingRoot))
+		}
+		keys[i] = entries
+	}
+	return db.update(func(tx *bolt.Tx) error {
+		for _, entries := range keys {
+			if err := importHistory(tx.Bucket(proposalHistoryBucket), entries.pubKey, entries.proposals); err != nil {
+				return err
+			}
+			if err := importHistory(tx.Bucket(attestationHistoryBucket), entries.pubKey, entries.atts); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// importHistory merges the history entries, keyed by epoch, into the history of the
+// public key in the bucket.
+func importHistory(bucket *bolt.Bucket, pubKey []byte, entries map[uint64][]byte) error {
+	history, err := bucket.CreateBucketIfNotExists(pubKey)
+	if err != nil {
+		return err
+	}
+	for epoch, enc := range entries {
+		key := encodeEpoch(epoch)
+		if err := history.Put(key, mergeHistoryEntry(history.Get(key), enc)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergeHistoryEntry returns the history entry of an epoch in which both entries were
+// signed. Different entries are merged into the existing one without a signing root.
+func mergeHistoryEntry(existing []byte, enc []byte) []byte {
+	if existing == nil || bytes.Equal(existing, enc) {
+		return enc
+	}
+	number, _ := decodeHistoryEntry(existing)
+	return encodeHistoryEntry(number, [32]byte{})
+}
+
+// publicKeys returns the public keys with a proposal or attestation history, sorted.
+func (db *ValidatorDB) publicKeys() ([][]byte, error) {
+	seen := make(map[string]bool)
+	var pubKeys [][]byte
+	err := db.view(func(tx *bolt.Tx) error {
+		for _, bucket := range [][]byte{proposalHistoryBucket, attestationHistoryBucket} {
+			if err := tx.Bucket(bucket).ForEach(func(pubKey, _ []byte) error {
+				if !seen[string(pubKey)] {
+					seen[string(pubKey)] = true
+					pubKeys = append(pubKeys, append([]byte{}, pubKey...))
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	sort.Slice(pubKeys, func(i, j int) bool {
+		return bytes.Compare(pubKeys[i], pubKeys[j]) < 0
+	})
+	return pubKeys, err
+}
+
+// encodeSigningRoot returns the hex encoding of a signing root, or an empty string
+// if the signing root is unknown.
+func encodeSigningRoot(signingRoot [32]byte) string {
+	if signingRoot == [32]byte{} {
+		return ""
+	}
+	return fmt.Sprintf("%#x", signingRoot)
+}
+
+// decodeSigningRoot decodes a hex encoded signing root, or returns the unknown
+// signing root if it is empty.
+func decodeSigningRoot(enc string) ([32]byte, error) {
+	var signingRoot [32]byte
+	if enc == "" {
+		return signingRoot, nil
+	}
+	b, err := hex.DecodeString(strings.TrimPrefix(enc, "0x"))
+	if err != nil || len(b) != len(signingRoot) {
+		return signingRoot, fmt.Errorf("invalid signing root %q", enc)
+	}
+	copy(signingRoot[:], b)
+	return signingRoot, nil
+}
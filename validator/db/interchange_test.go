@@ -0,0 +1,93 @@
+package db
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestExportImport_RoundTrip(t *testing.T) {
+	db := setupDB(t)
+	defer TeardownDB(db)
+	imported := setupDB(t)
+	defer TeardownDB(imported)
+
+	slot := params.BeaconConfig().GenesisSlot + 1
+	if err := db.SaveProposal(testPubKey, slot, [32]byte{1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveAttestation(testPubKey, attestationData(3, 5), [32]byte{2}); err != nil {
+		t.Fatal(err)
+	}
+
+	buf := new(bytes.Buffer)
+	if err := db.Export(buf); err != nil {
+		t.Fatalf("Could not export history: %v", err)
+	}
+	if err := imported.Import(bytes.NewReader(buf.Bytes())); err != nil {
+		t.Fatalf("Could not import history: %v", err)
+	}
+
+	// The imported history protects the key as the exported one did.
+	if err := imported.SaveProposal(testPubKey, slot, [32]byte{3}); err != ErrDoubleProposal {
+		t.Errorf("Expected %v, received %v", ErrDoubleProposal, err)
+	}
+	if err := imported.SaveAttestation(testPubKey, attestationData(2, 6), [32]byte{4}); err != ErrSurroundVote {
+		t.Errorf("Expected %v, received %v", ErrSurroundVote, err)
+	}
+	if err := imported.SaveAttestation(testPubKey, attestationData(3, 5), [32]byte{2}); err != nil {
+		t.Errorf("Expected the same attestation to be allowed, received %v", err)
+	}
+}
+
+func TestImport_ConflictingHistory(t *testing.T) {
+	db := setupDB(t)
+	defer TeardownDB(db)
+
+	if err := db.SaveAttestation(testPubKey, attestationData(3, 5), [32]byte{1}); err != nil {
+		t.Fatal(err)
+	}
+	other := setupDB(t)
+	defer TeardownDB(other)
+	if err := other.SaveAttestation(testPubKey, attestationData(3, 5), [32]byte{2}); err != nil {
+		t.Fatal(err)
+	}
+	buf := new(bytes.Buffer)
+	if err := other.Export(buf); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Import(buf); err != nil {
+		t.Fatalf("Could not import history: %v", err)
+	}
+
+	// Neither attestation may be signed again, as the key signed both.
+	for _, root := range [][32]byte{{1}, {2}} {
+		if err := db.SaveAttestation(testPubKey, attestationData(3, 5), root); err != ErrDoubleVote {
+			t.Errorf("Expected %v, received %v", ErrDoubleVote, err)
+		}
+	}
+}
+
+func TestImport_InvalidHistory(t *testing.T) {
+	db := setupDB(t)
+	defer TeardownDB(db)
+
+	tests := []struct {
+		history string
+		want    string
+	}{
+		{history: `{"version": 2, "data": []}`, want: "unsupported slashing protection history version"},
+		{history: `{"version": 1, "data": [{"pubkey": "0x01"}]}`, want: "invalid public key"},
+		{
+			history: `{"version": 1, "data": [{"pubkey": "0x` + strings.Repeat("00", params.BeaconConfig().BLSPubkeyLength) + `", "signed_blocks": [{"slot": "1", "signing_root": "0x01"}]}]}`,
+			want:    "invalid signing root",
+		},
+	}
+	for _, tt := range tests {
+		if err := db.Import(strings.NewReader(tt.history)); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("Expected error %q, received %v", tt.want, err)
+		}
+	}
+}
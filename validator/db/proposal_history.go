@@ -0,0 +1,57 @@
+package db
+
+import (
+	"errors"
+
+	"github.com/boltdb/bolt"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// ErrDoubleProposal is returned when a validator key would sign a second, different
+// block in an epoch in which it already proposed a block.
+var ErrDoubleProposal = errors.New("a different block was already signed in the epoch of the block")
+
+// Proposal is a block proposal signed by a validator key.
+type Proposal struct {
+	Slot        uint64
+	SigningRoot [32]byte
+}
+
+// SaveProposal records that the validator key signs the block with the signing root at
+// the slot, unless that would be slashable. It returns ErrDoubleProposal, and records
+// nothing, if the key already signed a different block in the epoch of the slot.
+// Signing the same block again is allowed. The proposal must be saved before the block
+// is signed, so that it is never signed without being in the history.
+func (db *ValidatorDB) SaveProposal(pubKey []byte, slot uint64, signingRoot [32]byte) error {
+	return db.update(func(tx *bolt.Tx) error {
+		history, err := tx.Bucket(proposalHistoryBucket).CreateBucketIfNotExists(pubKey)
+		if err != nil {
+			return err
+		}
+		epoch := encodeEpoch(slot / params.BeaconConfig().SlotsPerEpoch)
+		if enc := history.Get(epoch); enc != nil {
+			if _, root := decodeHistoryEntry(enc); root == signingRoot {
+				return nil
+			}
+			return ErrDoubleProposal
+		}
+		return history.Put(epoch, encodeHistoryEntry(slot, signingRoot))
+	})
+}
+
+// ProposalHistory returns the proposals signed by the validator key, ordered by slot.
+func (db *ValidatorDB) ProposalHistory(pubKey []byte) ([]*Proposal, error) {
+	var proposals []*Proposal
+	err := db.view(func(tx *bolt.Tx) error {
+		history := tx.Bucket(proposalHistoryBucket).Bucket(pubKey)
+		if history == nil {
+			return nil
+		}
+		return history.ForEach(func(_, enc []byte) error {
+			slot, signingRoot := decodeHistoryEntry(enc)
+			proposals = append(proposals, &Proposal{Slot: slot, SigningRoot: signingRoot})
+			return nil
+		})
+	})
+	return proposals, err
+}
@@ -0,0 +1,59 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+var testPubKey = make([]byte, params.BeaconConfig().BLSPubkeyLength)
+
+func TestSaveProposal_DoubleProposal(t *testing.T) {
+	db := setupDB(t)
+	defer TeardownDB(db)
+
+	slot := params.BeaconConfig().GenesisSlot + params.BeaconConfig().SlotsPerEpoch + 1
+	if err := db.SaveProposal(testPubKey, slot, [32]byte{1}); err != nil {
+		t.Fatalf("Could not save proposal: %v", err)
+	}
+	// Signing the same block again is not slashable.
+	if err := db.SaveProposal(testPubKey, slot, [32]byte{1}); err != nil {
+		t.Errorf("Expected the same proposal to be allowed, received %v", err)
+	}
+	if err := db.SaveProposal(testPubKey, slot, [32]byte{2}); err != ErrDoubleProposal {
+		t.Errorf("Expected %v, received %v", ErrDoubleProposal, err)
+	}
+	// Another slot of the same epoch.
+	if err := db.SaveProposal(testPubKey, slot+1, [32]byte{3}); err != ErrDoubleProposal {
+		t.Errorf("Expected %v, received %v", ErrDoubleProposal, err)
+	}
+	if err := db.SaveProposal(testPubKey, slot+params.BeaconConfig().SlotsPerEpoch, [32]byte{4}); err != nil {
+		t.Errorf("Expected a proposal in the next epoch to be allowed, received %v", err)
+	}
+	// Other keys have their own history.
+	otherPubKey := append([]byte{1}, testPubKey[1:]...)
+	if err := db.SaveProposal(otherPubKey, slot, [32]byte{5}); err != nil {
+		t.Errorf("Expected a proposal of another key to be allowed, received %v", err)
+	}
+
+	proposals, err := db.ProposalHistory(testPubKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(proposals) != 2 || proposals[0].Slot != slot || proposals[0].SigningRoot != [32]byte{1} {
+		t.Errorf("Unexpected proposal history %v", proposals)
+	}
+}
+
+func TestProposalHistory_Empty(t *testing.T) {
+	db := setupDB(t)
+	defer TeardownDB(db)
+
+	proposals, err := db.ProposalHistory(testPubKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(proposals) != 0 {
+		t.Errorf("Expected no proposals, received %v", proposals)
+	}
+}
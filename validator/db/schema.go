@@ -0,0 +1,43 @@
+package db
+
+import (
+	"encoding/binary"
+)
+
+// The history of a validator key is stored in a nested bucket, named after the
+// public key, of the proposal and attestation history buckets.
+// `proposal-history` / pubkey / epoch -> slot + signing root
+// `attestation-history` / pubkey / target epoch -> source epoch + signing root
+// Epochs are encoded big-endian, so that the history is ordered by epoch.
+var (
+	proposalHistoryBucket    = []byte("proposal-history")
+	attestationHistoryBucket = []byte("attestation-history")
+)
+
+// encodeEpoch encodes an epoch as big-endian uint64.
+func encodeEpoch(epoch uint64) []byte {
+	enc := make([]byte, 8)
+	binary.BigEndian.PutUint64(enc, epoch)
+	return enc
+}
+
+// decodeEpoch returns an epoch which has been encoded as a big-endian uint64.
+func decodeEpoch(enc []byte) uint64 {
+	return binary.BigEndian.Uint64(enc)
+}
+
+// encodeHistoryEntry encodes a number, the slot of a proposal or the source epoch
+// of an attestation, together with the signing root of the signed object.
+func encodeHistoryEntry(number uint64, signingRoot [32]byte) []byte {
+	enc := make([]byte, 8+32)
+	binary.BigEndian.PutUint64(enc, number)
+	copy(enc[8:], signingRoot[:])
+	return enc
+}
+
+// decodeHistoryEntry returns the number and signing root of an encoded history entry.
+func decodeHistoryEntry(enc []byte) (uint64, [32]byte) {
+	var signingRoot [32]byte
+	copy(signingRoot[:], enc[8:])
+	return binary.BigEndian.Uint64(enc[:8]), signingRoot
+}
@@ -0,0 +1,32 @@
+package db
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"os"
+	"path"
+)
+
+// SetupDB instantiates and returns a ValidatorDB instance in a temporary directory.
+func SetupDB() (*ValidatorDB, error) {
+	randPath, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return nil, fmt.Errorf("could not generate random file path: %v", err)
+	}
+	path := path.Join(os.TempDir(), fmt.Sprintf("/validatordb-%d", randPath))
+	if err := os.RemoveAll(path); err != nil {
+		return nil, fmt.Errorf("failed to remove directory: %v", err)
+	}
+	return NewDB(path)
+}
+
+// TeardownDB cleans up a ValidatorDB instance created by SetupDB.
+func TeardownDB(db *ValidatorDB) {
+	if err := db.Close(); err != nil {
+		log.Fatalf("failed to close database: %v", err)
+	}
+	if err := os.RemoveAll(db.DatabasePath); err != nil {
+		log.Fatalf("could not remove tmp db dir: %v", err)
+	}
+}
@@ -76,3 +76,43 @@ func (mr *MockAttesterServiceClientMockRecorder) AttestationDataAtSlot(arg0, arg
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttestationDataAtSlot", reflect.TypeOf((*MockAttesterServiceClient)(nil).AttestationDataAtSlot), varargs...)
 }
+
+// AttestationsToAggregate mocks base method
+func (m *MockAttesterServiceClient) AttestationsToAggregate(arg0 context.Context, arg1 *v1.AttestationData, arg2 ...grpc.CallOption) (*v10.AttestationsToAggregateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AttestationsToAggregate", varargs...)
+	ret0, _ := ret[0].(*v10.AttestationsToAggregateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttestationsToAggregate indicates an expected call of AttestationsToAggregate
+func (mr *MockAttesterServiceClientMockRecorder) AttestationsToAggregate(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttestationsToAggregate", reflect.TypeOf((*MockAttesterServiceClient)(nil).AttestationsToAggregate), varargs...)
+}
+
+// SubmitAggregateAndProof mocks base method
+func (m *MockAttesterServiceClient) SubmitAggregateAndProof(arg0 context.Context, arg1 *v1.AggregateAndProof, arg2 ...grpc.CallOption) (*v10.AttestResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SubmitAggregateAndProof", varargs...)
+	ret0, _ := ret[0].(*v10.AttestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitAggregateAndProof indicates an expected call of SubmitAggregateAndProof
+func (mr *MockAttesterServiceClientMockRecorder) SubmitAggregateAndProof(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitAggregateAndProof", reflect.TypeOf((*MockAttesterServiceClient)(nil).SubmitAggregateAndProof), varargs...)
+}
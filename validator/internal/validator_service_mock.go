@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1 (interfaces: ValidatorServiceClient,ValidatorService_WaitForActivationClient)
+// Source: github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1 (interfaces: ValidatorServiceClient,ValidatorService_StreamDutiesClient,ValidatorService_WaitForActivationClient)
 
 package internal
 
@@ -8,7 +8,8 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
-	v1 "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	v1 "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	v10 "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	grpc "google.golang.org/grpc"
 	metadata "google.golang.org/grpc/metadata"
 )
@@ -37,14 +38,14 @@ func (m *MockValidatorServiceClient) EXPECT() *MockValidatorServiceClientMockRec
 }
 
 // CommitteeAssignment mocks base method
-func (m *MockValidatorServiceClient) CommitteeAssignment(arg0 context.Context, arg1 *v1.ValidatorEpochAssignmentsRequest, arg2 ...grpc.CallOption) (*v1.CommitteeAssignmentResponse, error) {
+func (m *MockValidatorServiceClient) CommitteeAssignment(arg0 context.Context, arg1 *v10.ValidatorEpochAssignmentsRequest, arg2 ...grpc.CallOption) (*v10.CommitteeAssignmentResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "CommitteeAssignment", varargs...)
-	ret0, _ := ret[0].(*v1.CommitteeAssignmentResponse)
+	ret0, _ := ret[0].(*v10.CommitteeAssignmentResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -56,15 +57,75 @@ func (mr *MockValidatorServiceClientMockRecorder) CommitteeAssignment(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitteeAssignment", reflect.TypeOf((*MockValidatorServiceClient)(nil).CommitteeAssignment), varargs...)
 }
 
+// ProposeExit mocks base method
+func (m *MockValidatorServiceClient) ProposeExit(arg0 context.Context, arg1 *v1.VoluntaryExit, arg2 ...grpc.CallOption) (*v10.ProposeExitResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ProposeExit", varargs...)
+	ret0, _ := ret[0].(*v10.ProposeExitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProposeExit indicates an expected call of ProposeExit
+func (mr *MockValidatorServiceClientMockRecorder) ProposeExit(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProposeExit", reflect.TypeOf((*MockValidatorServiceClient)(nil).ProposeExit), varargs...)
+}
+
+// StreamDuties mocks base method
+func (m *MockValidatorServiceClient) StreamDuties(arg0 context.Context, arg1 *v10.DutiesRequest, arg2 ...grpc.CallOption) (v10.ValidatorService_StreamDutiesClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamDuties", varargs...)
+	ret0, _ := ret[0].(v10.ValidatorService_StreamDutiesClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamDuties indicates an expected call of StreamDuties
+func (mr *MockValidatorServiceClientMockRecorder) StreamDuties(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamDuties", reflect.TypeOf((*MockValidatorServiceClient)(nil).StreamDuties), varargs...)
+}
+
+// ValidatorActivity mocks base method
+func (m *MockValidatorServiceClient) ValidatorActivity(arg0 context.Context, arg1 *v10.ValidatorActivityRequest, arg2 ...grpc.CallOption) (*v10.ValidatorActivityResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ValidatorActivity", varargs...)
+	ret0, _ := ret[0].(*v10.ValidatorActivityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidatorActivity indicates an expected call of ValidatorActivity
+func (mr *MockValidatorServiceClientMockRecorder) ValidatorActivity(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorActivity", reflect.TypeOf((*MockValidatorServiceClient)(nil).ValidatorActivity), varargs...)
+}
+
 // ValidatorIndex mocks base method
-func (m *MockValidatorServiceClient) ValidatorIndex(arg0 context.Context, arg1 *v1.ValidatorIndexRequest, arg2 ...grpc.CallOption) (*v1.ValidatorIndexResponse, error) {
+func (m *MockValidatorServiceClient) ValidatorIndex(arg0 context.Context, arg1 *v10.ValidatorIndexRequest, arg2 ...grpc.CallOption) (*v10.ValidatorIndexResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "ValidatorIndex", varargs...)
-	ret0, _ := ret[0].(*v1.ValidatorIndexResponse)
+	ret0, _ := ret[0].(*v10.ValidatorIndexResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -76,15 +137,35 @@ func (mr *MockValidatorServiceClientMockRecorder) ValidatorIndex(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorIndex", reflect.TypeOf((*MockValidatorServiceClient)(nil).ValidatorIndex), varargs...)
 }
 
+// ValidatorPerformance mocks base method
+func (m *MockValidatorServiceClient) ValidatorPerformance(arg0 context.Context, arg1 *v10.ValidatorPerformanceRequest, arg2 ...grpc.CallOption) (*v10.ValidatorPerformanceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ValidatorPerformance", varargs...)
+	ret0, _ := ret[0].(*v10.ValidatorPerformanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidatorPerformance indicates an expected call of ValidatorPerformance
+func (mr *MockValidatorServiceClientMockRecorder) ValidatorPerformance(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorPerformance", reflect.TypeOf((*MockValidatorServiceClient)(nil).ValidatorPerformance), varargs...)
+}
+
 // ValidatorStatus mocks base method
-func (m *MockValidatorServiceClient) ValidatorStatus(arg0 context.Context, arg1 *v1.ValidatorIndexRequest, arg2 ...grpc.CallOption) (*v1.ValidatorStatusResponse, error) {
+func (m *MockValidatorServiceClient) ValidatorStatus(arg0 context.Context, arg1 *v10.ValidatorIndexRequest, arg2 ...grpc.CallOption) (*v10.ValidatorStatusResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "ValidatorStatus", varargs...)
-	ret0, _ := ret[0].(*v1.ValidatorStatusResponse)
+	ret0, _ := ret[0].(*v10.ValidatorStatusResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -97,14 +178,14 @@ func (mr *MockValidatorServiceClientMockRecorder) ValidatorStatus(arg0, arg1 int
 }
 
 // WaitForActivation mocks base method
-func (m *MockValidatorServiceClient) WaitForActivation(arg0 context.Context, arg1 *v1.ValidatorActivationRequest, arg2 ...grpc.CallOption) (v1.ValidatorService_WaitForActivationClient, error) {
+func (m *MockValidatorServiceClient) WaitForActivation(arg0 context.Context, arg1 *v10.ValidatorActivationRequest, arg2 ...grpc.CallOption) (v10.ValidatorService_WaitForActivationClient, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "WaitForActivation", varargs...)
-	ret0, _ := ret[0].(v1.ValidatorService_WaitForActivationClient)
+	ret0, _ := ret[0].(v10.ValidatorService_WaitForActivationClient)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -116,6 +197,129 @@ func (mr *MockValidatorServiceClientMockRecorder) WaitForActivation(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForActivation", reflect.TypeOf((*MockValidatorServiceClient)(nil).WaitForActivation), varargs...)
 }
 
+// MockValidatorService_StreamDutiesClient is a mock of ValidatorService_StreamDutiesClient interface
+type MockValidatorService_StreamDutiesClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockValidatorService_StreamDutiesClientMockRecorder
+}
+
+// MockValidatorService_StreamDutiesClientMockRecorder is the mock recorder for MockValidatorService_StreamDutiesClient
+type MockValidatorService_StreamDutiesClientMockRecorder struct {
+	mock *MockValidatorService_StreamDutiesClient
+}
+
+// NewMockValidatorService_StreamDutiesClient creates a new mock instance
+func NewMockValidatorService_StreamDutiesClient(ctrl *gomock.Controller) *MockValidatorService_StreamDutiesClient {
+	mock := &MockValidatorService_StreamDutiesClient{ctrl: ctrl}
+	mock.recorder = &MockValidatorService_StreamDutiesClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockValidatorService_StreamDutiesClient) EXPECT() *MockValidatorService_StreamDutiesClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method
+func (m *MockValidatorService_StreamDutiesClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend
+func (mr *MockValidatorService_StreamDutiesClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockValidatorService_StreamDutiesClient)(nil).CloseSend))
+}
+
+// Context mocks base method
+func (m *MockValidatorService_StreamDutiesClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockValidatorService_StreamDutiesClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockValidatorService_StreamDutiesClient)(nil).Context))
+}
+
+// Header mocks base method
+func (m *MockValidatorService_StreamDutiesClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header
+func (mr *MockValidatorService_StreamDutiesClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockValidatorService_StreamDutiesClient)(nil).Header))
+}
+
+// Recv mocks base method
+func (m *MockValidatorService_StreamDutiesClient) Recv() (*v10.DutiesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*v10.DutiesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv
+func (mr *MockValidatorService_StreamDutiesClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockValidatorService_StreamDutiesClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method
+func (m *MockValidatorService_StreamDutiesClient) RecvMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockValidatorService_StreamDutiesClientMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockValidatorService_StreamDutiesClient)(nil).RecvMsg), arg0)
+}
+
+// SendMsg mocks base method
+func (m *MockValidatorService_StreamDutiesClient) SendMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockValidatorService_StreamDutiesClientMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockValidatorService_StreamDutiesClient)(nil).SendMsg), arg0)
+}
+
+// Trailer mocks base method
+func (m *MockValidatorService_StreamDutiesClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer
+func (mr *MockValidatorService_StreamDutiesClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockValidatorService_StreamDutiesClient)(nil).Trailer))
+}
+
 // MockValidatorService_WaitForActivationClient is a mock of ValidatorService_WaitForActivationClient interface
 type MockValidatorService_WaitForActivationClient struct {
 	ctrl     *gomock.Controller
@@ -183,10 +387,10 @@ func (mr *MockValidatorService_WaitForActivationClientMockRecorder) Header() *go
 }
 
 // Recv mocks base method
-func (m *MockValidatorService_WaitForActivationClient) Recv() (*v1.ValidatorActivationResponse, error) {
+func (m *MockValidatorService_WaitForActivationClient) Recv() (*v10.ValidatorActivationResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Recv")
-	ret0, _ := ret[0].(*v1.ValidatorActivationResponse)
+	ret0, _ := ret[0].(*v10.ValidatorActivationResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
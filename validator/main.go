@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"runtime"
 
 	"github.com/prysmaticlabs/prysm/shared/cmd"
@@ -20,8 +21,14 @@ import (
 func startNode(ctx *cli.Context) error {
 	keystoreDirectory := ctx.String(types.KeystorePathFlag.Name)
 	keystorePassword := ctx.String(types.PasswordFlag.Name)
-	if err := accounts.VerifyAccountNotExists(keystoreDirectory, keystorePassword); err == nil {
-		return errors.New("no account found, use `validator accounts create` to generate a new keystore")
+	// Interop keys are generated on startup and a remote signer holds its own keys,
+	// so no keystore is needed for them.
+	if ctx.GlobalUint64(types.InteropNumKeysFlag.Name) == 0 && ctx.GlobalString(types.RemoteSignerFlag.Name) == "" {
+		// The keystore path is either a single keystore or a directory of keystores.
+		paths, err := accounts.KeystorePaths(keystoreDirectory)
+		if keystorePassword == "" || err != nil || len(paths) == 0 {
+			return errors.New("no account found, use `validator accounts create` to generate a new keystore")
+		}
 	}
 
 	verbosity := ctx.GlobalString(cmd.VerbosityFlag.Name)
@@ -43,12 +50,137 @@ func startNode(ctx *cli.Context) error {
 func createValidatorAccount(ctx *cli.Context) error {
 	keystoreDirectory := ctx.String(types.KeystorePathFlag.Name)
 	keystorePassword := ctx.String(types.PasswordFlag.Name)
+	if numAccounts := ctx.Uint64(types.NumAccountsFlag.Name); numAccounts > 0 {
+		if err := accounts.NewMnemonicValidatorAccounts(
+			keystoreDirectory,
+			keystorePassword,
+			ctx.String(types.MnemonicPassphraseFlag.Name),
+			ctx.Uint64(types.AccountStartIndexFlag.Name),
+			numAccounts,
+		); err != nil {
+			return fmt.Errorf("could not initialize validator accounts: %v", err)
+		}
+		return nil
+	}
 	if err := accounts.NewValidatorAccount(keystoreDirectory, keystorePassword); err != nil {
 		return fmt.Errorf("could not initialize validator account: %v", err)
 	}
 	return nil
 }
 
+func recoverValidatorAccounts(ctx *cli.Context) error {
+	mnemonic := ctx.String(types.MnemonicFlag.Name)
+	if mnemonic == "" {
+		return errors.New("expected a mnemonic to recover validator accounts from, received nil")
+	}
+	numAccounts := ctx.Uint64(types.NumAccountsFlag.Name)
+	if numAccounts == 0 {
+		numAccounts = 1
+	}
+	if err := accounts.NewValidatorAccountsFromMnemonic(
+		ctx.String(types.KeystorePathFlag.Name),
+		ctx.String(types.PasswordFlag.Name),
+		mnemonic,
+		ctx.String(types.MnemonicPassphraseFlag.Name),
+		ctx.Uint64(types.AccountStartIndexFlag.Name),
+		numAccounts,
+	); err != nil {
+		return fmt.Errorf("could not recover validator accounts: %v", err)
+	}
+	return nil
+}
+
+func importValidatorAccounts(ctx *cli.Context) error {
+	importPath := ctx.String(types.ImportPathFlag.Name)
+	if importPath == "" {
+		return errors.New("expected a path to the keystores to import, received nil")
+	}
+	keystorePassword := ctx.String(types.PasswordFlag.Name)
+	importPassword := ctx.String(types.EIP2335PasswordFlag.Name)
+	if importPassword == "" {
+		importPassword = keystorePassword
+	}
+	if err := accounts.ImportKeystores(
+		ctx.String(types.KeystorePathFlag.Name),
+		keystorePassword,
+		importPath,
+		importPassword,
+	); err != nil {
+		return fmt.Errorf("could not import validator accounts: %v", err)
+	}
+	return nil
+}
+
+func exportValidatorAccounts(ctx *cli.Context) error {
+	exportPath := ctx.String(types.ExportPathFlag.Name)
+	if exportPath == "" {
+		return errors.New("expected a path to export the keystores to, received nil")
+	}
+	keystorePassword := ctx.String(types.PasswordFlag.Name)
+	exportPassword := ctx.String(types.EIP2335PasswordFlag.Name)
+	if exportPassword == "" {
+		exportPassword = keystorePassword
+	}
+	if _, err := accounts.ExportKeystores(
+		ctx.String(types.KeystorePathFlag.Name),
+		keystorePassword,
+		exportPath,
+		exportPassword,
+	); err != nil {
+		return fmt.Errorf("could not export validator accounts: %v", err)
+	}
+	return nil
+}
+
+func exportSlashingProtection(ctx *cli.Context) error {
+	file := ctx.String(types.SlashingProtectionFileFlag.Name)
+	if file == "" {
+		return errors.New("expected a file to export the slashing protection history to, received nil")
+	}
+	validatorDB, err := node.OpenDB(ctx)
+	if err != nil {
+		return fmt.Errorf("could not open validator database: %v", err)
+	}
+	defer validatorDB.Close()
+	// #nosec G304
+	f, err := os.OpenFile(filepath.Clean(file), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return fmt.Errorf("could not create slashing protection file: %v", err)
+	}
+	defer f.Close()
+	if err := validatorDB.Export(f); err != nil {
+		return fmt.Errorf("could not export slashing protection history: %v", err)
+	}
+	return nil
+}
+
+func importSlashingProtection(ctx *cli.Context) error {
+	file := ctx.String(types.SlashingProtectionFileFlag.Name)
+	if file == "" {
+		return errors.New("expected a file to import the slashing protection history from, received nil")
+	}
+	validatorDB, err := node.OpenDB(ctx)
+	if err != nil {
+		return fmt.Errorf("could not open validator database: %v", err)
+	}
+	defer validatorDB.Close()
+	// #nosec G304
+	f, err := os.Open(filepath.Clean(file))
+	if err != nil {
+		return fmt.Errorf("could not open slashing protection file: %v", err)
+	}
+	defer f.Close()
+	if err := validatorDB.Import(f); err != nil {
+		return fmt.Errorf("could not import slashing protection history: %v", err)
+	}
+	return nil
+}
+
+func exitValidators(ctx *cli.Context) error {
+	logrus.Warn("Submitting voluntary exits, the validators cannot validate again once they have exited")
+	return node.ExitValidators(ctx)
+}
+
 func main() {
 	customFormatter := new(prefixed.TextFormatter)
 	customFormatter.TimestampFormat = "2006-01-02 15:04:05"
@@ -71,23 +203,119 @@ func main() {
 					Name: "create",
 					Description: `creates a new validator account keystore containing private keys for Ethereum Serenity - 
 this command outputs a deposit data string which can be used to deposit Ether into the ETH1.0 deposit 
-contract in order to activate the validator client`,
+contract in order to activate the validator client. With --num-accounts, the accounts are derived 
+from a new mnemonic, which is printed so that the accounts can be recovered later, and each account 
+is stored in its own account-<index> keystore directory below the keystore path`,
 					Flags: []cli.Flag{
 						types.KeystorePathFlag,
 						types.PasswordFlag,
+						types.NumAccountsFlag,
+						types.AccountStartIndexFlag,
+						types.MnemonicPassphraseFlag,
 					},
 					Action: createValidatorAccount,
 				},
+				cli.Command{
+					Name: "recover",
+					Description: `recovers the validator accounts with the given index range from a mnemonic printed by 
+the create command, storing each account in its own account-<index> keystore directory below the 
+keystore path`,
+					Flags: []cli.Flag{
+						types.KeystorePathFlag,
+						types.PasswordFlag,
+						types.MnemonicFlag,
+						types.MnemonicPassphraseFlag,
+						types.NumAccountsFlag,
+						types.AccountStartIndexFlag,
+					},
+					Action: recoverValidatorAccounts,
+				},
+				cli.Command{
+					Name: "import",
+					Description: `imports validator keys from EIP-2335 keystore files, as written by other clients and 
+tools, storing each key in its own validator-<public key> keystore directory below the keystore path. 
+Imported accounts have no withdrawal key`,
+					Flags: []cli.Flag{
+						types.KeystorePathFlag,
+						types.PasswordFlag,
+						types.ImportPathFlag,
+						types.EIP2335PasswordFlag,
+					},
+					Action: importValidatorAccounts,
+				},
+				cli.Command{
+					Name: "export",
+					Description: `exports the validator keys of the keystore, or of all the keystores below the keystore 
+path, to EIP-2335 keystore files which other clients and tools can import`,
+					Flags: []cli.Flag{
+						types.KeystorePathFlag,
+						types.PasswordFlag,
+						types.ExportPathFlag,
+						types.EIP2335PasswordFlag,
+					},
+					Action: exportValidatorAccounts,
+				},
+			},
+		},
+		{
+			Name:     "slashing-protection",
+			Category: "slashing protection",
+			Usage:    "exports and imports the history of the blocks and attestations signed by the validator client",
+			Subcommands: cli.Commands{
+				cli.Command{
+					Name: "export",
+					Description: `exports the history of the blocks and attestations signed with the validator keys from 
+the database in the data directory to a JSON file, so that the keys can be moved to another 
+machine or client without being slashed. The validator client must be stopped`,
+					Flags: []cli.Flag{
+						types.SlashingProtectionFileFlag,
+					},
+					Action: exportSlashingProtection,
+				},
+				cli.Command{
+					Name: "import",
+					Description: `imports a history of signed blocks and attestations exported by the export command 
+into the database in the data directory, merging it with the history already stored there. 
+The validator client must be stopped`,
+					Flags: []cli.Flag{
+						types.SlashingProtectionFileFlag,
+					},
+					Action: importSlashingProtection,
+				},
+			},
+		},
+		{
+			Name:     "exit",
+			Category: "exit",
+			Usage:    "voluntarily exits validators from the validator registry",
+			Description: `signs a voluntary exit of each of the validators of the given public keys with the keys 
+the validator client validates with, and submits it to the beacon node, which broadcasts it 
+to the network. An exit cannot be undone. The keys are those of the keystore, the interop keys 
+or the remote signer, as when the validator client is started`,
+			Flags: []cli.Flag{
+				types.KeystorePathFlag,
+				types.PasswordFlag,
+				types.ExitPublicKeysFlag,
 			},
+			Action: exitValidators,
 		},
 	}
 	app.Flags = []cli.Flag{
 		types.DemoConfigFlag,
 		types.BeaconRPCProviderFlag,
+		types.RemoteSignerFlag,
+		types.RemoteSignerCertFlag,
+		types.RemoteSignerClientCertFlag,
+		types.RemoteSignerClientKeyFlag,
 		types.KeystorePathFlag,
 		types.PasswordFlag,
+		types.InteropStartIndexFlag,
+		types.InteropNumKeysFlag,
+		types.DoppelgangerEpochsFlag,
 		cmd.VerbosityFlag,
 		cmd.DataDirFlag,
+		cmd.ForkScheduleFlag,
+		cmd.BLSBackendFlag,
 		cmd.EnableTracingFlag,
 		cmd.TracingEndpointFlag,
 		cmd.TraceSampleFractionFlag,
@@ -4,20 +4,27 @@ package node
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"os"
 	"os/signal"
+	"path"
+	"strings"
 	"sync"
 	"syscall"
 
 	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/debug"
+	"github.com/prysmaticlabs/prysm/shared/interop"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/prometheus"
 	"github.com/prysmaticlabs/prysm/shared/tracing"
 	"github.com/prysmaticlabs/prysm/shared/version"
 	"github.com/prysmaticlabs/prysm/validator/client"
+	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/types"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
@@ -25,6 +32,8 @@ import (
 
 var log = logrus.WithField("prefix", "node")
 
+const validatorDBName = "validatordata"
+
 // ValidatorClient defines an instance of a sharding validator that manages
 // the entire lifecycle of services attached to it participating in
 // Ethereum Serenity.
@@ -33,6 +42,7 @@ type ValidatorClient struct {
 	services *shared.ServiceRegistry // Lifecycle and service store.
 	lock     sync.RWMutex
 	stop     chan struct{} // Channel to wait for termination notifications.
+	db       *db.ValidatorDB
 }
 
 // NewValidatorClient creates a new, Ethereum Serenity validator client.
@@ -58,6 +68,28 @@ func NewValidatorClient(ctx *cli.Context) (*ValidatorClient, error) {
 		params.UseDemoBeaconConfig()
 	}
 
+	if schedule := ctx.GlobalString(cmd.ForkScheduleFlag.Name); schedule != "" {
+		forks, err := params.ParseForkSchedule(schedule)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse fork schedule: %v", err)
+		}
+		log.WithField("forkSchedule", forks).Info("Using custom fork schedule")
+		c := params.BeaconConfig()
+		c.ForkSchedule = forks
+		params.OverrideBeaconConfig(c)
+	}
+
+	if backend := ctx.GlobalString(cmd.BLSBackendFlag.Name); backend != "" {
+		if err := bls.SetBackend(backend); err != nil {
+			return nil, err
+		}
+		log.WithField("backend", backend).Info("Using custom BLS backend")
+	}
+
+	if err := ValidatorClient.startDB(ctx); err != nil {
+		return nil, err
+	}
+
 	if err := ValidatorClient.registerPrometheusService(ctx); err != nil {
 		return nil, err
 	}
@@ -110,10 +142,57 @@ func (s *ValidatorClient) Close() {
 
 	s.services.StopAll()
 	log.Info("Stopping sharding validator")
+	if err := s.db.Close(); err != nil {
+		log.Errorf("Failed to close database: %v", err)
+	}
 
 	close(s.stop)
 }
 
+// OpenDB opens the slashing protection database of the validator client in the
+// data directory.
+func OpenDB(ctx *cli.Context) (*db.ValidatorDB, error) {
+	baseDir := ctx.GlobalString(cmd.DataDirFlag.Name)
+	return db.NewDB(path.Join(baseDir, validatorDBName))
+}
+
+// ExitValidators submits voluntary exits of the validators of the public keys given
+// with --exit-public-keys to the beacon node, signing them with the keys the validator
+// client is configured to validate with.
+func ExitValidators(ctx *cli.Context) error {
+	var pubKeys [][]byte
+	for _, k := range ctx.StringSlice(types.ExitPublicKeysFlag.Name) {
+		pubKey, err := hex.DecodeString(strings.TrimPrefix(k, "0x"))
+		if err != nil {
+			return fmt.Errorf("could not decode public key %s: %v", k, err)
+		}
+		pubKeys = append(pubKeys, pubKey)
+	}
+	keys, err := interopKeys(ctx)
+	if err != nil {
+		return err
+	}
+	return client.ProposeExits(context.Background(), &client.Config{
+		Endpoints:        beaconEndpoints(ctx),
+		KeystorePath:     ctx.String(types.KeystorePathFlag.Name),
+		Password:         ctx.String(types.PasswordFlag.Name),
+		Keys:             keys,
+		SignerEndpoint:   ctx.GlobalString(types.RemoteSignerFlag.Name),
+		SignerCertFlag:   ctx.GlobalString(types.RemoteSignerCertFlag.Name),
+		SignerClientCert: ctx.GlobalString(types.RemoteSignerClientCertFlag.Name),
+		SignerClientKey:  ctx.GlobalString(types.RemoteSignerClientKeyFlag.Name),
+	}, pubKeys)
+}
+
+func (s *ValidatorClient) startDB(ctx *cli.Context) error {
+	db, err := OpenDB(ctx)
+	if err != nil {
+		return fmt.Errorf("could not open validator database: %v", err)
+	}
+	s.db = db
+	return nil
+}
+
 func (s *ValidatorClient) registerPrometheusService(ctx *cli.Context) error {
 	service := prometheus.NewPrometheusService(
 		fmt.Sprintf(":%d", ctx.GlobalInt64(cmd.MonitoringPortFlag.Name)),
@@ -124,16 +203,63 @@ func (s *ValidatorClient) registerPrometheusService(ctx *cli.Context) error {
 }
 
 func (s *ValidatorClient) registerClientService(ctx *cli.Context) error {
-	endpoint := ctx.GlobalString(types.BeaconRPCProviderFlag.Name)
 	keystoreDirectory := ctx.GlobalString(types.KeystorePathFlag.Name)
 	keystorePassword := ctx.String(types.PasswordFlag.Name)
+	keys, err := interopKeys(ctx)
+	if err != nil {
+		return err
+	}
 	v, err := client.NewValidatorService(context.Background(), &client.Config{
-		Endpoint:     endpoint,
-		KeystorePath: keystoreDirectory,
-		Password:     keystorePassword,
+		Endpoints:          beaconEndpoints(ctx),
+		KeystorePath:       keystoreDirectory,
+		Password:           keystorePassword,
+		Keys:               keys,
+		DB:                 s.db,
+		SignerEndpoint:     ctx.GlobalString(types.RemoteSignerFlag.Name),
+		SignerCertFlag:     ctx.GlobalString(types.RemoteSignerCertFlag.Name),
+		SignerClientCert:   ctx.GlobalString(types.RemoteSignerClientCertFlag.Name),
+		SignerClientKey:    ctx.GlobalString(types.RemoteSignerClientKeyFlag.Name),
+		DoppelgangerEpochs: ctx.GlobalUint64(types.DoppelgangerEpochsFlag.Name),
 	})
 	if err != nil {
 		return fmt.Errorf("could not initialize client service: %v", err)
 	}
 	return s.services.RegisterService(v)
 }
+
+// beaconEndpoints splits the comma separated endpoints of --beacon-rpc-provider.
+func beaconEndpoints(ctx *cli.Context) []string {
+	var endpoints []string
+	for _, endpoint := range strings.Split(ctx.GlobalString(types.BeaconRPCProviderFlag.Name), ",") {
+		if endpoint = strings.TrimSpace(endpoint); endpoint != "" {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	return endpoints
+}
+
+// interopKeys derives the deterministic interop keys requested with
+// --interop-num-keys, or returns nil to use the key in the keystore.
+func interopKeys(ctx *cli.Context) ([]*keystore.Key, error) {
+	numKeys := ctx.GlobalUint64(types.InteropNumKeysFlag.Name)
+	if numKeys == 0 {
+		return nil, nil
+	}
+	startIndex := ctx.GlobalUint64(types.InteropStartIndexFlag.Name)
+	secretKeys, err := interop.DeterministicallyGenerateKeys(startIndex, numKeys)
+	if err != nil {
+		return nil, fmt.Errorf("could not generate interop keys: %v", err)
+	}
+	log.WithFields(logrus.Fields{
+		"startIndex": startIndex,
+		"numKeys":    numKeys,
+	}).Warn("Using deterministic interop keys, do not use them outside of testing")
+	keys := make([]*keystore.Key, len(secretKeys))
+	for i, secretKey := range secretKeys {
+		keys[i] = &keystore.Key{
+			PublicKey: secretKey.PublicKey(),
+			SecretKey: secretKey,
+		}
+	}
+	return keys, nil
+}
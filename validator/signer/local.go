@@ -0,0 +1,129 @@
+package signer
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/ssz"
+	"github.com/prysmaticlabs/prysm/validator/db"
+)
+
+// LocalSigner signs with validator keys held in process memory, and keeps the
+// history of what they signed in a validator database to protect them from being
+// slashed.
+type LocalSigner struct {
+	keys    map[string]*keystore.Key // Keyed by the hex encoded public key.
+	pubkeys [][]byte
+	db      *db.ValidatorDB
+}
+
+// NewLocalSigner returns a signer which signs with the keys, checking everything
+// it signs against the signing history in the database.
+func NewLocalSigner(keys []*keystore.Key, validatorDB *db.ValidatorDB) *LocalSigner {
+	s := &LocalSigner{
+		keys:    make(map[string]*keystore.Key, len(keys)),
+		pubkeys: make([][]byte, 0, len(keys)),
+		db:      validatorDB,
+	}
+	for _, key := range keys {
+		pubKey := key.PublicKey.Marshal()
+		s.keys[hex.EncodeToString(pubKey)] = key
+		s.pubkeys = append(s.pubkeys, pubKey)
+	}
+	return s
+}
+
+// PublicKeys returns the public keys of the validator keys of the signer.
+func (s *LocalSigner) PublicKeys() [][]byte {
+	return s.pubkeys
+}
+
+// SignBlock signs the signing root of the block, unless the key already signed a
+// different block in the epoch of the block.
+func (s *LocalSigner) SignBlock(_ context.Context, pubKey []byte, block *pbp2p.BeaconBlock, domain uint64) ([]byte, error) {
+	key, err := s.key(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	blockRoot, err := ssz.SigningRoot(block)
+	if err != nil {
+		return nil, fmt.Errorf("could not get signing root of block: %v", err)
+	}
+	// The block is recorded in the history before it is signed, so that a block is
+	// never signed without being in the history.
+	if err := s.db.SaveProposal(pubKey, block.Slot, blockRoot); err != nil {
+		return nil, fmt.Errorf("slashing protection refused the block: %v", err)
+	}
+	return key.SecretKey.Sign(blockRoot[:], domain).Marshal(), nil
+}
+
+// SignAttestation signs the attestation data with custody bit 0, unless the
+// attestation would be a double or surround vote of an attestation the key already
+// signed.
+func (s *LocalSigner) SignAttestation(_ context.Context, pubKey []byte, data *pbp2p.AttestationData, domain uint64) ([]byte, error) {
+	key, err := s.key(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	// Until phase 1, every participant signs the attestation data with custody bit 0,
+	// so that the signatures of a committee can be aggregated.
+	msg, err := ssz.TreeHash(&pbp2p.AttestationDataAndCustodyBit{Data: data, CustodyBit: false})
+	if err != nil {
+		return nil, fmt.Errorf("could not hash attestation data: %v", err)
+	}
+	if err := s.db.SaveAttestation(pubKey, data, msg); err != nil {
+		return nil, fmt.Errorf("slashing protection refused the attestation: %v", err)
+	}
+	return key.SecretKey.Sign(msg[:], domain).Marshal(), nil
+}
+
+// SignRandaoReveal signs the epoch as the RANDAO reveal of a block. Signing it
+// cannot be slashed.
+func (s *LocalSigner) SignRandaoReveal(_ context.Context, pubKey []byte, epoch uint64, domain uint64) ([]byte, error) {
+	key, err := s.key(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	// message_hash=int_to_bytes32(slot_to_epoch(block.slot))
+	buf := make([]byte, 32)
+	binary.LittleEndian.PutUint64(buf, epoch)
+	return key.SecretKey.Sign(buf, domain).Marshal(), nil
+}
+
+// SignExit signs the signing root of the voluntary exit. An exit cannot be undone,
+// but signing it cannot be slashed.
+func (s *LocalSigner) SignExit(_ context.Context, pubKey []byte, exit *pbp2p.VoluntaryExit, domain uint64) ([]byte, error) {
+	key, err := s.key(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	exitRoot, err := ssz.SigningRoot(exit)
+	if err != nil {
+		return nil, fmt.Errorf("could not get signing root of exit: %v", err)
+	}
+	return key.SecretKey.Sign(exitRoot[:], domain).Marshal(), nil
+}
+
+// SignSelectionProof signs the slot as the selection proof of an aggregator.
+// Signing it cannot be slashed.
+func (s *LocalSigner) SignSelectionProof(_ context.Context, pubKey []byte, slot uint64, domain uint64) ([]byte, error) {
+	key, err := s.key(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, 32)
+	binary.LittleEndian.PutUint64(buf, slot)
+	return key.SecretKey.Sign(buf, domain).Marshal(), nil
+}
+
+func (s *LocalSigner) key(pubKey []byte) (*keystore.Key, error) {
+	key, ok := s.keys[hex.EncodeToString(pubKey)]
+	if !ok {
+		return nil, fmt.Errorf("no validator key with public key %#x", pubKey)
+	}
+	return key, nil
+}
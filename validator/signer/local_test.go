@@ -0,0 +1,145 @@
+package signer
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"strings"
+	"testing"
+
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/ssz"
+	"github.com/prysmaticlabs/prysm/validator/db"
+)
+
+func setupSigner(t *testing.T) (*LocalSigner, *keystore.Key, func()) {
+	key, err := keystore.NewKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	validatorDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewLocalSigner([]*keystore.Key{key}, validatorDB), key, func() { db.TeardownDB(validatorDB) }
+}
+
+func TestLocalSigner_SignBlock(t *testing.T) {
+	s, key, teardown := setupSigner(t)
+	defer teardown()
+	pubKey := key.PublicKey.Marshal()
+
+	block := &pbp2p.BeaconBlock{Slot: 5, StateRootHash32: []byte("A")}
+	sig, err := s.SignBlock(context.Background(), pubKey, block, params.BeaconConfig().DomainProposal)
+	if err != nil {
+		t.Fatalf("Could not sign block: %v", err)
+	}
+	root, err := ssz.SigningRoot(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signature, err := bls.SignatureFromBytes(sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !signature.Verify(root[:], key.PublicKey, params.BeaconConfig().DomainProposal) {
+		t.Error("Block signature does not verify")
+	}
+
+	// Signing the same block again is allowed, signing another one is not.
+	if _, err := s.SignBlock(context.Background(), pubKey, block, params.BeaconConfig().DomainProposal); err != nil {
+		t.Errorf("Could not sign the same block again: %v", err)
+	}
+	other := &pbp2p.BeaconBlock{Slot: 6, StateRootHash32: []byte("B")}
+	if _, err := s.SignBlock(context.Background(), pubKey, other, params.BeaconConfig().DomainProposal); err == nil ||
+		!strings.Contains(err.Error(), db.ErrDoubleProposal.Error()) {
+		t.Errorf("Expected a double proposal to be refused, received %v", err)
+	}
+}
+
+func TestLocalSigner_SignAttestation(t *testing.T) {
+	s, key, teardown := setupSigner(t)
+	defer teardown()
+	pubKey := key.PublicKey.Marshal()
+
+	data := &pbp2p.AttestationData{Slot: params.BeaconConfig().SlotsPerEpoch * 3, JustifiedEpoch: 1}
+	sig, err := s.SignAttestation(context.Background(), pubKey, data, params.BeaconConfig().DomainAttestation)
+	if err != nil {
+		t.Fatalf("Could not sign attestation: %v", err)
+	}
+	msg, err := ssz.TreeHash(&pbp2p.AttestationDataAndCustodyBit{Data: data, CustodyBit: false})
+	if err != nil {
+		t.Fatal(err)
+	}
+	signature, err := bls.SignatureFromBytes(sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !signature.Verify(msg[:], key.PublicKey, params.BeaconConfig().DomainAttestation) {
+		t.Error("Attestation signature does not verify")
+	}
+
+	// Source epoch 0 and target epoch 4 surround source epoch 1 and target epoch 3.
+	surrounding := &pbp2p.AttestationData{Slot: params.BeaconConfig().SlotsPerEpoch * 4, JustifiedEpoch: 0}
+	if _, err := s.SignAttestation(context.Background(), pubKey, surrounding, params.BeaconConfig().DomainAttestation); err == nil ||
+		!strings.Contains(err.Error(), db.ErrSurroundVote.Error()) {
+		t.Errorf("Expected a surround vote to be refused, received %v", err)
+	}
+}
+
+func TestLocalSigner_SignExit(t *testing.T) {
+	s, key, teardown := setupSigner(t)
+	defer teardown()
+
+	exit := &pbp2p.VoluntaryExit{Epoch: 2, ValidatorIndex: 7}
+	sig, err := s.SignExit(context.Background(), key.PublicKey.Marshal(), exit, params.BeaconConfig().DomainExit)
+	if err != nil {
+		t.Fatalf("Could not sign exit: %v", err)
+	}
+	root, err := ssz.SigningRoot(exit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signature, err := bls.SignatureFromBytes(sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !signature.Verify(root[:], key.PublicKey, params.BeaconConfig().DomainExit) {
+		t.Error("Exit signature does not verify")
+	}
+}
+
+func TestLocalSigner_SignSelectionProof(t *testing.T) {
+	s, key, teardown := setupSigner(t)
+	defer teardown()
+
+	sig, err := s.SignSelectionProof(context.Background(), key.PublicKey.Marshal(), 9, params.BeaconConfig().DomainSelectionProof)
+	if err != nil {
+		t.Fatalf("Could not sign selection proof: %v", err)
+	}
+	buf := make([]byte, 32)
+	binary.LittleEndian.PutUint64(buf, 9)
+	signature, err := bls.SignatureFromBytes(sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !signature.Verify(buf, key.PublicKey, params.BeaconConfig().DomainSelectionProof) {
+		t.Error("Selection proof does not verify")
+	}
+}
+
+func TestLocalSigner_UnknownKey(t *testing.T) {
+	s, _, teardown := setupSigner(t)
+	defer teardown()
+	other, err := keystore.NewKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.SignRandaoReveal(context.Background(), other.PublicKey.Marshal(), 1, 0); err == nil ||
+		!strings.Contains(err.Error(), "no validator key") {
+		t.Errorf("Expected an unknown key error, received %v", err)
+	}
+}
@@ -0,0 +1,105 @@
+package signer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/signer/v1"
+)
+
+// RemoteSigner signs with the validator keys held by a remote signer, which keeps
+// the signing history of the keys and enforces the slashing protection itself.
+type RemoteSigner struct {
+	client  pb.RemoteSignerClient
+	pubkeys [][]byte
+}
+
+// NewRemoteSigner returns a signer which signs with all the validator keys of the
+// remote signer, as listed when the signer is created.
+func NewRemoteSigner(ctx context.Context, client pb.RemoteSignerClient) (*RemoteSigner, error) {
+	res, err := client.ListPublicKeys(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list the public keys of the remote signer: %v", err)
+	}
+	if len(res.PublicKeys) == 0 {
+		return nil, errors.New("remote signer has no validator keys")
+	}
+	return &RemoteSigner{
+		client:  client,
+		pubkeys: res.PublicKeys,
+	}, nil
+}
+
+// PublicKeys returns the public keys of the validator keys of the remote signer.
+func (s *RemoteSigner) PublicKeys() [][]byte {
+	return s.pubkeys
+}
+
+// SignBlock asks the remote signer to sign the signing root of the block.
+func (s *RemoteSigner) SignBlock(ctx context.Context, pubKey []byte, block *pbp2p.BeaconBlock, domain uint64) ([]byte, error) {
+	res, err := s.client.SignBlock(ctx, &pb.SignBlockRequest{
+		PublicKey: pubKey,
+		Block:     block,
+		Domain:    domain,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Signature, nil
+}
+
+// SignAttestation asks the remote signer to sign the attestation data with custody
+// bit 0.
+func (s *RemoteSigner) SignAttestation(ctx context.Context, pubKey []byte, data *pbp2p.AttestationData, domain uint64) ([]byte, error) {
+	res, err := s.client.SignAttestation(ctx, &pb.SignAttestationRequest{
+		PublicKey: pubKey,
+		Data:      data,
+		Domain:    domain,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Signature, nil
+}
+
+// SignRandaoReveal asks the remote signer to sign the epoch as a RANDAO reveal.
+func (s *RemoteSigner) SignRandaoReveal(ctx context.Context, pubKey []byte, epoch uint64, domain uint64) ([]byte, error) {
+	res, err := s.client.SignRandaoReveal(ctx, &pb.SignRandaoRevealRequest{
+		PublicKey: pubKey,
+		Epoch:     epoch,
+		Domain:    domain,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Signature, nil
+}
+
+// SignExit asks the remote signer to sign the signing root of the voluntary exit.
+func (s *RemoteSigner) SignExit(ctx context.Context, pubKey []byte, exit *pbp2p.VoluntaryExit, domain uint64) ([]byte, error) {
+	res, err := s.client.SignExit(ctx, &pb.SignExitRequest{
+		PublicKey: pubKey,
+		Exit:      exit,
+		Domain:    domain,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Signature, nil
+}
+
+// SignSelectionProof asks the remote signer to sign the slot as a selection proof.
+func (s *RemoteSigner) SignSelectionProof(ctx context.Context, pubKey []byte, slot uint64, domain uint64) ([]byte, error) {
+	res, err := s.client.SignSelectionProof(ctx, &pb.SignSelectionProofRequest{
+		PublicKey: pubKey,
+		Slot:      slot,
+		Domain:    domain,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Signature, nil
+}
@@ -0,0 +1,87 @@
+package signer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pb "github.com/prysmaticlabs/prysm/proto/signer/v1"
+	"github.com/sirupsen/logrus"
+)
+
+// Server serves the RemoteSigner gRPC service, signing with the keys of a signer
+// on the host which holds them.
+type Server struct {
+	signer Signer
+}
+
+// NewServer returns a remote signer server which signs with the signer.
+func NewServer(signer Signer) *Server {
+	return &Server{signer: signer}
+}
+
+// ListPublicKeys returns the public keys of the validator keys of the server.
+func (s *Server) ListPublicKeys(ctx context.Context, _ *ptypes.Empty) (*pb.ListPublicKeysResponse, error) {
+	return &pb.ListPublicKeysResponse{PublicKeys: s.signer.PublicKeys()}, nil
+}
+
+// SignBlock signs the signing root of the block in the request.
+func (s *Server) SignBlock(ctx context.Context, req *pb.SignBlockRequest) (*pb.SignResponse, error) {
+	if req.Block == nil {
+		return nil, errors.New("expected a block to sign, received nil")
+	}
+	sig, err := s.signer.SignBlock(ctx, req.PublicKey, req.Block, req.Domain)
+	if err != nil {
+		log.WithField("publicKey", fmt.Sprintf("%#x", req.PublicKey)).Warnf("Refused to sign block: %v", err)
+		return nil, err
+	}
+	return &pb.SignResponse{Signature: sig}, nil
+}
+
+// SignAttestation signs the attestation data in the request with custody bit 0.
+func (s *Server) SignAttestation(ctx context.Context, req *pb.SignAttestationRequest) (*pb.SignResponse, error) {
+	if req.Data == nil {
+		return nil, errors.New("expected attestation data to sign, received nil")
+	}
+	sig, err := s.signer.SignAttestation(ctx, req.PublicKey, req.Data, req.Domain)
+	if err != nil {
+		log.WithField("publicKey", fmt.Sprintf("%#x", req.PublicKey)).Warnf("Refused to sign attestation: %v", err)
+		return nil, err
+	}
+	return &pb.SignResponse{Signature: sig}, nil
+}
+
+// SignRandaoReveal signs the epoch in the request as a RANDAO reveal.
+func (s *Server) SignRandaoReveal(ctx context.Context, req *pb.SignRandaoRevealRequest) (*pb.SignResponse, error) {
+	sig, err := s.signer.SignRandaoReveal(ctx, req.PublicKey, req.Epoch, req.Domain)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SignResponse{Signature: sig}, nil
+}
+
+// SignSelectionProof signs the slot in the request as a selection proof.
+func (s *Server) SignSelectionProof(ctx context.Context, req *pb.SignSelectionProofRequest) (*pb.SignResponse, error) {
+	sig, err := s.signer.SignSelectionProof(ctx, req.PublicKey, req.Slot, req.Domain)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SignResponse{Signature: sig}, nil
+}
+
+// SignExit signs the signing root of the voluntary exit in the request.
+func (s *Server) SignExit(ctx context.Context, req *pb.SignExitRequest) (*pb.SignResponse, error) {
+	if req.Exit == nil {
+		return nil, errors.New("expected an exit to sign, received nil")
+	}
+	sig, err := s.signer.SignExit(ctx, req.PublicKey, req.Exit, req.Domain)
+	if err != nil {
+		return nil, err
+	}
+	log.WithFields(logrus.Fields{
+		"publicKey":      fmt.Sprintf("%#x", req.PublicKey),
+		"validatorIndex": req.Exit.ValidatorIndex,
+	}).Info("Signed voluntary exit")
+	return &pb.SignResponse{Signature: sig}, nil
+}
@@ -0,0 +1,102 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/signer/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc"
+)
+
+var _ = pb.RemoteSignerServer(&Server{})
+var _ = Signer(&LocalSigner{})
+var _ = Signer(&RemoteSigner{})
+
+func TestRemoteSigner_SignsWithServerKeys(t *testing.T) {
+	local, key, teardown := setupSigner(t)
+	defer teardown()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer()
+	pb.RegisterRemoteSignerServer(srv, NewServer(local))
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	remote, err := NewRemoteSigner(context.Background(), pb.NewRemoteSignerClient(conn))
+	if err != nil {
+		t.Fatalf("Could not create remote signer: %v", err)
+	}
+	pubKeys := remote.PublicKeys()
+	if len(pubKeys) != 1 || !bytes.Equal(pubKeys[0], key.PublicKey.Marshal()) {
+		t.Fatalf("Unexpected remote signer public keys %#x", pubKeys)
+	}
+
+	reveal, err := remote.SignRandaoReveal(context.Background(), pubKeys[0], 3, params.BeaconConfig().DomainRandao)
+	if err != nil {
+		t.Fatalf("Could not sign RANDAO reveal: %v", err)
+	}
+	want, err := local.SignRandaoReveal(context.Background(), pubKeys[0], 3, params.BeaconConfig().DomainRandao)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(reveal, want) {
+		t.Errorf("Remote RANDAO reveal differs from the local one. want=%#x got=%#x", want, reveal)
+	}
+
+	exit := &pbp2p.VoluntaryExit{Epoch: 2, ValidatorIndex: 7}
+	exitSig, err := remote.SignExit(context.Background(), pubKeys[0], exit, params.BeaconConfig().DomainExit)
+	if err != nil {
+		t.Fatalf("Could not sign exit: %v", err)
+	}
+	want, err = local.SignExit(context.Background(), pubKeys[0], exit, params.BeaconConfig().DomainExit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(exitSig, want) {
+		t.Errorf("Remote exit signature differs from the local one. want=%#x got=%#x", want, exitSig)
+	}
+
+	proof, err := remote.SignSelectionProof(context.Background(), pubKeys[0], 9, params.BeaconConfig().DomainSelectionProof)
+	if err != nil {
+		t.Fatalf("Could not sign selection proof: %v", err)
+	}
+	want, err = local.SignSelectionProof(context.Background(), pubKeys[0], 9, params.BeaconConfig().DomainSelectionProof)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(proof, want) {
+		t.Errorf("Remote selection proof differs from the local one. want=%#x got=%#x", want, proof)
+	}
+
+	block := &pbp2p.BeaconBlock{Slot: 5, StateRootHash32: []byte("A")}
+	if _, err := remote.SignBlock(context.Background(), pubKeys[0], block, params.BeaconConfig().DomainProposal); err != nil {
+		t.Fatalf("Could not sign block: %v", err)
+	}
+	other := &pbp2p.BeaconBlock{Slot: 6, StateRootHash32: []byte("B")}
+	if _, err := remote.SignBlock(context.Background(), pubKeys[0], other, params.BeaconConfig().DomainProposal); err == nil ||
+		!strings.Contains(err.Error(), "slashing protection refused the block") {
+		t.Errorf("Expected the server to refuse a double proposal, received %v", err)
+	}
+}
+
+func TestServer_SignBlock_NoBlock(t *testing.T) {
+	local, key, teardown := setupSigner(t)
+	defer teardown()
+	srv := NewServer(local)
+	if _, err := srv.SignBlock(context.Background(), &pb.SignBlockRequest{PublicKey: key.PublicKey.Marshal()}); err == nil {
+		t.Error("Expected a request without a block to fail, received nil")
+	}
+}
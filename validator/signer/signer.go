@@ -0,0 +1,31 @@
+// Package signer signs the blocks, attestations, RANDAO reveals and exits of a validator
+// client, either with validator keys held in process or with the keys held by a
+// remote signer on another host.
+package signer
+
+import (
+	"context"
+
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "signer")
+
+// Signer signs with the validator keys identified by their public keys. A signer
+// refuses to sign a block or an attestation which would get the key slashed.
+type Signer interface {
+	// PublicKeys returns the public keys of the validator keys the signer signs with.
+	PublicKeys() [][]byte
+	// SignBlock returns the signature of the signing root of the block.
+	SignBlock(ctx context.Context, pubKey []byte, block *pbp2p.BeaconBlock, domain uint64) ([]byte, error)
+	// SignAttestation returns the signature of the attestation data with custody bit 0.
+	SignAttestation(ctx context.Context, pubKey []byte, data *pbp2p.AttestationData, domain uint64) ([]byte, error)
+	// SignRandaoReveal returns the signature of the epoch, the RANDAO reveal of a block.
+	SignRandaoReveal(ctx context.Context, pubKey []byte, epoch uint64, domain uint64) ([]byte, error)
+	// SignExit returns the signature of the signing root of the voluntary exit.
+	SignExit(ctx context.Context, pubKey []byte, exit *pbp2p.VoluntaryExit, domain uint64) ([]byte, error)
+	// SignSelectionProof returns the signature of the slot, which selects the
+	// aggregators of the committees of the slot.
+	SignSelectionProof(ctx context.Context, pubKey []byte, slot uint64, domain uint64) ([]byte, error)
+}
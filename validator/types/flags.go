@@ -11,10 +11,11 @@ var (
 		Name:  "demo-config",
 		Usage: " Run the validator using demo paramteres (i.e. shorter cycles, fewer shards and committees)",
 	}
-	// BeaconRPCProviderFlag defines a beacon node RPC endpoint.
+	// BeaconRPCProviderFlag defines the beacon node RPC endpoints, separated by commas,
+	// which the validator client fails over between in order.
 	BeaconRPCProviderFlag = cli.StringFlag{
 		Name:  "beacon-rpc-provider",
-		Usage: "Beacon node RPC provider endpoint",
+		Usage: "Beacon node RPC provider endpoint, or comma separated endpoints to fail over between, in order of preference",
 		Value: "localhost:4000",
 	}
 	// CertFlag defines a flag for the node's TLS certificate.
@@ -22,14 +23,100 @@ var (
 		Name:  "tls-cert",
 		Usage: "Certificate for secure gRPC. Pass this and the tls-key flag in order to use gRPC securely.",
 	}
-	// KeystorePathFlag defines the location of the keystore directory for a validator's account.
+	// RemoteSignerFlag defines the gRPC endpoint of a remote signer which holds the validator keys.
+	RemoteSignerFlag = cli.StringFlag{
+		Name:  "remote-signer",
+		Usage: "Remote signer endpoint to sign with the validator keys it holds, instead of with the keys of a local keystore",
+	}
+	// RemoteSignerCertFlag defines the TLS certificate of the remote signer.
+	RemoteSignerCertFlag = cli.StringFlag{
+		Name:  "remote-signer-tls-cert",
+		Usage: "Certificate of the remote signer for secure gRPC",
+	}
+	// RemoteSignerClientCertFlag defines the TLS client certificate the validator client
+	// authenticates to the remote signer with.
+	RemoteSignerClientCertFlag = cli.StringFlag{
+		Name:  "remote-signer-tls-client-cert",
+		Usage: "Client certificate to authenticate to the remote signer with, signed by the CA the remote signer was started with in --tls-client-ca",
+	}
+	// RemoteSignerClientKeyFlag defines the key of the TLS client certificate of the remote signer.
+	RemoteSignerClientKeyFlag = cli.StringFlag{
+		Name:  "remote-signer-tls-client-key",
+		Usage: "Key of the client certificate to authenticate to the remote signer with",
+	}
+	// KeystorePathFlag defines the location of the keystore directory for a validator's account, or of
+	// a directory of keystores whose accounts a single validator client validates with.
 	KeystorePathFlag = cli.StringFlag{
 		Name:  "keystore-path",
-		Usage: "path to the desired keystore directory",
+		Usage: "path to the desired keystore directory, or to a directory of keystore directories to validate with all of their keys",
 	}
 	// PasswordFlag defines the password value for storing and retrieving validator private keys from the keystore.
 	PasswordFlag = cli.StringFlag{
 		Name:  "password",
 		Usage: "string value of the password for your validator private keys",
 	}
+	// InteropStartIndexFlag defines the index of the first deterministically generated interop
+	// key the validator client signs with.
+	InteropStartIndexFlag = cli.Uint64Flag{
+		Name:  "interop-start-index",
+		Usage: "Index of the first deterministic interop key to validate with",
+	}
+	// InteropNumKeysFlag defines the number of deterministically generated interop keys the
+	// validator client signs with, instead of the key in its keystore.
+	InteropNumKeysFlag = cli.Uint64Flag{
+		Name:  "interop-num-keys",
+		Usage: "Number of deterministic interop keys to validate with, starting from --interop-start-index",
+	}
+	// DoppelgangerEpochsFlag defines the number of epochs the validator client watches the
+	// network for its keys on start-up before performing duties.
+	DoppelgangerEpochsFlag = cli.Uint64Flag{
+		Name:  "doppelganger-epochs",
+		Usage: "Number of epochs to watch the network for the validator keys before performing duties, refusing to sign if they are running elsewhere. 0 disables the watch",
+		Value: 2,
+	}
+	// NumAccountsFlag defines the number of validator accounts to derive from a mnemonic.
+	NumAccountsFlag = cli.Uint64Flag{
+		Name:  "num-accounts",
+		Usage: "Number of validator accounts to derive from a mnemonic, starting from --account-start-index. If zero, a single account with random keys is created",
+	}
+	// AccountStartIndexFlag defines the index of the first validator account derived from a mnemonic.
+	AccountStartIndexFlag = cli.Uint64Flag{
+		Name:  "account-start-index",
+		Usage: "Index of the first validator account to derive from a mnemonic",
+	}
+	// MnemonicFlag defines the mnemonic from which validator accounts are recovered.
+	MnemonicFlag = cli.StringFlag{
+		Name:  "mnemonic",
+		Usage: "Mnemonic from which to recover validator accounts",
+	}
+	// MnemonicPassphraseFlag defines the optional passphrase which protects a mnemonic.
+	MnemonicPassphraseFlag = cli.StringFlag{
+		Name:  "mnemonic-passphrase",
+		Usage: "Optional passphrase which, together with the mnemonic, derives the validator accounts",
+	}
+	// ImportPathFlag defines the EIP-2335 keystore file, or directory of keystore files, to import.
+	ImportPathFlag = cli.StringFlag{
+		Name:  "import-path",
+		Usage: "Path to an EIP-2335 keystore file, or to a directory of EIP-2335 keystore files, to import",
+	}
+	// ExportPathFlag defines the directory to which validator keys are exported as EIP-2335 keystores.
+	ExportPathFlag = cli.StringFlag{
+		Name:  "export-path",
+		Usage: "Path to the directory to export validator keys to as EIP-2335 keystore files",
+	}
+	// EIP2335PasswordFlag defines the password of the EIP-2335 keystores which are imported or exported.
+	EIP2335PasswordFlag = cli.StringFlag{
+		Name:  "eip2335-password",
+		Usage: "Password of the EIP-2335 keystores to import or export. Defaults to --password",
+	}
+	// SlashingProtectionFileFlag defines the file to which the slashing protection history is exported, or from which it is imported.
+	SlashingProtectionFileFlag = cli.StringFlag{
+		Name:  "slashing-protection-file",
+		Usage: "Path to the JSON file of signed blocks and attestations to export the slashing protection history to, or to import it from",
+	}
+	// ExitPublicKeysFlag defines the public keys of the validators to voluntarily exit.
+	ExitPublicKeysFlag = cli.StringSliceFlag{
+		Name:  "exit-public-keys",
+		Usage: "Hex encoded public keys of the validators to exit, which may be left out if the validator client has a single key",
+	}
 )
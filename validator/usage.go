@@ -45,6 +45,8 @@ var appHelpFlagGroups = []flagGroup{
 		Flags: []cli.Flag{
 			cmd.VerbosityFlag,
 			cmd.DataDirFlag,
+			cmd.ForkScheduleFlag,
+			cmd.BLSBackendFlag,
 			cmd.EnableTracingFlag,
 			cmd.TracingEndpointFlag,
 			cmd.TraceSampleFractionFlag,
@@ -68,8 +70,15 @@ var appHelpFlagGroups = []flagGroup{
 		Flags: []cli.Flag{
 			types.DemoConfigFlag,
 			types.BeaconRPCProviderFlag,
+			types.RemoteSignerFlag,
+			types.RemoteSignerCertFlag,
+			types.RemoteSignerClientCertFlag,
+			types.RemoteSignerClientKeyFlag,
 			types.KeystorePathFlag,
 			types.PasswordFlag,
+			types.InteropStartIndexFlag,
+			types.InteropNumKeysFlag,
+			types.DoppelgangerEpochsFlag,
 		},
 	},
 }